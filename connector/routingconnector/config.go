@@ -6,6 +6,7 @@ package routingconnector // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/pipeline"
 
@@ -18,8 +19,15 @@ var (
 	errNoPipelines            = errors.New("invalid route: no pipelines defined")
 	errUnexpectedConsumer     = errors.New("expected consumer to be a connector router")
 	errNoTableItems           = errors.New("invalid routing table: the routing table is empty")
+	errTableAndTableFile      = errors.New("invalid routing table: both table and table_file provided")
+	errNoTableFilePath        = errors.New("invalid table_file: no path provided")
+	errNegativeReloadInterval = errors.New("invalid table_file: reload_interval must not be negative")
 )
 
+// defaultTableFileReloadInterval is the interval the routing table file is checked for changes
+// when no reload interval is configured.
+const defaultTableFileReloadInterval = time.Minute
+
 // Config defines configuration for the Routing processor.
 type Config struct {
 	// DefaultPipelines contains the list of pipelines to use when a more specific record can't be
@@ -39,9 +47,14 @@ type Config struct {
 	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
 
 	// Table contains the routing table for this processor.
-	// Required.
+	// Required, unless the routing table is loaded from TableFile.
 	Table []RoutingTableItem `mapstructure:"table"`
 
+	// TableFile configures the file the routing table is loaded from, as an alternative to Table.
+	// The file is watched for changes, and the routing table is replaced when a valid update is found.
+	// Optional.
+	TableFile *TableFileConfig `mapstructure:"table_file"`
+
 	// MatchOnce determines whether the connector matches multiple statements.
 	// Optional.
 	MatchOnce bool `mapstructure:"match_once"`
@@ -49,14 +62,27 @@ type Config struct {
 
 // Validate checks if the processor configuration is valid.
 func (c *Config) Validate() error {
+	if c.TableFile != nil {
+		if len(c.Table) != 0 {
+			return errTableAndTableFile
+		}
+		// the routing table is validated when the file is loaded
+		return nil
+	}
+	return validateTable(c.Table, c.MatchOnce)
+}
+
+// validateTable checks if the routing table is valid, either when it comes from the configuration
+// or when it's loaded from a file.
+func validateTable(table []RoutingTableItem, matchOnce bool) error {
 	// validate that there's at least one item in the table
-	if len(c.Table) == 0 {
+	if len(table) == 0 {
 		return errNoTableItems
 	}
 
 	// validate that every route has a value for the routing attribute and has
 	// at least one pipeline
-	for _, item := range c.Table {
+	for _, item := range table {
 		if item.Statement == "" && item.Condition == "" {
 			return errNoConditionOrStatement
 		}
@@ -78,7 +104,7 @@ func (c *Config) Validate() error {
 			}
 			fallthrough
 		case "span", "metric", "log": // ok
-			if !c.MatchOnce {
+			if !matchOnce {
 				return fmt.Errorf(`%q context is not supported with "match_once: false"`, item.Context)
 			}
 		default:
@@ -88,8 +114,36 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// TableFileConfig defines the file the routing table is loaded from.
+type TableFileConfig struct {
+	// Path is the path of the YAML file containing the routing table, under a "table" key with the
+	// same format as Config.Table. The file may also contain a "version" key, used to identify the
+	// routing table in the logs.
+	// Required.
+	Path string `mapstructure:"path"`
+
+	// ReloadInterval is the interval the file is checked for changes.
+	// Optional. Default 1m.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+}
+
+// Validate checks if the routing table file configuration is valid.
+func (c *TableFileConfig) Validate() error {
+	if c.Path == "" {
+		return errNoTableFilePath
+	}
+	if c.ReloadInterval < 0 {
+		return errNegativeReloadInterval
+	}
+	return nil
+}
+
 // RoutingTableItem specifies how data should be routed to the different pipelines
 type RoutingTableItem struct {
+	// Name identifies the route in the telemetry of the connector.
+	// Optional. Defaults to the statement or condition of the route.
+	Name string `mapstructure:"name"`
+
 	// One of "request", "resource", "log" (other OTTL contexts will be added in the future)
 	// Optional. Default "resource".
 	Context string `mapstructure:"context"`
@@ -6,6 +6,7 @@ package routingconnector // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -98,6 +99,20 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			configPath: filepath.Join("testdata", "config", "table_file.yaml"),
+			id:         component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				DefaultPipelines: []pipeline.ID{
+					pipeline.NewIDWithName(pipeline.SignalLogs, "otlp-all"),
+				},
+				ErrorMode: ottl.PropagateError,
+				TableFile: &TableFileConfig{
+					Path:           "/etc/otelcol/routing-table.yaml",
+					ReloadInterval: 30 * time.Second,
+				},
+			},
+		},
 	}
 
 	for _, tt := range testcases {
@@ -162,6 +177,41 @@ func TestValidateConfig(t *testing.T) {
 			config: &Config{},
 			error:  "invalid routing table: the routing table is empty",
 		},
+		{
+			name: "table file provided",
+			config: &Config{
+				TableFile: &TableFileConfig{Path: "table.yaml"},
+			},
+		},
+		{
+			name: "both table and table file provided",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Condition: `attributes["attr"] == "acme"`,
+						Pipelines: []pipeline.ID{
+							pipeline.NewIDWithName(pipeline.SignalTraces, "otlp"),
+						},
+					},
+				},
+				TableFile: &TableFileConfig{Path: "table.yaml"},
+			},
+			error: "invalid routing table: both table and table_file provided",
+		},
+		{
+			name: "no table file path provided",
+			config: &Config{
+				TableFile: &TableFileConfig{ReloadInterval: time.Second},
+			},
+			error: "invalid table_file: no path provided",
+		},
+		{
+			name: "negative table file reload interval",
+			config: &Config{
+				TableFile: &TableFileConfig{Path: "table.yaml", ReloadInterval: -time.Second},
+			},
+			error: "invalid table_file: reload_interval must not be negative",
+		},
 		{
 			name: "condition provided",
 			config: &Config{
@@ -336,3 +386,29 @@ func testConfig(opts ...testConfigOption) *Config {
 	}
 	return cfg
 }
+
+func TestReadTableFile(t *testing.T) {
+	content, err := readTableFile(filepath.Join("testdata", "table", "logs.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, &tableFileContent{
+		Version: "2024-11-20.1",
+		Table: []RoutingTableItem{
+			{
+				Name:      "acme",
+				Condition: `attributes["X-Tenant"] == "acme"`,
+				Pipelines: []pipeline.ID{
+					pipeline.NewIDWithName(pipeline.SignalLogs, "jaeger-acme"),
+					pipeline.NewIDWithName(pipeline.SignalLogs, "otlp-acme"),
+				},
+			},
+			{
+				Name:      "globex",
+				Statement: `route() where attributes["X-Tenant"] == "globex"`,
+				Pipelines: []pipeline.ID{
+					pipeline.NewIDWithName(pipeline.SignalLogs, "otlp-globex"),
+				},
+			},
+		},
+	}, content)
+	assert.NoError(t, validateTable(content.Table, false))
+}
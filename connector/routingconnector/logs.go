@@ -6,6 +6,7 @@ package routingconnector // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/connector"
@@ -13,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/plogutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
@@ -20,12 +22,11 @@ import (
 )
 
 type logsConnector struct {
-	component.StartFunc
-	component.ShutdownFunc
-
-	logger *zap.Logger
-	config *Config
-	router *router[consumer.Logs]
+	logger    *zap.Logger
+	config    *Config
+	telemetry *metadata.TelemetryBuilder
+	router    atomic.Pointer[router[consumer.Logs]]
+	tableFile *tableFile
 }
 
 func newLogsConnector(
@@ -40,20 +41,35 @@ func newLogsConnector(
 		return nil, errUnexpectedConsumer
 	}
 
-	r, err := newRouter(
-		cfg.Table,
-		cfg.DefaultPipelines,
-		lr.Consumer,
-		set.TelemetrySettings)
+	telemetry, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
 	if err != nil {
 		return nil, err
 	}
 
-	return &logsConnector{
-		logger: set.TelemetrySettings.Logger,
-		config: cfg,
-		router: r,
-	}, nil
+	c := &logsConnector{
+		logger:    set.TelemetrySettings.Logger,
+		config:    cfg,
+		telemetry: telemetry,
+	}
+	c.tableFile, err = loadRouter(cfg, lr.Consumer, set.TelemetrySettings, telemetry, &c.router)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *logsConnector) Start(context.Context, component.Host) error {
+	if c.tableFile != nil {
+		c.tableFile.start()
+	}
+	return nil
+}
+
+func (c *logsConnector) Shutdown(context.Context) error {
+	if c.tableFile != nil {
+		c.tableFile.shutdown()
+	}
+	return nil
 }
 
 func (c *logsConnector) Capabilities() consumer.Capabilities {
@@ -72,12 +88,15 @@ func (c *logsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 func (c *logsConnector) switchLogs(ctx context.Context, ld plog.Logs) error {
 	groups := make(map[consumer.Logs]plog.Logs)
 	var errs error
-	for i := 0; i < len(c.router.routeSlice) && ld.ResourceLogs().Len() > 0; i++ {
-		route := c.router.routeSlice[i]
+	r := c.router.Load()
+	for i := 0; i < len(r.routeSlice) && ld.ResourceLogs().Len() > 0; i++ {
+		route := r.routeSlice[i]
 		matchedLogs := plog.NewLogs()
+		var matches int64
 		switch route.statementContext {
 		case "request":
 			if route.requestCondition.matchRequest(ctx) {
+				matches++
 				groupAllLogs(groups, route.consumer, ld)
 				ld = plog.NewLogs() // all logs have been routed
 			}
@@ -87,6 +106,9 @@ func (c *logsConnector) switchLogs(ctx context.Context, ld plog.Logs) error {
 					rtx := ottlresource.NewTransformContext(rl.Resource(), rl)
 					_, isMatch, err := route.resourceStatement.Execute(ctx, rtx)
 					errs = errors.Join(errs, err)
+					if isMatch {
+						matches++
+					}
 					return isMatch
 				},
 			)
@@ -96,20 +118,26 @@ func (c *logsConnector) switchLogs(ctx context.Context, ld plog.Logs) error {
 					ltx := ottllog.NewTransformContext(lr, sl.Scope(), rl.Resource(), sl, rl)
 					_, isMatch, err := route.logStatement.Execute(ctx, ltx)
 					errs = errors.Join(errs, err)
+					if isMatch {
+						matches++
+					}
 					return isMatch
 				},
 			)
 		}
+		if matches > 0 {
+			c.telemetry.ConnectorRoutingRouteMatches.Add(ctx, matches, route.matchAttrs)
+		}
 		if errs != nil {
 			if c.config.ErrorMode == ottl.PropagateError {
 				return errs
 			}
-			groupAllLogs(groups, c.router.defaultConsumer, matchedLogs)
+			groupAllLogs(groups, r.defaultConsumer, matchedLogs)
 		}
 		groupAllLogs(groups, route.consumer, matchedLogs)
 	}
 	// anything left wasn't matched by any route. Send to default consumer
-	groupAllLogs(groups, c.router.defaultConsumer, ld)
+	groupAllLogs(groups, r.defaultConsumer, ld)
 	for consumer, group := range groups {
 		errs = errors.Join(errs, consumer.ConsumeLogs(ctx, group))
 	}
@@ -123,27 +151,35 @@ func (c *logsConnector) matchAllLogs(ctx context.Context, ld plog.Logs) error {
 	// higher CPU usage.
 	groups := make(map[consumer.Logs]plog.Logs)
 	var errs error
+	r := c.router.Load()
+	matches := make([]int64, len(r.routeSlice))
 	for i := 0; i < ld.ResourceLogs().Len(); i++ {
 		rlogs := ld.ResourceLogs().At(i)
 		rtx := ottlresource.NewTransformContext(rlogs.Resource(), rlogs)
 		noRoutesMatch := true
-		for _, route := range c.router.routeSlice {
+		for j, route := range r.routeSlice {
 			_, isMatch, err := route.resourceStatement.Execute(ctx, rtx)
 			if err != nil {
 				if c.config.ErrorMode == ottl.PropagateError {
 					return err
 				}
-				groupLogs(groups, c.router.defaultConsumer, rlogs)
+				groupLogs(groups, r.defaultConsumer, rlogs)
 				continue
 			}
 			if isMatch {
 				noRoutesMatch = false
+				matches[j]++
 				groupLogs(groups, route.consumer, rlogs)
 			}
 		}
 		if noRoutesMatch {
 			// no route conditions are matched, add resource logs to default exporters group
-			groupLogs(groups, c.router.defaultConsumer, rlogs)
+			groupLogs(groups, r.defaultConsumer, rlogs)
+		}
+	}
+	for j, route := range r.routeSlice {
+		if matches[j] > 0 {
+			c.telemetry.ConnectorRoutingRouteMatches.Add(ctx, matches[j], route.matchAttrs)
 		}
 	}
 	for consumer, group := range groups {
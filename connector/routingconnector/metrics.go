@@ -6,6 +6,7 @@ package routingconnector // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/connector"
@@ -13,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/pmetricutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
@@ -20,12 +22,11 @@ import (
 )
 
 type metricsConnector struct {
-	component.StartFunc
-	component.ShutdownFunc
-
-	logger *zap.Logger
-	config *Config
-	router *router[consumer.Metrics]
+	logger    *zap.Logger
+	config    *Config
+	telemetry *metadata.TelemetryBuilder
+	router    atomic.Pointer[router[consumer.Metrics]]
+	tableFile *tableFile
 }
 
 func newMetricsConnector(
@@ -40,20 +41,35 @@ func newMetricsConnector(
 		return nil, errUnexpectedConsumer
 	}
 
-	r, err := newRouter(
-		cfg.Table,
-		cfg.DefaultPipelines,
-		mr.Consumer,
-		set.TelemetrySettings)
+	telemetry, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
 	if err != nil {
 		return nil, err
 	}
 
-	return &metricsConnector{
-		logger: set.TelemetrySettings.Logger,
-		config: cfg,
-		router: r,
-	}, nil
+	c := &metricsConnector{
+		logger:    set.TelemetrySettings.Logger,
+		config:    cfg,
+		telemetry: telemetry,
+	}
+	c.tableFile, err = loadRouter(cfg, mr.Consumer, set.TelemetrySettings, telemetry, &c.router)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *metricsConnector) Start(context.Context, component.Host) error {
+	if c.tableFile != nil {
+		c.tableFile.start()
+	}
+	return nil
+}
+
+func (c *metricsConnector) Shutdown(context.Context) error {
+	if c.tableFile != nil {
+		c.tableFile.shutdown()
+	}
+	return nil
 }
 
 func (c *metricsConnector) Capabilities() consumer.Capabilities {
@@ -70,12 +86,15 @@ func (c *metricsConnector) ConsumeMetrics(ctx context.Context, md pmetric.Metric
 func (c *metricsConnector) switchMetrics(ctx context.Context, md pmetric.Metrics) error {
 	groups := make(map[consumer.Metrics]pmetric.Metrics)
 	var errs error
-	for i := 0; i < len(c.router.routeSlice) && md.ResourceMetrics().Len() > 0; i++ {
-		route := c.router.routeSlice[i]
+	r := c.router.Load()
+	for i := 0; i < len(r.routeSlice) && md.ResourceMetrics().Len() > 0; i++ {
+		route := r.routeSlice[i]
 		matchedMetrics := pmetric.NewMetrics()
+		var matches int64
 		switch route.statementContext {
 		case "request":
 			if route.requestCondition.matchRequest(ctx) {
+				matches++
 				groupAllMetrics(groups, route.consumer, md)
 				md = pmetric.NewMetrics() // all metrics have been routed
 			}
@@ -85,6 +104,9 @@ func (c *metricsConnector) switchMetrics(ctx context.Context, md pmetric.Metrics
 					rtx := ottlresource.NewTransformContext(rs.Resource(), rs)
 					_, isMatch, err := route.resourceStatement.Execute(ctx, rtx)
 					errs = errors.Join(errs, err)
+					if isMatch {
+						matches++
+					}
 					return isMatch
 				},
 			)
@@ -94,20 +116,26 @@ func (c *metricsConnector) switchMetrics(ctx context.Context, md pmetric.Metrics
 					mtx := ottlmetric.NewTransformContext(m, sm.Metrics(), sm.Scope(), rm.Resource(), sm, rm)
 					_, isMatch, err := route.metricStatement.Execute(ctx, mtx)
 					errs = errors.Join(errs, err)
+					if isMatch {
+						matches++
+					}
 					return isMatch
 				},
 			)
 		}
+		if matches > 0 {
+			c.telemetry.ConnectorRoutingRouteMatches.Add(ctx, matches, route.matchAttrs)
+		}
 		if errs != nil {
 			if c.config.ErrorMode == ottl.PropagateError {
 				return errs
 			}
-			groupAllMetrics(groups, c.router.defaultConsumer, matchedMetrics)
+			groupAllMetrics(groups, r.defaultConsumer, matchedMetrics)
 		}
 		groupAllMetrics(groups, route.consumer, matchedMetrics)
 	}
 	// anything left wasn't matched by any route. Send to default consumer
-	groupAllMetrics(groups, c.router.defaultConsumer, md)
+	groupAllMetrics(groups, r.defaultConsumer, md)
 	for consumer, group := range groups {
 		errs = errors.Join(errs, consumer.ConsumeMetrics(ctx, group))
 	}
@@ -121,28 +149,36 @@ func (c *metricsConnector) matchAllMetrics(ctx context.Context, md pmetric.Metri
 	groups := make(map[consumer.Metrics]pmetric.Metrics)
 
 	var errs error
+	r := c.router.Load()
+	matches := make([]int64, len(r.routeSlice))
 	for i := 0; i < md.ResourceMetrics().Len(); i++ {
 		rmetrics := md.ResourceMetrics().At(i)
 		rtx := ottlresource.NewTransformContext(rmetrics.Resource(), rmetrics)
 
 		noRoutesMatch := true
-		for _, route := range c.router.routeSlice {
+		for j, route := range r.routeSlice {
 			_, isMatch, err := route.resourceStatement.Execute(ctx, rtx)
 			if err != nil {
 				if c.config.ErrorMode == ottl.PropagateError {
 					return err
 				}
-				groupMetrics(groups, c.router.defaultConsumer, rmetrics)
+				groupMetrics(groups, r.defaultConsumer, rmetrics)
 				continue
 			}
 			if isMatch {
 				noRoutesMatch = false
+				matches[j]++
 				groupMetrics(groups, route.consumer, rmetrics)
 			}
 		}
 		if noRoutesMatch {
 			// no route conditions are matched, add resource metrics to default exporters group
-			groupMetrics(groups, c.router.defaultConsumer, rmetrics)
+			groupMetrics(groups, r.defaultConsumer, rmetrics)
+		}
+	}
+	for j, route := range r.routeSlice {
+		if matches[j] > 0 {
+			c.telemetry.ConnectorRoutingRouteMatches.Add(ctx, matches[j], route.matchAttrs)
 		}
 	}
 	for consumer, group := range groups {
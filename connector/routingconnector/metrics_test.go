@@ -58,13 +58,13 @@ func TestMetricsRegisterConsumersForValidRoute(t *testing.T) {
 
 	rtConn := conn.(*metricsConnector)
 	require.NoError(t, err)
-	require.Same(t, &defaultSink, rtConn.router.defaultConsumer)
+	require.Same(t, &defaultSink, rtConn.router.Load().defaultConsumer)
 
-	route, ok := rtConn.router.routes[rtConn.router.table[0].Statement]
+	route, ok := rtConn.router.Load().routes[rtConn.router.Load().table[0].Statement]
 	assert.True(t, ok)
 	require.Same(t, &sink0, route.consumer)
 
-	route, ok = rtConn.router.routes[rtConn.router.table[1].Statement]
+	route, ok = rtConn.router.Load().routes[rtConn.router.Load().table[1].Statement]
 	assert.True(t, ok)
 
 	routeConsumer, err := router.Consumer(metrics0, metrics1)
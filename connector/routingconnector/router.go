@@ -10,6 +10,8 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/common"
@@ -74,6 +76,7 @@ func newRouter[C any](
 type routingItem[C any] struct {
 	consumer          C
 	statementContext  string
+	matchAttrs        metric.MeasurementOption
 	requestCondition  *requestCondition
 	resourceStatement *ottl.Statement[ottlresource.TransformContext]
 	spanStatement     *ottl.Statement[ottlspan.TransformContext]
@@ -192,6 +195,7 @@ func (r *router[C]) registerRouteConsumers() (err error) {
 		route, ok := r.routes[key(item)]
 		if !ok {
 			route.statementContext = item.Context
+			route.matchAttrs = metric.WithAttributeSet(attribute.NewSet(attribute.String("route", routeName(item))))
 			switch item.Context {
 			case "request":
 				route.requestCondition, err = parseRequestCondition(item.Condition)
@@ -246,6 +250,14 @@ func (r *router[C]) registerRouteConsumers() (err error) {
 	return nil
 }
 
+// routeName returns the name the route is identified by in the telemetry of the connector.
+func routeName(entry RoutingTableItem) string {
+	if entry.Name != "" {
+		return entry.Name
+	}
+	return key(entry)
+}
+
 func key(entry RoutingTableItem) string {
 	switch entry.Context {
 	case "", "resource":
@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package routingconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/metadata"
+)
+
+// tableFileContent is the content of the routing table file.
+type tableFileContent struct {
+	// Version identifies the routing table in the logs.
+	Version string `mapstructure:"version"`
+
+	// Table contains the routing table, with the same format as Config.Table.
+	Table []RoutingTableItem `mapstructure:"table"`
+}
+
+// loadFunc validates and applies the routing table loaded from the routing table file.
+type loadFunc func(table []RoutingTableItem) error
+
+// tableFile loads the routing table from a file, and checks the file for changes periodically. The
+// routing table is reloaded when the modification time or the size of the file changes. An update
+// that fails to load is rejected, and the previous routing table is kept until the file loads
+// successfully, which is retried every reload interval.
+type tableFile struct {
+	path           string
+	reloadInterval time.Duration
+	load           loadFunc
+	logger         *zap.Logger
+	telemetry      *metadata.TelemetryBuilder
+
+	// version, modification time and size of the loaded file
+	version string
+	modTime time.Time
+	size    int64
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newTableFile loads the routing table from the configured file, failing if it can't be loaded.
+func newTableFile(
+	cfg *TableFileConfig,
+	load loadFunc,
+	logger *zap.Logger,
+	telemetry *metadata.TelemetryBuilder,
+) (*tableFile, error) {
+	f := &tableFile{
+		path:           cfg.Path,
+		reloadInterval: cfg.ReloadInterval,
+		load:           load,
+		logger:         logger,
+		telemetry:      telemetry,
+		stop:           make(chan struct{}),
+	}
+	if f.reloadInterval == 0 {
+		f.reloadInterval = defaultTableFileReloadInterval
+	}
+
+	if _, err := f.reload(); err != nil {
+		return nil, err
+	}
+	f.logger.Info("Loaded the routing table", zap.String("path", f.path), zap.String("version", f.version))
+	return f, nil
+}
+
+// start starts checking the file for changes.
+func (f *tableFile) start() {
+	f.wg.Add(1)
+	go f.watch()
+}
+
+// shutdown stops checking the file for changes.
+func (f *tableFile) shutdown() {
+	select {
+	case <-f.stop:
+	default:
+		close(f.stop)
+	}
+	f.wg.Wait()
+}
+
+func (f *tableFile) watch() {
+	defer f.wg.Done()
+
+	ticker := time.NewTicker(f.reloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-f.stop:
+			return
+		case <-ticker.C:
+			reloaded, err := f.reload()
+			switch {
+			case err != nil:
+				f.telemetry.ConnectorRoutingTableReloadFailures.Add(context.Background(), 1)
+				f.logger.Error("Failed to reload the routing table, keeping the previous one",
+					zap.String("path", f.path), zap.String("version", f.version), zap.Error(err))
+			case reloaded:
+				f.telemetry.ConnectorRoutingTableReloads.Add(context.Background(), 1)
+				f.logger.Info("Reloaded the routing table", zap.String("path", f.path), zap.String("version", f.version))
+			}
+		}
+	}
+}
+
+// reload loads the routing table if the file changed since it was last loaded. It reports whether
+// the routing table was loaded.
+func (f *tableFile) reload() (bool, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return false, fmt.Errorf("could not read the routing table file: %w", err)
+	}
+	if !f.modTime.IsZero() && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return false, nil
+	}
+
+	content, err := readTableFile(f.path)
+	if err != nil {
+		return false, err
+	}
+	if err = f.load(content.Table); err != nil {
+		return false, fmt.Errorf("invalid routing table in %s (version %q): %w", f.path, content.Version, err)
+	}
+
+	// only record the file as loaded once it is, so that a failed update, e.g. of a file read
+	// while being written, is retried even if the file doesn't change again
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	f.version = content.Version
+	return true, nil
+}
+
+// readTableFile reads and decodes the routing table file in the given path.
+func readTableFile(path string) (*tableFileContent, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the routing table file: %w", err)
+	}
+
+	var raw map[string]any
+	if err = yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("could not parse the routing table file %s: %w", path, err)
+	}
+	content := &tableFileContent{}
+	if err = confmap.NewFromStringMap(raw).Unmarshal(content); err != nil {
+		return nil, fmt.Errorf("could not decode the routing table file %s: %w", path, err)
+	}
+	return content, nil
+}
+
+// loadRouter builds the router from the routing table of the configuration, storing it in current.
+// When the routing table is loaded from a file, a new router is built each time the file changes,
+// and swapped atomically with the current one once it's completely built. The returned tableFile
+// is nil when the routing table isn't loaded from a file.
+func loadRouter[C any](
+	cfg *Config,
+	provider consumerProvider[C],
+	settings component.TelemetrySettings,
+	telemetry *metadata.TelemetryBuilder,
+	current *atomic.Pointer[router[C]],
+) (*tableFile, error) {
+	if cfg.TableFile == nil {
+		r, err := newRouter(cfg.Table, cfg.DefaultPipelines, provider, settings)
+		if err != nil {
+			return nil, err
+		}
+		current.Store(r)
+		return nil, nil
+	}
+
+	return newTableFile(cfg.TableFile, func(table []RoutingTableItem) error {
+		if err := validateTable(table, cfg.MatchOnce); err != nil {
+			return err
+		}
+		r, err := newRouter(table, cfg.DefaultPipelines, provider, settings)
+		if err != nil {
+			return err
+		}
+		current.Store(r)
+		return nil
+	}, settings.Logger, telemetry)
+}
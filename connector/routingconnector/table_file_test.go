@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package routingconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector"
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	acmeTableFile = `
+version: "1"
+table:
+  - name: acme
+    condition: attributes["X-Tenant"] == "acme"
+    pipelines: [logs/0]
+`
+	globexTableFile = `
+version: "2"
+table:
+  - name: globex
+    condition: attributes["X-Tenant"] == "globex"
+    pipelines: [logs/1]
+`
+)
+
+// writeTableFile writes the routing table file, moving its modification time forward so that the
+// change is detected even when the file is written twice within the resolution of the file system.
+func writeTableFile(t *testing.T, path, content string) {
+	var modTime time.Time
+	if info, err := os.Stat(path); err == nil {
+		modTime = info.ModTime()
+	}
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	modTime = modTime.Add(time.Second)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func tenantLogs(tenant string) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("X-Tenant", tenant)
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	return ld
+}
+
+func TestLogsTableFileReload(t *testing.T) {
+	logsDefault := pipeline.NewIDWithName(pipeline.SignalLogs, "default")
+	logs0 := pipeline.NewIDWithName(pipeline.SignalLogs, "0")
+	logs1 := pipeline.NewIDWithName(pipeline.SignalLogs, "1")
+
+	path := filepath.Join(t.TempDir(), "table.yaml")
+	writeTableFile(t, path, acmeTableFile)
+
+	cfg := &Config{
+		DefaultPipelines: []pipeline.ID{logsDefault},
+		TableFile:        &TableFileConfig{Path: path},
+	}
+	require.NoError(t, cfg.Validate())
+
+	var defaultSink, sink0, sink1 consumertest.LogsSink
+	router := connector.NewLogsRouter(map[pipeline.ID]consumer.Logs{
+		logsDefault: &defaultSink,
+		logs0:       &sink0,
+		logs1:       &sink1,
+	})
+
+	conn, err := NewFactory().CreateLogsToLogs(context.Background(),
+		connectortest.NewNopSettings(), cfg, router.(consumer.Logs))
+	require.NoError(t, err)
+	rtConn := conn.(*logsConnector)
+	require.NotNil(t, rtConn.tableFile)
+	assert.Equal(t, "1", rtConn.tableFile.version)
+
+	require.NoError(t, conn.ConsumeLogs(context.Background(), tenantLogs("acme")))
+	require.NoError(t, conn.ConsumeLogs(context.Background(), tenantLogs("globex")))
+	assert.Len(t, sink0.AllLogs(), 1)
+	assert.Empty(t, sink1.AllLogs())
+	assert.Len(t, defaultSink.AllLogs(), 1)
+
+	// the file didn't change
+	reloaded, err := rtConn.tableFile.reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded)
+
+	writeTableFile(t, path, globexTableFile)
+	reloaded, err = rtConn.tableFile.reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.Equal(t, "2", rtConn.tableFile.version)
+
+	sink0.Reset()
+	defaultSink.Reset()
+	require.NoError(t, conn.ConsumeLogs(context.Background(), tenantLogs("acme")))
+	require.NoError(t, conn.ConsumeLogs(context.Background(), tenantLogs("globex")))
+	assert.Empty(t, sink0.AllLogs())
+	assert.Len(t, sink1.AllLogs(), 1)
+	assert.Len(t, defaultSink.AllLogs(), 1)
+
+	// invalid updates are rejected, keeping the previous routing table
+	invalidUpdates := map[string]string{
+		"invalid yaml":    "table: [",
+		"unknown key":     "tables: []",
+		"empty table":     `version: "3"`,
+		"unknown context": "table:\n  - context: invalid\n    condition: 'true'\n    pipelines: [logs/0]",
+		"invalid ottl":    "table:\n  - condition: attributes[\"X-Tenant\"] ==\n    pipelines: [logs/0]",
+		"no pipelines":    "table:\n  - condition: 'true'",
+		"unknown pipeline": `
+table:
+  - condition: attributes["X-Tenant"] == "globex"
+    pipelines: [logs/unknown]
+`,
+	}
+	current := rtConn.router.Load()
+	for name, content := range invalidUpdates {
+		t.Run(name, func(t *testing.T) {
+			writeTableFile(t, path, content)
+			reloaded, err = rtConn.tableFile.reload()
+			assert.Error(t, err)
+			assert.False(t, reloaded)
+			assert.Equal(t, "2", rtConn.tableFile.version)
+			assert.Same(t, current, rtConn.router.Load())
+
+			// the update is retried, even if the file doesn't change again
+			reloaded, err = rtConn.tableFile.reload()
+			assert.Error(t, err)
+			assert.False(t, reloaded)
+		})
+	}
+}
+
+func TestTableFileWatch(t *testing.T) {
+	logsDefault := pipeline.NewIDWithName(pipeline.SignalLogs, "default")
+	logs0 := pipeline.NewIDWithName(pipeline.SignalLogs, "0")
+	logs1 := pipeline.NewIDWithName(pipeline.SignalLogs, "1")
+
+	path := filepath.Join(t.TempDir(), "table.yaml")
+	writeTableFile(t, path, acmeTableFile)
+
+	cfg := &Config{
+		DefaultPipelines: []pipeline.ID{logsDefault},
+		MatchOnce:        true,
+		TableFile:        &TableFileConfig{Path: path, ReloadInterval: 10 * time.Millisecond},
+	}
+
+	router := connector.NewLogsRouter(map[pipeline.ID]consumer.Logs{
+		logsDefault: consumertest.NewNop(),
+		logs0:       consumertest.NewNop(),
+		logs1:       consumertest.NewNop(),
+	})
+
+	tel := setupTestTelemetry()
+	conn, err := NewFactory().CreateLogsToLogs(context.Background(),
+		tel.NewSettings(), cfg, router.(consumer.Logs))
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, conn.Shutdown(context.Background()))
+	}()
+	rtConn := conn.(*logsConnector)
+	initial := rtConn.router.Load()
+
+	reloadFailures := func() int64 {
+		var md metricdata.ResourceMetrics
+		require.NoError(t, tel.reader.Collect(context.Background(), &md))
+		sum, ok := tel.getMetric("otelcol_connector_routing_table_reload_failures", md).Data.(metricdata.Sum[int64])
+		if !ok || len(sum.DataPoints) == 0 {
+			return 0
+		}
+		return sum.DataPoints[0].Value
+	}
+
+	// the invalid update is retried until the file loads
+	writeTableFile(t, path, "table: [")
+	assert.Eventually(t, func() bool {
+		return reloadFailures() >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Same(t, initial, rtConn.router.Load())
+
+	writeTableFile(t, path, globexTableFile)
+	assert.Eventually(t, func() bool {
+		return rtConn.router.Load() != initial
+	}, 5*time.Second, 10*time.Millisecond)
+	failures := reloadFailures()
+
+	require.NoError(t, conn.ConsumeLogs(context.Background(), tenantLogs("globex")))
+
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "otelcol_connector_routing_table_reloads",
+			Description: "Number of times the routing table was reloaded from the routing table file.",
+			Unit:        "{reloads}",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: 1}},
+			},
+		},
+		{
+			Name:        "otelcol_connector_routing_table_reload_failures",
+			Description: "Number of failed attempts to reload the routing table file, keeping the previous routing table.",
+			Unit:        "{reloads}",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: failures}},
+			},
+		},
+		{
+			Name:        "otelcol_connector_routing_route_matches",
+			Description: "Number of items matched by each route of the routing table. Items are resources, spans, metrics, log records or requests, depending on the context of the route.",
+			Unit:        "{items}",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Value: 1, Attributes: attribute.NewSet(attribute.String("route", "globex"))},
+				},
+			},
+		},
+	})
+}
+
+func TestInvalidTableFile(t *testing.T) {
+	dir := t.TempDir()
+	invalidPath := filepath.Join(dir, "invalid.yaml")
+	writeTableFile(t, invalidPath, "table:\n  - condition: 'true'\n    pipelines: [logs/unknown]")
+
+	for name, path := range map[string]string{
+		"missing file":  filepath.Join(dir, "missing.yaml"),
+		"invalid table": invalidPath,
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := &Config{TableFile: &TableFileConfig{Path: path}}
+			router := connector.NewLogsRouter(map[pipeline.ID]consumer.Logs{
+				pipeline.NewIDWithName(pipeline.SignalLogs, "0"): consumertest.NewNop(),
+			})
+
+			_, err := NewFactory().CreateLogsToLogs(context.Background(),
+				connectortest.NewNopSettings(), cfg, router.(consumer.Logs))
+			assert.Error(t, err)
+		})
+	}
+}
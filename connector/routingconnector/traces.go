@@ -6,6 +6,7 @@ package routingconnector // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/connector"
@@ -13,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/ptraceutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
@@ -20,12 +22,11 @@ import (
 )
 
 type tracesConnector struct {
-	component.StartFunc
-	component.ShutdownFunc
-
-	logger *zap.Logger
-	config *Config
-	router *router[consumer.Traces]
+	logger    *zap.Logger
+	config    *Config
+	telemetry *metadata.TelemetryBuilder
+	router    atomic.Pointer[router[consumer.Traces]]
+	tableFile *tableFile
 }
 
 func newTracesConnector(
@@ -40,20 +41,35 @@ func newTracesConnector(
 		return nil, errUnexpectedConsumer
 	}
 
-	r, err := newRouter(
-		cfg.Table,
-		cfg.DefaultPipelines,
-		tr.Consumer,
-		set.TelemetrySettings)
+	telemetry, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
 	if err != nil {
 		return nil, err
 	}
 
-	return &tracesConnector{
-		logger: set.TelemetrySettings.Logger,
-		config: cfg,
-		router: r,
-	}, nil
+	c := &tracesConnector{
+		logger:    set.TelemetrySettings.Logger,
+		config:    cfg,
+		telemetry: telemetry,
+	}
+	c.tableFile, err = loadRouter(cfg, tr.Consumer, set.TelemetrySettings, telemetry, &c.router)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *tracesConnector) Start(context.Context, component.Host) error {
+	if c.tableFile != nil {
+		c.tableFile.start()
+	}
+	return nil
+}
+
+func (c *tracesConnector) Shutdown(context.Context) error {
+	if c.tableFile != nil {
+		c.tableFile.shutdown()
+	}
+	return nil
 }
 
 func (*tracesConnector) Capabilities() consumer.Capabilities {
@@ -70,12 +86,15 @@ func (c *tracesConnector) ConsumeTraces(ctx context.Context, td ptrace.Traces) e
 func (c *tracesConnector) switchTraces(ctx context.Context, td ptrace.Traces) error {
 	groups := make(map[consumer.Traces]ptrace.Traces)
 	var errs error
-	for i := 0; i < len(c.router.routeSlice) && td.ResourceSpans().Len() > 0; i++ {
-		route := c.router.routeSlice[i]
+	r := c.router.Load()
+	for i := 0; i < len(r.routeSlice) && td.ResourceSpans().Len() > 0; i++ {
+		route := r.routeSlice[i]
 		matchedSpans := ptrace.NewTraces()
+		var matches int64
 		switch route.statementContext {
 		case "request":
 			if route.requestCondition.matchRequest(ctx) {
+				matches++
 				groupAllTraces(groups, route.consumer, td)
 				td = ptrace.NewTraces() // all traces have been routed
 			}
@@ -85,6 +104,9 @@ func (c *tracesConnector) switchTraces(ctx context.Context, td ptrace.Traces) er
 					rtx := ottlresource.NewTransformContext(rs.Resource(), rs)
 					_, isMatch, err := route.resourceStatement.Execute(ctx, rtx)
 					errs = errors.Join(errs, err)
+					if isMatch {
+						matches++
+					}
 					return isMatch
 				},
 			)
@@ -94,20 +116,26 @@ func (c *tracesConnector) switchTraces(ctx context.Context, td ptrace.Traces) er
 					mtx := ottlspan.NewTransformContext(s, ss.Scope(), rs.Resource(), ss, rs)
 					_, isMatch, err := route.spanStatement.Execute(ctx, mtx)
 					errs = errors.Join(errs, err)
+					if isMatch {
+						matches++
+					}
 					return isMatch
 				},
 			)
 		}
+		if matches > 0 {
+			c.telemetry.ConnectorRoutingRouteMatches.Add(ctx, matches, route.matchAttrs)
+		}
 		if errs != nil {
 			if c.config.ErrorMode == ottl.PropagateError {
 				return errs
 			}
-			groupAllTraces(groups, c.router.defaultConsumer, matchedSpans)
+			groupAllTraces(groups, r.defaultConsumer, matchedSpans)
 		}
 		groupAllTraces(groups, route.consumer, matchedSpans)
 	}
 	// anything left wasn't matched by any route. Send to default consumer
-	groupAllTraces(groups, c.router.defaultConsumer, td)
+	groupAllTraces(groups, r.defaultConsumer, td)
 	for consumer, group := range groups {
 		errs = errors.Join(errs, consumer.ConsumeTraces(ctx, group))
 	}
@@ -121,27 +149,35 @@ func (c *tracesConnector) matchAllTraces(ctx context.Context, td ptrace.Traces)
 	groups := make(map[consumer.Traces]ptrace.Traces)
 
 	var errs error
+	r := c.router.Load()
+	matches := make([]int64, len(r.routeSlice))
 	for i := 0; i < td.ResourceSpans().Len(); i++ {
 		rspans := td.ResourceSpans().At(i)
 		rtx := ottlresource.NewTransformContext(rspans.Resource(), rspans)
 		noRoutesMatch := true
-		for _, route := range c.router.routeSlice {
+		for j, route := range r.routeSlice {
 			_, isMatch, err := route.resourceStatement.Execute(ctx, rtx)
 			if err != nil {
 				if c.config.ErrorMode == ottl.PropagateError {
 					return err
 				}
-				groupTraces(groups, c.router.defaultConsumer, rspans)
+				groupTraces(groups, r.defaultConsumer, rspans)
 				continue
 			}
 			if isMatch {
 				noRoutesMatch = false
+				matches[j]++
 				groupTraces(groups, route.consumer, rspans)
 			}
 		}
 		if noRoutesMatch {
 			// no route conditions are matched, add resource spans to default pipelines group
-			groupTraces(groups, c.router.defaultConsumer, rspans)
+			groupTraces(groups, r.defaultConsumer, rspans)
+		}
+	}
+	for j, route := range r.routeSlice {
+		if matches[j] > 0 {
+			c.telemetry.ConnectorRoutingRouteMatches.Add(ctx, matches[j], route.matchAttrs)
 		}
 	}
 	for consumer, group := range groups {
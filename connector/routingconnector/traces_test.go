@@ -16,6 +16,8 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/ptraceutiltest"
 )
@@ -58,13 +60,13 @@ func TestTracesRegisterConsumersForValidRoute(t *testing.T) {
 
 	rtConn := conn.(*tracesConnector)
 	require.NoError(t, err)
-	require.Same(t, &defaultSink, rtConn.router.defaultConsumer)
+	require.Same(t, &defaultSink, rtConn.router.Load().defaultConsumer)
 
-	route, ok := rtConn.router.routes[rtConn.router.table[0].Statement]
+	route, ok := rtConn.router.Load().routes[rtConn.router.Load().table[0].Statement]
 	assert.True(t, ok)
 	require.Same(t, &sink0, route.consumer)
 
-	route, ok = rtConn.router.routes[rtConn.router.table[1].Statement]
+	route, ok = rtConn.router.Load().routes[rtConn.router.Load().table[1].Statement]
 	assert.True(t, ok)
 
 	routeConsumer, err := router.Consumer(traces0, traces1)
@@ -901,3 +903,89 @@ func TestTracesConnectorDetailed(t *testing.T) {
 		})
 	}
 }
+
+func TestTracesRouteMatches(t *testing.T) {
+	idSink0 := pipeline.NewIDWithName(pipeline.SignalTraces, "0")
+	idSink1 := pipeline.NewIDWithName(pipeline.SignalTraces, "1")
+
+	isResourceA := `attributes["resourceName"] == "resourceA"`
+	isResourceB := `attributes["resourceName"] == "resourceB"`
+	isSpanF := `name == "spanF"`
+
+	routeAttrs := func(route string) attribute.Set {
+		return attribute.NewSet(attribute.String("route", route))
+	}
+
+	testCases := []struct {
+		name     string
+		cfg      *Config
+		expected []metricdata.DataPoint[int64]
+	}{
+		{
+			name: "match_all",
+			cfg: &Config{
+				Table: []RoutingTableItem{
+					{Name: "resource-a", Condition: isResourceA, Pipelines: []pipeline.ID{idSink0}},
+					{Condition: isResourceB, Pipelines: []pipeline.ID{idSink1}},
+					{Condition: `attributes["resourceName"] != nil`, Pipelines: []pipeline.ID{idSink1}},
+				},
+			},
+			expected: []metricdata.DataPoint[int64]{
+				{Value: 1, Attributes: routeAttrs("resource-a")},
+				{Value: 1, Attributes: routeAttrs("route() where " + isResourceB)},
+				{Value: 2, Attributes: routeAttrs(`route() where attributes["resourceName"] != nil`)},
+			},
+		},
+		{
+			name: "match_once",
+			cfg: testConfig(
+				func(cfg *Config) {
+					cfg.Table = []RoutingTableItem{
+						{Name: "span-f", Context: "span", Condition: isSpanF, Pipelines: []pipeline.ID{idSink0}},
+						{Name: "resource-b", Condition: isResourceB, Pipelines: []pipeline.ID{idSink1}},
+						{Name: "resource-a", Condition: isResourceA, Pipelines: []pipeline.ID{idSink1}},
+						{Name: "unmatched", Condition: "false", Pipelines: []pipeline.ID{idSink1}},
+					}
+				},
+			),
+			expected: []metricdata.DataPoint[int64]{
+				{Value: 4, Attributes: routeAttrs("span-f")},
+				{Value: 1, Attributes: routeAttrs("resource-b")},
+				{Value: 1, Attributes: routeAttrs("resource-a")},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			router := connector.NewTracesRouter(map[pipeline.ID]consumer.Traces{
+				idSink0: consumertest.NewNop(),
+				idSink1: consumertest.NewNop(),
+			})
+
+			tel := setupTestTelemetry()
+			conn, err := NewFactory().CreateTracesToTraces(
+				context.Background(),
+				tel.NewSettings(),
+				tt.cfg,
+				router.(consumer.Traces),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, conn.ConsumeTraces(context.Background(), ptraceutiltest.NewTraces("AB", "CD", "EF", "GH")))
+
+			tel.assertMetrics(t, []metricdata.Metrics{
+				{
+					Name:        "otelcol_connector_routing_route_matches",
+					Description: "Number of items matched by each route of the routing table. Items are resources, spans, metrics, log records or requests, depending on the context of the route.",
+					Unit:        "{items}",
+					Data: metricdata.Sum[int64]{
+						Temporality: metricdata.CumulativeTemporality,
+						IsMonotonic: true,
+						DataPoints:  tt.expected,
+					},
+				},
+			})
+		})
+	}
+}
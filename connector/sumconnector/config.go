@@ -29,6 +29,9 @@ type MetricInfo struct {
 	Conditions      []string          `mapstructure:"conditions"`
 	Attributes      []AttributeConfig `mapstructure:"attributes"`
 	SourceAttribute string            `mapstructure:"source_attribute"`
+	// Histogram records the values of the source attribute into a histogram
+	// instead of summing them.
+	Histogram *HistogramConfig `mapstructure:"histogram"`
 }
 
 type AttributeConfig struct {
@@ -36,6 +39,24 @@ type AttributeConfig struct {
 	DefaultValue any    `mapstructure:"default_value"`
 }
 
+// HistogramConfig selects the kind of histogram the source attribute values are recorded into.
+// Exactly one of Explicit or Exponential must be set.
+type HistogramConfig struct {
+	Explicit    *ExplicitHistogramConfig    `mapstructure:"explicit"`
+	Exponential *ExponentialHistogramConfig `mapstructure:"exponential"`
+}
+
+type ExplicitHistogramConfig struct {
+	// Buckets is the list of upper bounds of the explicit histogram buckets.
+	Buckets []float64 `mapstructure:"buckets"`
+}
+
+type ExponentialHistogramConfig struct {
+	// MaxSize is the maximum number of buckets per positive or negative range.
+	// Defaults to 160 when unset.
+	MaxSize int32 `mapstructure:"max_size"`
+}
+
 func (c *Config) Validate() (combinedErrors error) {
 	for name, info := range c.Spans {
 		if name == "" {
@@ -44,6 +65,9 @@ func (c *Config) Validate() (combinedErrors error) {
 		if info.SourceAttribute == "" {
 			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("spans: metric source_attribute missing"))
 		}
+		if err := info.validateHistogram(); err != nil {
+			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("spans histogram: metric %q: %w", name, err))
+		}
 		if _, err := filterottl.NewBoolExprForSpan(info.Conditions, filterottl.StandardSpanFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
 			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("spans condition: metric %q: %w", name, err))
 		}
@@ -58,6 +82,9 @@ func (c *Config) Validate() (combinedErrors error) {
 		if info.SourceAttribute == "" {
 			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("spanevents: metric source_attribute missing"))
 		}
+		if err := info.validateHistogram(); err != nil {
+			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("spanevents histogram: metric %q: %w", name, err))
+		}
 		if _, err := filterottl.NewBoolExprForSpanEvent(info.Conditions, filterottl.StandardSpanEventFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
 			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("spanevents condition: metric %q: %w", name, err))
 		}
@@ -72,6 +99,9 @@ func (c *Config) Validate() (combinedErrors error) {
 		if info.SourceAttribute == "" {
 			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("metrics: metric source_attribute missing"))
 		}
+		if err := info.validateHistogram(); err != nil {
+			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("metrics histogram: metric %q: %w", name, err))
+		}
 		if _, err := filterottl.NewBoolExprForMetric(info.Conditions, filterottl.StandardMetricFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
 			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("metrics condition: metric %q: %w", name, err))
 		}
@@ -86,6 +116,9 @@ func (c *Config) Validate() (combinedErrors error) {
 		if info.SourceAttribute == "" {
 			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("datapoints: metric source_attribute missing"))
 		}
+		if err := info.validateHistogram(); err != nil {
+			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("datapoints histogram: metric %q: %w", name, err))
+		}
 		if _, err := filterottl.NewBoolExprForDataPoint(info.Conditions, filterottl.StandardDataPointFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
 			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("datapoints condition: metric %q: %w", name, err))
 		}
@@ -100,6 +133,9 @@ func (c *Config) Validate() (combinedErrors error) {
 		if info.SourceAttribute == "" {
 			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("logs: metric source_attribute missing"))
 		}
+		if err := info.validateHistogram(); err != nil {
+			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("logs histogram: metric %q: %w", name, err))
+		}
 		if _, err := filterottl.NewBoolExprForLog(info.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
 			combinedErrors = errors.Join(combinedErrors, fmt.Errorf("logs condition: metric %q: %w", name, err))
 		}
@@ -119,4 +155,25 @@ func (i *MetricInfo) validateAttributes() error {
 	return nil
 }
 
+func (i *MetricInfo) validateHistogram() error {
+	if i.Histogram == nil {
+		return nil
+	}
+	switch {
+	case i.Histogram.Explicit == nil && i.Histogram.Exponential == nil:
+		return errors.New("one of `explicit` or `exponential` buckets is required")
+	case i.Histogram.Explicit != nil && i.Histogram.Exponential != nil:
+		return errors.New("use either `explicit` or `exponential` buckets histogram")
+	case i.Histogram.Explicit != nil:
+		for j := 1; j < len(i.Histogram.Explicit.Buckets); j++ {
+			if i.Histogram.Explicit.Buckets[j] <= i.Histogram.Explicit.Buckets[j-1] {
+				return errors.New("explicit buckets must be strictly increasing")
+			}
+		}
+	case i.Histogram.Exponential.MaxSize < 0 || i.Histogram.Exponential.MaxSize == 1:
+		return fmt.Errorf("exponential max_size must be 0 or at least 2, got %d", i.Histogram.Exponential.MaxSize)
+	}
+	return nil
+}
+
 var _ component.ConfigValidator = (*Config)(nil)
@@ -309,6 +309,35 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "histogram",
+			expect: &Config{
+				Logs: map[string]MetricInfo{
+					"http.server.duration": {
+						Description:     "Distribution of response times.",
+						SourceAttribute: "response_time_ms",
+						Conditions:      []string{`attributes["response_time_ms"] != nil`},
+						Attributes: []AttributeConfig{
+							{Key: "http.route"},
+						},
+						Histogram: &HistogramConfig{
+							Explicit: &ExplicitHistogramConfig{
+								Buckets: []float64{10, 50, 100, 500, 1000},
+							},
+						},
+					},
+					"http.response.size": {
+						Description:     "Distribution of response sizes.",
+						SourceAttribute: "response_size",
+						Histogram: &HistogramConfig{
+							Exponential: &ExponentialHistogramConfig{
+								MaxSize: 80,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -507,7 +536,7 @@ func TestConfigErrors(t *testing.T) {
 					},
 				},
 			},
-			expect: `spans: metric name missing` + "\n" + `spans: metric source_attribute missing` + "\n" + `spans condition: metric "": unable to parse OTTL condition "invalid condition": condition has invalid syntax: 1:9: unexpected token "condition" (expected <opcomparison> Value)` + "\n" + `spans attributes: metric "": attribute key missing`,
+			expect: `spans: metric name missing` + "\n" + `spans: metric source_attribute missing` + "\n" + `spans condition: metric "": unable to parse OTTL condition "invalid condition": condition has invalid syntax: 1:9: unexpected token "condition" (expected <opin>)` + "\n" + `spans attributes: metric "": attribute key missing`,
 		},
 		{
 			name: "multi_error_spanevent",
@@ -522,7 +551,7 @@ func TestConfigErrors(t *testing.T) {
 					},
 				},
 			},
-			expect: `spanevents: metric name missing` + "\n" + `spanevents: metric source_attribute missing` + "\n" + `spanevents condition: metric "": unable to parse OTTL condition "invalid condition": condition has invalid syntax: 1:9: unexpected token "condition" (expected <opcomparison> Value)` + "\n" + `spanevents attributes: metric "": attribute key missing`,
+			expect: `spanevents: metric name missing` + "\n" + `spanevents: metric source_attribute missing` + "\n" + `spanevents condition: metric "": unable to parse OTTL condition "invalid condition": condition has invalid syntax: 1:9: unexpected token "condition" (expected <opin>)` + "\n" + `spanevents attributes: metric "": attribute key missing`,
 		},
 		{
 			name: "multi_error_metric",
@@ -537,7 +566,62 @@ func TestConfigErrors(t *testing.T) {
 					},
 				},
 			},
-			expect: `metrics: metric name missing` + "\n" + `metrics: metric source_attribute missing` + "\n" + `metrics condition: metric "": unable to parse OTTL condition "invalid condition": condition has invalid syntax: 1:9: unexpected token "condition" (expected <opcomparison> Value)` + "\n" + `metrics attributes not supported: metric ""`,
+			expect: `metrics: metric name missing` + "\n" + `metrics: metric source_attribute missing` + "\n" + `metrics condition: metric "": unable to parse OTTL condition "invalid condition": condition has invalid syntax: 1:9: unexpected token "condition" (expected <opin>)` + "\n" + `metrics attributes not supported: metric ""`,
+		},
+		{
+			name: "histogram_missing_buckets_log",
+			input: &Config{
+				Logs: map[string]MetricInfo{
+					"log.histogram": {
+						SourceAttribute: "my.attribute",
+						Histogram:       &HistogramConfig{},
+					},
+				},
+			},
+			expect: "logs histogram: metric \"log.histogram\": one of `explicit` or `exponential` buckets is required",
+		},
+		{
+			name: "histogram_explicit_and_exponential_span",
+			input: &Config{
+				Spans: map[string]MetricInfo{
+					"span.histogram": {
+						SourceAttribute: "my.attribute",
+						Histogram: &HistogramConfig{
+							Explicit:    &ExplicitHistogramConfig{Buckets: []float64{1, 10}},
+							Exponential: &ExponentialHistogramConfig{},
+						},
+					},
+				},
+			},
+			expect: "spans histogram: metric \"span.histogram\": use either `explicit` or `exponential` buckets histogram",
+		},
+		{
+			name: "histogram_unsorted_buckets_datapoint",
+			input: &Config{
+				DataPoints: map[string]MetricInfo{
+					"datapoint.histogram": {
+						SourceAttribute: "my.attribute",
+						Histogram: &HistogramConfig{
+							Explicit: &ExplicitHistogramConfig{Buckets: []float64{1, 10, 5}},
+						},
+					},
+				},
+			},
+			expect: `datapoints histogram: metric "datapoint.histogram": explicit buckets must be strictly increasing`,
+		},
+		{
+			name: "histogram_invalid_max_size_spanevent",
+			input: &Config{
+				SpanEvents: map[string]MetricInfo{
+					"spanevent.histogram": {
+						SourceAttribute: "my.attribute",
+						Histogram: &HistogramConfig{
+							Exponential: &ExponentialHistogramConfig{MaxSize: 1},
+						},
+					},
+				},
+			},
+			expect: `spanevents histogram: metric "spanevent.histogram": exponential max_size must be 0 or at least 2, got 1`,
 		},
 		{
 			name: "multi_error_datapoint",
@@ -552,7 +636,7 @@ func TestConfigErrors(t *testing.T) {
 					},
 				},
 			},
-			expect: `datapoints: metric name missing` + "\n" + `datapoints: metric source_attribute missing` + "\n" + `datapoints condition: metric "": unable to parse OTTL condition "invalid condition": condition has invalid syntax: 1:9: unexpected token "condition" (expected <opcomparison> Value)` + "\n" + `datapoints attributes: metric "": attribute key missing`,
+			expect: `datapoints: metric name missing` + "\n" + `datapoints: metric source_attribute missing` + "\n" + `datapoints condition: metric "": unable to parse OTTL condition "invalid condition": condition has invalid syntax: 1:9: unexpected token "condition" (expected <opin>)` + "\n" + `datapoints attributes: metric "": attribute key missing`,
 		},
 		{
 			name: "multi_error_log",
@@ -567,7 +651,7 @@ func TestConfigErrors(t *testing.T) {
 					},
 				},
 			},
-			expect: `logs: metric name missing` + "\n" + `logs: metric source_attribute missing` + "\n" + `logs condition: metric "": unable to parse OTTL condition "invalid condition": condition has invalid syntax: 1:9: unexpected token "condition" (expected <opcomparison> Value)` + "\n" + `logs attributes: metric "": attribute key missing`,
+			expect: `logs: metric name missing` + "\n" + `logs: metric source_attribute missing` + "\n" + `logs condition: metric "": unable to parse OTTL condition "invalid condition": condition has invalid syntax: 1:9: unexpected token "condition" (expected <opin>)` + "\n" + `logs attributes: metric "": attribute key missing`,
 		},
 	}
 
@@ -5,6 +5,7 @@ package sumconnector
 
 import (
 	"context"
+	"math"
 	"path/filepath"
 	"testing"
 
@@ -13,6 +14,8 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/connector/connectortest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
@@ -625,3 +628,133 @@ func TestLogsToMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestLogsToHistograms(t *testing.T) {
+	testCases := []struct {
+		name string
+		cfg  *Config
+	}{
+		{
+			name: "explicit_histogram",
+			cfg: &Config{
+				Logs: map[string]MetricInfo{
+					"log.histogram.by_attr": {
+						Description:     "Log histogram by attribute",
+						SourceAttribute: "beep",
+						Attributes: []AttributeConfig{
+							{
+								Key: "log.required",
+							},
+						},
+						Histogram: &HistogramConfig{
+							Explicit: &ExplicitHistogramConfig{
+								Buckets: []float64{1, 2, 5},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "exponential_histogram",
+			cfg: &Config{
+				Logs: map[string]MetricInfo{
+					"log.histogram.if": {
+						Description:     "Log histogram if ...",
+						SourceAttribute: "beep",
+						Conditions: []string{
+							`resource.attributes["resource.optional"] != nil`,
+						},
+						Histogram: &HistogramConfig{
+							Exponential: &ExponentialHistogramConfig{
+								MaxSize: 10,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.cfg.Validate())
+			factory := NewFactory()
+			sink := &consumertest.MetricsSink{}
+			conn, err := factory.CreateLogsToMetrics(context.Background(),
+				connectortest.NewNopSettings(), tc.cfg, sink)
+			require.NoError(t, err)
+			require.NotNil(t, conn)
+			assert.False(t, conn.Capabilities().MutatesData)
+
+			require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				assert.NoError(t, conn.Shutdown(context.Background()))
+			}()
+
+			testLogs, err := golden.ReadLogs(filepath.Join("testdata", "logs", "input.yaml"))
+			assert.NoError(t, err)
+			assert.NoError(t, conn.ConsumeLogs(context.Background(), testLogs))
+
+			allMetrics := sink.AllMetrics()
+			assert.Len(t, allMetrics, 1)
+
+			expected, err := golden.ReadMetrics(filepath.Join("testdata", "logs", tc.name+".yaml"))
+			assert.NoError(t, err)
+			assert.NoError(t, pmetrictest.CompareMetrics(expected, allMetrics[0],
+				pmetrictest.IgnoreTimestamp(),
+				pmetrictest.IgnoreResourceMetricsOrder(),
+				pmetrictest.IgnoreMetricsOrder(),
+				pmetrictest.IgnoreMetricDataPointsOrder()))
+		})
+	}
+}
+
+func TestLogsToHistogramsSkipsNonFiniteValues(t *testing.T) {
+	for _, histogramCfg := range []*HistogramConfig{
+		{Explicit: &ExplicitHistogramConfig{Buckets: []float64{1, 2, 5}}},
+		{Exponential: &ExponentialHistogramConfig{MaxSize: 10}},
+	} {
+		cfg := &Config{
+			Logs: map[string]MetricInfo{
+				"log.histogram": {
+					Description:     "Log histogram",
+					SourceAttribute: "beep",
+					Histogram:       histogramCfg,
+				},
+			},
+		}
+		require.NoError(t, cfg.Validate())
+		sink := &consumertest.MetricsSink{}
+		conn, err := NewFactory().CreateLogsToMetrics(context.Background(),
+			connectortest.NewNopSettings(), cfg, sink)
+		require.NoError(t, err)
+
+		logs := plog.NewLogs()
+		records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+		for _, value := range []string{"NaN", "Inf", "-Inf", "1.5"} {
+			records.AppendEmpty().Attributes().PutStr("beep", value)
+		}
+		records.AppendEmpty().Attributes().PutDouble("beep", math.NaN())
+		records.AppendEmpty().Attributes().PutDouble("beep", math.Inf(1))
+		require.NoError(t, conn.ConsumeLogs(context.Background(), logs))
+
+		require.Len(t, sink.AllMetrics(), 1)
+		metric := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+		var count uint64
+		var sum, minimum, maximum float64
+		if histogramCfg.Explicit != nil {
+			require.Equal(t, pmetric.MetricTypeHistogram, metric.Type())
+			dp := metric.Histogram().DataPoints().At(0)
+			count, sum, minimum, maximum = dp.Count(), dp.Sum(), dp.Min(), dp.Max()
+		} else {
+			require.Equal(t, pmetric.MetricTypeExponentialHistogram, metric.Type())
+			dp := metric.ExponentialHistogram().DataPoints().At(0)
+			count, sum, minimum, maximum = dp.Count(), dp.Sum(), dp.Min(), dp.Max()
+		}
+		assert.Equal(t, uint64(1), count)
+		assert.Equal(t, 1.5, sum)
+		assert.Equal(t, 1.5, minimum)
+		assert.Equal(t, 1.5, maximum)
+	}
+}
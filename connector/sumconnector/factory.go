@@ -53,6 +53,7 @@ func createTracesToMetrics(
 			desc:       info.Description,
 			attrs:      info.Attributes,
 			sourceAttr: info.SourceAttribute,
+			histogram:  info.Histogram,
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -68,6 +69,7 @@ func createTracesToMetrics(
 			desc:       info.Description,
 			attrs:      info.Attributes,
 			sourceAttr: info.SourceAttribute,
+			histogram:  info.Histogram,
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -98,6 +100,7 @@ func createMetricsToMetrics(
 		md := metricDef[ottlmetric.TransformContext]{
 			desc:       info.Description,
 			sourceAttr: info.SourceAttribute,
+			histogram:  info.Histogram,
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -113,6 +116,7 @@ func createMetricsToMetrics(
 			desc:       info.Description,
 			attrs:      info.Attributes,
 			sourceAttr: info.SourceAttribute,
+			histogram:  info.Histogram,
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -144,6 +148,7 @@ func createLogsToMetrics(
 			desc:       info.Description,
 			attrs:      info.Attributes,
 			sourceAttr: info.SourceAttribute,
+			histogram:  info.Histogram,
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -164,4 +169,5 @@ type metricDef[K any] struct {
 	desc       string
 	attrs      []AttributeConfig
 	sourceAttr string
+	histogram  *HistogramConfig
 }
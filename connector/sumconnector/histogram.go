@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sumconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/sumconnector"
+
+import (
+	"math"
+	"sort"
+
+	"github.com/lightstep/go-expohisto/structure"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
+)
+
+// histogram records source attribute values for a single attribute set.
+type histogram interface {
+	record(value float64)
+}
+
+func newHistogram(cfg *HistogramConfig) histogram {
+	if cfg.Exponential != nil {
+		maxSize := structure.DefaultMaxSize
+		if cfg.Exponential.MaxSize != 0 {
+			maxSize = cfg.Exponential.MaxSize
+		}
+		h := new(structure.Histogram[float64])
+		h.Init(structure.NewConfig(structure.WithMaxSize(maxSize)))
+		return &exponentialHistogram{histogram: h}
+	}
+	return &explicitHistogram{
+		bounds:       cfg.Explicit.Buckets,
+		bucketCounts: make([]uint64, len(cfg.Explicit.Buckets)+1),
+		min:          math.Inf(1),
+		max:          math.Inf(-1),
+	}
+}
+
+type explicitHistogram struct {
+	bounds       []float64
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+	min          float64
+	max          float64
+}
+
+func (h *explicitHistogram) record(value float64) {
+	// Bucket i counts the values in (bounds[i-1], bounds[i]]
+	h.bucketCounts[sort.SearchFloat64s(h.bounds, value)]++
+	h.count++
+	h.sum += value
+	h.min = math.Min(h.min, value)
+	h.max = math.Max(h.max, value)
+}
+
+func (h *explicitHistogram) copyTo(dp pmetric.HistogramDataPoint) {
+	dp.SetCount(h.count)
+	dp.SetSum(h.sum)
+	if h.count != 0 {
+		dp.SetMin(h.min)
+		dp.SetMax(h.max)
+	}
+	dp.ExplicitBounds().FromRaw(h.bounds)
+	dp.BucketCounts().FromRaw(h.bucketCounts)
+}
+
+type exponentialHistogram struct {
+	histogram *structure.Histogram[float64]
+}
+
+func (h *exponentialHistogram) record(value float64) {
+	h.histogram.Update(value)
+}
+
+// appendHistogramTo appends the histograms recorded for a metric as a delta histogram of the configured kind.
+func appendHistogramTo(metric pmetric.Metric, cfg *HistogramConfig, hists map[[16]byte]*attrSummer, timestamp pcommon.Timestamp) {
+	if cfg.Exponential != nil {
+		expHist := metric.SetEmptyExponentialHistogram()
+		expHist.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		expHist.DataPoints().EnsureCapacity(len(hists))
+		for _, h := range hists {
+			dp := expHist.DataPoints().AppendEmpty()
+			h.attrs.CopyTo(dp.Attributes())
+			exphistogram.CopyAggregationTo[*structure.Buckets](h.histogram.(*exponentialHistogram).histogram, dp)
+			dp.SetTimestamp(timestamp)
+		}
+		return
+	}
+	explicitHist := metric.SetEmptyHistogram()
+	explicitHist.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	explicitHist.DataPoints().EnsureCapacity(len(hists))
+	for _, h := range hists {
+		dp := explicitHist.DataPoints().AppendEmpty()
+		h.attrs.CopyTo(dp.Attributes())
+		h.histogram.(*explicitHistogram).copyTo(dp)
+		dp.SetTimestamp(timestamp)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
@@ -35,6 +36,8 @@ type summer[K any] struct {
 type attrSummer struct {
 	attrs pcommon.Map
 	sum   float64
+	// histogram is set instead of sum for metrics with a histogram config
+	histogram histogram
 }
 
 func (c *summer[K]) update(ctx context.Context, attrs pcommon.Map, tCtx K) error {
@@ -43,19 +46,28 @@ func (c *summer[K]) update(ctx context.Context, attrs pcommon.Map, tCtx K) error
 		sourceAttribute := md.sourceAttr
 		sumAttrs := pcommon.NewMap()
 		var sumVal float64
+		var hasVal bool
 
 		// Get source attribute value
 		if sourceAttrVal, ok := attrs.Get(sourceAttribute); ok {
-			switch {
-			case sourceAttrVal.Str() != "":
-				sumVal, _ = strconv.ParseFloat(sourceAttrVal.Str(), 64)
-			case sourceAttrVal.Double() != 0:
-				sumVal = sourceAttrVal.Double()
-			case sourceAttrVal.Int() != 0:
-				sumVal = float64(sourceAttrVal.Int())
+			switch sourceAttrVal.Type() {
+			case pcommon.ValueTypeStr:
+				if val, err := strconv.ParseFloat(sourceAttrVal.Str(), 64); err == nil {
+					sumVal, hasVal = val, true
+				}
+			case pcommon.ValueTypeDouble:
+				sumVal, hasVal = sourceAttrVal.Double(), true
+			case pcommon.ValueTypeInt:
+				sumVal, hasVal = float64(sourceAttrVal.Int()), true
 			}
 		}
 
+		// Histograms only record records that carry a finite numeric source attribute,
+		// as NaN or infinite values would poison the sum, min and max
+		if md.histogram != nil && (!hasVal || math.IsNaN(sumVal) || math.IsInf(sumVal, 0)) {
+			continue
+		}
+
 		// Get attribute values to include otherwise use default value
 		for _, attr := range md.attrs {
 			if attrVal, ok := attrs.Get(attr.Key); ok {
@@ -117,6 +129,14 @@ func (c *summer[K]) increment(metricName string, sumVal float64, attrs pcommon.M
 
 	if _, ok := c.sums[metricName][key]; !ok {
 		c.sums[metricName][key] = &attrSummer{attrs: attrs}
+		if cfg := c.metricDefs[metricName].histogram; cfg != nil {
+			c.sums[metricName][key].histogram = newHistogram(cfg)
+		}
+	}
+
+	if h := c.sums[metricName][key].histogram; h != nil {
+		h.record(sumVal)
+		return nil
 	}
 
 	for strings := range c.sums[metricName][key].attrs.AsRaw() {
@@ -140,6 +160,10 @@ func (c *summer[K]) appendMetricsTo(metricSlice pmetric.MetricSlice) {
 		sumMetric := metricSlice.AppendEmpty()
 		sumMetric.SetName(name)
 		sumMetric.SetDescription(md.desc)
+		if md.histogram != nil {
+			appendHistogramTo(sumMetric, md.histogram, c.sums[name], pcommon.NewTimestampFromTime(c.timestamp))
+			continue
+		}
 		sum := sumMetric.SetEmptySum()
 		// The delta value is always positive, so a value accumulated downstream is monotonic
 		sum.SetIsMonotonic(true)
@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
@@ -31,6 +32,9 @@ type Config struct {
 
 	// ResourceToTelemetrySettings defines configuration for converting resource attributes to metric labels.
 	ResourceToTelemetryConfig resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
+
+	// ExponentialHistograms defines how the exponential histograms are converted, as Carbon doesn't support them.
+	ExponentialHistograms exphistogram.Config `mapstructure:"exponential_histograms"`
 }
 
 func (cfg *Config) Validate() error {
@@ -17,6 +17,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
@@ -62,6 +63,11 @@ func TestLoadConfig(t *testing.T) {
 				ResourceToTelemetryConfig: resourcetotelemetry.Settings{
 					Enabled: true,
 				},
+				ExponentialHistograms: exphistogram.Config{
+					ConvertTo:      exphistogram.ConvertToSummary,
+					ExplicitBounds: exphistogram.NewDefaultConfig().ExplicitBounds,
+					Quantiles:      []float64{0.5, 0.99},
+				},
 			},
 		},
 	}
@@ -15,14 +15,16 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/multierr"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
 // newCarbonExporter returns a new Carbon exporter.
 func newCarbonExporter(ctx context.Context, cfg *Config, set exporter.Settings) (exporter.Metrics, error) {
 	sender := carbonSender{
-		writeTimeout: cfg.TimeoutSettings.Timeout,
-		conns:        newConnPool(cfg.TCPAddrConfig, cfg.TimeoutSettings.Timeout, cfg.MaxIdleConns),
+		writeTimeout:  cfg.TimeoutSettings.Timeout,
+		conns:         newConnPool(cfg.TCPAddrConfig, cfg.TimeoutSettings.Timeout, cfg.MaxIdleConns),
+		expHistograms: cfg.ExponentialHistograms,
 	}
 
 	exp, err := exporterhelper.NewMetrics(
@@ -45,12 +47,13 @@ func newCarbonExporter(ctx context.Context, cfg *Config, set exporter.Settings)
 // connections into an implementations of exporterhelper.PushMetricsData so
 // the exporter can leverage the helper and get consistent observability.
 type carbonSender struct {
-	writeTimeout time.Duration
-	conns        connPool
+	writeTimeout  time.Duration
+	conns         connPool
+	expHistograms exphistogram.Config
 }
 
 func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) error {
-	lines := metricDataToPlaintext(md)
+	lines := metricDataToPlaintext(md, cs.expHistograms)
 
 	// There is no way to do a call equivalent to recvfrom with an empty buffer
 	// to check if the connection was terminated (if the size of the buffer is
@@ -27,6 +27,7 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.27.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
@@ -177,7 +178,7 @@ func TestNopConnPool(t *testing.T) {
 
 	conn, err := cp.get()
 	require.NoError(t, err)
-	_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch())))
+	_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch(), exphistogram.NewDefaultConfig())))
 	assert.NoError(t, err)
 	cp.put(conn)
 
@@ -185,7 +186,7 @@ func TestNopConnPool(t *testing.T) {
 	conn2, err2 := cp.get()
 	require.NoError(t, err2)
 	assert.NotSame(t, conn, conn2)
-	_, err = conn2.Write([]byte(metricDataToPlaintext(generateSmallBatch())))
+	_, err = conn2.Write([]byte(metricDataToPlaintext(generateSmallBatch(), exphistogram.NewDefaultConfig())))
 	assert.NoError(t, err)
 	cp.put(conn2)
 
@@ -208,7 +209,7 @@ func TestConnPoolWithIdle(t *testing.T) {
 
 	conn, err := cp.get()
 	require.NoError(t, err)
-	_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch())))
+	_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch(), exphistogram.NewDefaultConfig())))
 	assert.NoError(t, err)
 	cp.put(conn)
 
@@ -216,7 +217,7 @@ func TestConnPoolWithIdle(t *testing.T) {
 	conn2, err2 := cp.get()
 	require.NoError(t, err2)
 	assert.Same(t, conn, conn2)
-	_, err = conn2.Write([]byte(metricDataToPlaintext(generateSmallBatch())))
+	_, err = conn2.Write([]byte(metricDataToPlaintext(generateSmallBatch(), exphistogram.NewDefaultConfig())))
 	assert.NoError(t, err)
 	cp.put(conn2)
 
@@ -255,7 +256,7 @@ func TestConnPoolWithIdleMaxConnections(t *testing.T) {
 	for i := 0; i < maxIdleConns+1; i++ {
 		conn, err := cp.get()
 		require.NoError(t, err)
-		_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch())))
+		_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch(), exphistogram.NewDefaultConfig())))
 		assert.NoError(t, err)
 		if i != maxIdleConns {
 			assert.Same(t, conn, conns[maxIdleConns-i-1])
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 )
 
 // Defaults for not specified configuration settings.
@@ -37,6 +38,8 @@ func createDefaultConfig() component.Config {
 		TimeoutSettings: exporterhelper.NewDefaultTimeoutConfig(),
 		QueueConfig:     exporterhelper.NewDefaultQueueConfig(),
 		RetryConfig:     configretry.NewDefaultBackOffConfig(),
+
+		ExponentialHistograms: exphistogram.NewDefaultConfig(),
 	}
 }
 
@@ -11,6 +11,8 @@ import (
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 )
 
 const (
@@ -79,7 +81,11 @@ var writerPool = sync.Pool{
 //     a single Carbon metric.
 //   - number of time series successfully converted to carbon.
 //   - number of time series that could not be converted to Carbon.
-func metricDataToPlaintext(md pmetric.Metrics) string {
+//
+// The exponential histograms are converted to histograms or summaries as
+// configured by expHistograms, as Carbon has no equivalent, or dropped if
+// they are not converted.
+func metricDataToPlaintext(md pmetric.Metrics, expHistograms exphistogram.Config) string {
 	if md.DataPointCount() == 0 {
 		return ""
 	}
@@ -107,6 +113,15 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 					formatHistogramDataPoints(buf, metric.Name(), metric.Histogram().DataPoints())
 				case pmetric.MetricTypeSummary:
 					formatSummaryDataPoints(buf, metric.Name(), metric.Summary().DataPoints())
+				case pmetric.MetricTypeExponentialHistogram:
+					converted := pmetric.NewMetric()
+					expHistograms.ConvertMetric(metric, converted)
+					switch converted.Type() {
+					case pmetric.MetricTypeHistogram:
+						formatHistogramDataPoints(buf, metric.Name(), converted.Histogram().DataPoints())
+					case pmetric.MetricTypeSummary:
+						formatSummaryDataPoints(buf, metric.Name(), converted.Summary().DataPoints())
+					}
 				}
 			}
 		}
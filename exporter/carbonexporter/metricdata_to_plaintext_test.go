@@ -4,6 +4,7 @@
 package carbonexporter
 
 import (
+	"math"
 	"strconv"
 	"strings"
 	"testing"
@@ -12,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 )
 
 func TestSanitizeTagKey(t *testing.T) {
@@ -270,7 +273,7 @@ func TestToPlaintext(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotLines := metricDataToPlaintext(tt.metricsDataFn())
+			gotLines := metricDataToPlaintext(tt.metricsDataFn(), exphistogram.NewDefaultConfig())
 			got := strings.Split(gotLines, "\n")
 			got = got[:len(got)-1]
 			assert.Len(t, got, len(tt.wantLines)+tt.wantExtraLinesCount)
@@ -279,6 +282,60 @@ func TestToPlaintext(t *testing.T) {
 	}
 }
 
+func TestToPlaintextExponentialHistogram(t *testing.T) {
+	ts := time.Unix(1574092046, 11)
+	expectedTimestampStr := "1574092046"
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("exp_distrib")
+	dp := m.SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	dp.Attributes().PutStr("k0", "v0")
+	dp.SetCount(8)
+	dp.SetSum(24)
+	// the buckets (1, 2], (2, 4] and (4, 8]
+	dp.Positive().BucketCounts().FromRaw([]uint64{2, 4, 2})
+
+	tests := []struct {
+		name      string
+		cfg       exphistogram.Config
+		wantLines []string
+	}{
+		{
+			name: "histogram",
+			cfg:  exphistogram.Config{ConvertTo: exphistogram.ConvertToHistogram, ExplicitBounds: []float64{2, 4}},
+			wantLines: expectedDistributionLines(
+				"exp_distrib", ";k0=v0", expectedTimestampStr,
+				24,
+				8,
+				[]float64{2, 4},
+				[]uint64{2, 4, 2}),
+		},
+		{
+			name: "summary",
+			cfg:  exphistogram.Config{ConvertTo: exphistogram.ConvertToSummary, Quantiles: []float64{0.5, 1}},
+			wantLines: expectedSummaryLines(
+				"exp_distrib", ";k0=v0", expectedTimestampStr,
+				24,
+				8,
+				[]float64{50, 100},
+				[]float64{math.Exp2(1.5), 8}),
+		},
+		{
+			name: "none",
+			cfg:  exphistogram.Config{ConvertTo: exphistogram.ConvertToNone},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotLines := metricDataToPlaintext(md, tt.cfg)
+			got := strings.Split(gotLines, "\n")
+			got = got[:len(got)-1]
+			assert.ElementsMatch(t, tt.wantLines, got)
+		})
+	}
+}
+
 func expectedDistributionLines(
 	metricName string,
 	tags string,
@@ -328,6 +385,6 @@ func BenchmarkConsumeMetricsDefault(b *testing.B) {
 	b.ResetTimer()
 	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
-		assert.Len(b, metricDataToPlaintext(md), 62)
+		assert.Len(b, metricDataToPlaintext(md, exphistogram.NewDefaultConfig()), 62)
 	}
 }
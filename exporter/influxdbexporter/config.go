@@ -12,6 +12,8 @@ import (
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"golang.org/x/exp/maps"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 )
 
 // V1Compatibility is used to specify if the exporter should use the v1.X InfluxDB API schema.
@@ -74,6 +76,10 @@ type Config struct {
 	PayloadMaxLines int `mapstructure:"payload_max_lines"`
 	// PayloadMaxBytes is the maximum number of line protocol bytes to POST in a single request.
 	PayloadMaxBytes int `mapstructure:"payload_max_bytes"`
+
+	// ExponentialHistograms defines how exponential histograms are converted to histograms or summaries,
+	// as the line protocol schemas don't support them.
+	ExponentialHistograms exphistogram.Config `mapstructure:"exponential_histograms"`
 }
 
 func (cfg *Config) Validate() error {
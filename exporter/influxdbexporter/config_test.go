@@ -19,6 +19,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -64,6 +65,11 @@ func TestLoadConfig(t *testing.T) {
 				MetricsSchema:       "telegraf-prometheus-v1",
 				PayloadMaxLines:     72,
 				PayloadMaxBytes:     27,
+				ExponentialHistograms: exphistogram.Config{
+					ConvertTo:      exphistogram.ConvertToSummary,
+					ExplicitBounds: exphistogram.NewDefaultConfig().ExplicitBounds,
+					Quantiles:      []float64{0.5, 0.9},
+				},
 			},
 		},
 	}
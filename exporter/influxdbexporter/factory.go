@@ -16,10 +16,13 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 )
 
 // NewFactory creates a factory for InfluxDB exporter.
@@ -50,6 +53,8 @@ func createDefaultConfig() component.Config {
 		// https://docs.influxdata.com/influxdb/cloud-serverless/write-data/best-practices/optimize-writes/#batch-writes
 		PayloadMaxLines: 10_000,
 		PayloadMaxBytes: 10_000_000,
+
+		ExponentialHistograms: exphistogram.NewDefaultConfig(),
 	}
 }
 
@@ -111,11 +116,17 @@ func createMetricsExporter(ctx context.Context, set exporter.Settings, config co
 		return nil, err
 	}
 
+	pushMetrics := func(ctx context.Context, md pmetric.Metrics) error {
+		cfg.ExponentialHistograms.ConvertMetrics(md)
+		return exp.WriteMetrics(ctx, md)
+	}
+
 	return exporterhelper.NewMetrics(
 		ctx,
 		set,
 		cfg,
-		exp.WriteMetrics,
+		pushMetrics,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: true}),
 		exporterhelper.WithQueue(cfg.QueueSettings),
 		exporterhelper.WithRetry(cfg.BackOffConfig),
 		exporterhelper.WithStart(writer.Start),
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package influxdbexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func Test_createMetricsExporter_exponentialHistograms(t *testing.T) {
+	var body string
+	mockHTTPService := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		body += string(b)
+	}))
+	t.Cleanup(mockHTTPService.Close)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = mockHTTPService.URL
+	cfg.QueueSettings.Enabled = false
+	cfg.BackOffConfig.Enabled = false
+	cfg.ExponentialHistograms.ExplicitBounds = []float64{2, 4}
+
+	exp, err := NewFactory().CreateMetrics(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(context.Background())) })
+
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("exp_histo")
+	eh := m.SetEmptyExponentialHistogram()
+	eh.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := eh.DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.Timestamp(1_000_000_000))
+	dp.SetCount(8)
+	dp.SetSum(24)
+	// the buckets (1, 2], (2, 4] and (4, 8]
+	dp.Positive().BucketCounts().FromRaw([]uint64{2, 4, 2})
+
+	require.NoError(t, exp.ConsumeMetrics(context.Background(), md))
+	// the fields of the line are unordered
+	assert.True(t, strings.HasPrefix(body, "exp_histo "), body)
+	for _, field := range []string{"count=8", "sum=24", "2=2", "4=6", "+Inf=8"} {
+		assert.Contains(t, body, field)
+	}
+}
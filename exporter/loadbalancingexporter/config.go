@@ -4,10 +4,15 @@
 package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
 
 import (
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/exporter/otlphttpexporter"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter"
 )
 
 type routingKey int
@@ -18,14 +23,26 @@ const (
 	metricNameRouting
 	resourceRouting
 	streamIDRouting
+	attrRouting
+	ottlRouting
 )
 
+const (
+	otlpProtocolStr      = "otlp"
+	otlpHTTPProtocolStr  = "otlphttp"
+	otelArrowProtocolStr = "otelarrow"
+)
+
+var errMultipleProtocolsProvided = errors.New("only one protocol should be specified")
+
 const (
 	svcRoutingStr        = "service"
 	traceIDRoutingStr    = "traceID"
 	metricNameRoutingStr = "metric"
 	resourceRoutingStr   = "resource"
 	streamIDRoutingStr   = "streamID"
+	attrRoutingStr       = "attributes"
+	ottlRoutingStr       = "ottl"
 )
 
 // Config defines configuration for the exporter.
@@ -33,11 +50,66 @@ type Config struct {
 	Protocol   Protocol         `mapstructure:"protocol"`
 	Resolver   ResolverSettings `mapstructure:"resolver"`
 	RoutingKey string           `mapstructure:"routing_key"`
+
+	// RoutingAttributes lists the attributes the routing key is built from when the routing_key is "attributes".
+	// Each attribute is looked up in the span, log record or data point attributes first, then in the scope
+	// attributes and finally in the resource attributes.
+	RoutingAttributes []string `mapstructure:"routing_attributes"`
+	// RoutingExpression is the OTTL value expression the routing key is built from when the routing_key is "ottl".
+	// It is evaluated in the span, log or datapoint context, depending on the signal.
+	RoutingExpression string `mapstructure:"routing_expression"`
+
+	// PassiveHealthCheck ejects the backends failing to export, redistributing their keys among the other backends
+	PassiveHealthCheck PassiveHealthCheckSettings `mapstructure:"passive_health_check"`
 }
 
-// Protocol holds the individual protocol-specific settings. Only OTLP is supported at the moment.
+// PassiveHealthCheckSettings defines when a backend is temporarily removed from the ring based on its export results
+type PassiveHealthCheckSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+	// ConsecutiveFailures is the number of consecutive failed exports after which the backend is ejected
+	ConsecutiveFailures int `mapstructure:"consecutive_failures"`
+	// EjectionDuration is how long the backend stays ejected before receiving data again
+	EjectionDuration time.Duration `mapstructure:"ejection_duration"`
+	// MaxEjectionPercent is the maximum percentage of the backends that can be ejected at the same time
+	MaxEjectionPercent int `mapstructure:"max_ejection_percent"`
+}
+
+// Protocol holds the individual protocol-specific settings. Only one protocol can be specified,
+// OTLP over gRPC being used when none is.
 type Protocol struct {
 	OTLP otlpexporter.Config `mapstructure:"otlp"`
+	// OTLPHTTP sends the data to the backends using OTLP over HTTP
+	OTLPHTTP *otlphttpexporter.Config `mapstructure:"otlphttp"`
+	// OTelArrow sends the data to the backends using the OTel Arrow protocol
+	OTelArrow *otelarrowexporter.Config `mapstructure:"otelarrow"`
+}
+
+// Unmarshal a confmap.Conf into the config struct, starting from the defaults of the chosen protocol.
+func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
+	if componentParser == nil {
+		return nil
+	}
+
+	protocols := 0
+	for _, protocol := range []string{otlpProtocolStr, otlpHTTPProtocolStr, otelArrowProtocolStr} {
+		if componentParser.IsSet("protocol::" + protocol) {
+			protocols++
+		}
+	}
+	if protocols > 1 {
+		return errMultipleProtocolsProvided
+	}
+
+	// the protocols other than OTLP are only present when configured, so their defaults are set
+	// before loading the config to keep the values that aren't specified
+	if componentParser.IsSet("protocol::" + otlpHTTPProtocolStr) {
+		cfg.Protocol.OTLPHTTP = defaultOTLPHTTPConfig()
+	}
+	if componentParser.IsSet("protocol::" + otelArrowProtocolStr) {
+		cfg.Protocol.OTelArrow = defaultOTelArrowConfig()
+	}
+
+	return componentParser.Unmarshal(cfg)
 }
 
 // ResolverSettings defines the configurations for the backend resolver
@@ -51,6 +123,12 @@ type ResolverSettings struct {
 // StaticResolver defines the configuration for the resolver providing a fixed list of backends
 type StaticResolver struct {
 	Hostnames []string `mapstructure:"hostnames"`
+	// Weights holds the weight of the hostnames, which is the number of positions they take in the ring.
+	// Hostnames without a weight use the default weight of 100.
+	Weights map[string]int `mapstructure:"weights"`
+	// Priorities holds the priority of the hostnames. Only the healthy hostnames with the lowest priority
+	// value receive data, the others are used when all of them are ejected. Hostnames without a priority use 0.
+	Priorities map[string]int `mapstructure:"priorities"`
 }
 
 // DNSResolver defines the configuration for the DNS resolver
@@ -59,6 +137,9 @@ type DNSResolver struct {
 	Port     string        `mapstructure:"port"`
 	Interval time.Duration `mapstructure:"interval"`
 	Timeout  time.Duration `mapstructure:"timeout"`
+	// RecordType is the type of the DNS records to query, either A (the default, including AAAA records) or SRV.
+	// SRV records provide the port, the weight and the priority of each backend.
+	RecordType string `mapstructure:"record_type"`
 }
 
 // K8sSvcResolver defines the configuration for the DNS resolver
@@ -6,7 +6,9 @@ package loadbalancingexporter
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
@@ -25,3 +27,77 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, sub.Unmarshal(cfg))
 	require.NotNil(t, cfg)
 }
+
+func TestLoadProtocols(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name        string
+		expectedErr error
+		verify      func(t *testing.T, cfg *Config)
+	}{
+		{
+			name: "",
+			verify: func(t *testing.T, cfg *Config) {
+				assert.Nil(t, cfg.Protocol.OTLPHTTP)
+				assert.Nil(t, cfg.Protocol.OTelArrow)
+				assert.Equal(t, time.Second, cfg.Protocol.OTLP.Timeout)
+			},
+		},
+		{
+			name: "otlphttp",
+			verify: func(t *testing.T, cfg *Config) {
+				require.NotNil(t, cfg.Protocol.OTLPHTTP)
+				assert.Nil(t, cfg.Protocol.OTelArrow)
+				assert.Equal(t, 2*time.Second, cfg.Protocol.OTLPHTTP.Timeout)
+				assert.True(t, cfg.Protocol.OTLPHTTP.TLSSetting.Insecure)
+				// the defaults of the protocol are kept
+				assert.Equal(t, defaultOTLPHTTPConfig().RetryConfig, cfg.Protocol.OTLPHTTP.RetryConfig)
+				assert.NoError(t, cfg.Protocol.OTLPHTTP.Validate())
+			},
+		},
+		{
+			name: "otelarrow",
+			verify: func(t *testing.T, cfg *Config) {
+				assert.Nil(t, cfg.Protocol.OTLPHTTP)
+				require.NotNil(t, cfg.Protocol.OTelArrow)
+				assert.Equal(t, 2, cfg.Protocol.OTelArrow.Arrow.NumStreams)
+				assert.Equal(t, defaultOTelArrowConfig().QueueSettings, cfg.Protocol.OTelArrow.QueueSettings)
+				assert.NoError(t, cfg.Protocol.OTelArrow.Validate())
+			},
+		},
+		{
+			name: "attributes",
+			verify: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, attrRoutingStr, cfg.RoutingKey)
+				assert.Equal(t, []string{"tenant.id", "deployment.environment"}, cfg.RoutingAttributes)
+			},
+		},
+		{
+			name: "ottl",
+			verify: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, ottlRoutingStr, cfg.RoutingKey)
+				assert.Equal(t, `resource.attributes["tenant.id"]`, cfg.RoutingExpression)
+			},
+		},
+		{
+			name:        "multiple_protocols",
+			expectedErr: errMultipleProtocolsProvided,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tt.name).String())
+			require.NoError(t, err)
+			err = sub.Unmarshal(cfg)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.verify(t, cfg)
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
 
 import (
+	"encoding/binary"
 	"hash/crc32"
 	"sort"
 )
@@ -29,7 +30,18 @@ type hashRing struct {
 
 // newHashRing builds a new immutable consistent hash ring based on the given endpoints.
 func newHashRing(endpoints []string) *hashRing {
-	items := positionsForEndpoints(endpoints, defaultWeight)
+	return newWeightedHashRing(endpoints, nil)
+}
+
+// newWeightedHashRing builds a new immutable consistent hash ring based on the given endpoints,
+// where each endpoint takes as many positions as its weight. Endpoints without a weight use the default weight.
+func newWeightedHashRing(endpoints []string, weights map[string]int) *hashRing {
+	items := positionsForWeightedEndpoints(endpoints, func(endpoint string) int {
+		if weight, ok := weights[endpoint]; ok && weight > 0 {
+			return weight
+		}
+		return defaultWeight
+	})
 	return &hashRing{
 		items: items,
 	}
@@ -104,7 +116,12 @@ func positionsFor(endpoint string, numPoints int) []position {
 	for i := 0; i < numPoints; i++ {
 		h := crc32.NewIEEE()
 		h.Write([]byte(endpoint))
-		h.Write([]byte{byte(i)})
+		if i <= 0xff {
+			h.Write([]byte{byte(i)})
+		} else {
+			// weights higher than 256 need more than a byte, while the positions of the lower ones stay the same
+			h.Write(binary.BigEndian.AppendUint32(nil, uint32(i)))
+		}
 		hash := h.Sum32()
 		pos := hash % maxPositions
 		res = append(res, position(pos))
@@ -115,11 +132,15 @@ func positionsFor(endpoint string, numPoints int) []position {
 
 // positionsForEndpoints calculates all the positions for all the given endpoints
 func positionsForEndpoints(endpoints []string, weight int) []ringItem {
+	return positionsForWeightedEndpoints(endpoints, func(string) int { return weight })
+}
+
+// positionsForWeightedEndpoints calculates all the positions for all the given endpoints, using the weight of each endpoint
+func positionsForWeightedEndpoints(endpoints []string, weightFor func(string) int) []ringItem {
 	var items []ringItem
 	positions := map[position]bool{} // tracking the used positions
 	for _, endpoint := range endpoints {
-		// for this initial implementation, we don't allow endpoints to have custom weights
-		for _, pos := range positionsFor(endpoint, weight) {
+		for _, pos := range positionsFor(endpoint, weightFor(endpoint)) {
 			// if this position is occupied already, skip this item
 			if _, found := positions[pos]; found {
 				continue
@@ -141,6 +162,21 @@ func positionsForEndpoints(endpoints []string, weight int) []ringItem {
 	return items
 }
 
+// movedKeyspace returns the fraction of the positions in the ring that are assigned to a different endpoint in the candidate ring.
+func (h *hashRing) movedKeyspace(candidate *hashRing) float64 {
+	if h == nil || len(h.items) == 0 || candidate == nil || len(candidate.items) == 0 {
+		return 1
+	}
+
+	var moved uint32
+	for pos := uint32(0); pos < maxPositions; pos++ {
+		if h.findEndpoint(position(pos)) != candidate.findEndpoint(position(pos)) {
+			moved++
+		}
+	}
+	return float64(moved) / float64(maxPositions)
+}
+
 func (h *hashRing) equal(candidate *hashRing) bool {
 	if candidate == nil {
 		return false
@@ -218,3 +218,68 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestNewWeightedHashRing(t *testing.T) {
+	// prepare
+	endpoints := []string{"endpoint-1", "endpoint-2", "endpoint-3"}
+	weights := map[string]int{"endpoint-1": 200, "endpoint-2": 50}
+
+	// test
+	ring := newWeightedHashRing(endpoints, weights)
+
+	// verify
+	count := map[string]int{}
+	for _, item := range ring.items {
+		count[item.endpoint]++
+	}
+	assert.Equal(t, 200, count["endpoint-1"])
+	assert.Equal(t, 50, count["endpoint-2"])
+	assert.Equal(t, defaultWeight, count["endpoint-3"])
+}
+
+func TestMovedKeyspace(t *testing.T) {
+	// prepare
+	ring := newHashRing([]string{"endpoint-1", "endpoint-2"})
+
+	for _, tt := range []struct {
+		desc      string
+		candidate *hashRing
+		expected  func(t *testing.T, moved float64)
+	}{
+		{
+			desc:      "same ring",
+			candidate: newHashRing([]string{"endpoint-1", "endpoint-2"}),
+			expected: func(t *testing.T, moved float64) {
+				assert.Zero(t, moved)
+			},
+		},
+		{
+			desc:      "no ring",
+			candidate: nil,
+			expected: func(t *testing.T, moved float64) {
+				assert.Equal(t, 1.0, moved)
+			},
+		},
+		{
+			desc:      "endpoint added",
+			candidate: newHashRing([]string{"endpoint-1", "endpoint-2", "endpoint-3"}),
+			expected: func(t *testing.T, moved float64) {
+				// only the keys taken by the new endpoint should move
+				assert.Greater(t, moved, 0.0)
+				assert.Less(t, moved, 0.5)
+			},
+		},
+		{
+			desc:      "endpoint removed",
+			candidate: newHashRing([]string{"endpoint-1"}),
+			expected: func(t *testing.T, moved float64) {
+				assert.Greater(t, moved, 0.0)
+				assert.Less(t, moved, 1.0)
+			},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			tt.expected(t, ring.movedKeyspace(tt.candidate))
+		})
+	}
+}
@@ -11,8 +11,10 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/exporter/otlphttpexporter"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter"
 )
 
 // NewFactory creates a factory for the exporter.
@@ -35,9 +37,26 @@ func createDefaultConfig() component.Config {
 		Protocol: Protocol{
 			OTLP: *otlpDefaultCfg,
 		},
+		PassiveHealthCheck: PassiveHealthCheckSettings{
+			ConsecutiveFailures: defaultConsecutiveFailures,
+			EjectionDuration:    defaultEjectionDuration,
+			MaxEjectionPercent:  defaultMaxEjectionPercent,
+		},
 	}
 }
 
+func defaultOTLPHTTPConfig() *otlphttpexporter.Config {
+	otlpHTTPDefaultCfg := otlphttpexporter.NewFactory().CreateDefaultConfig().(*otlphttpexporter.Config)
+	otlpHTTPDefaultCfg.Endpoint = "http://placeholder:4318"
+	return otlpHTTPDefaultCfg
+}
+
+func defaultOTelArrowConfig() *otelarrowexporter.Config {
+	otelArrowDefaultCfg := otelarrowexporter.NewFactory().CreateDefaultConfig().(*otelarrowexporter.Config)
+	otelArrowDefaultCfg.Endpoint = "placeholder:4317"
+	return otelArrowDefaultCfg
+}
+
 func createTracesExporter(_ context.Context, params exporter.Settings, cfg component.Config) (exporter.Traces, error) {
 	return newTracesExporter(params, cfg)
 }
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 )
 
@@ -58,3 +60,39 @@ func TestOTLPConfigIsValid(t *testing.T) {
 	// verify
 	assert.NoError(t, otlpCfg.Validate())
 }
+
+func TestMetricsExporterGetsCreatedWithNonOTLPProtocols(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		protocol Protocol
+	}{
+		{
+			desc:     "otlphttp",
+			protocol: Protocol{OTLPHTTP: defaultOTLPHTTPConfig()},
+		},
+		{
+			desc:     "otelarrow",
+			protocol: Protocol{OTelArrow: defaultOTelArrowConfig()},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// prepare
+			factory := NewFactory()
+			creationParams := exportertest.NewNopSettings()
+			cfg := &Config{
+				Protocol: tt.protocol,
+				Resolver: ResolverSettings{
+					Static: &StaticResolver{Hostnames: []string{"endpoint-1"}},
+				},
+			}
+
+			// test
+			exp, err := factory.CreateMetrics(context.Background(), creationParams, cfg)
+			require.NoError(t, err)
+			require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+			// verify
+			assert.NoError(t, exp.Shutdown(context.Background()))
+		})
+	}
+}
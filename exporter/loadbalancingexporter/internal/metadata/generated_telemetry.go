@@ -29,12 +29,16 @@ func Tracer(settings component.TelemetrySettings) trace.Tracer {
 // TelemetryBuilder provides an interface for components to report telemetry
 // as defined in metadata and user config.
 type TelemetryBuilder struct {
-	meter                         metric.Meter
-	LoadbalancerBackendLatency    metric.Int64Histogram
-	LoadbalancerBackendOutcome    metric.Int64Counter
-	LoadbalancerNumBackendUpdates metric.Int64Counter
-	LoadbalancerNumBackends       metric.Int64Gauge
-	LoadbalancerNumResolutions    metric.Int64Counter
+	meter                          metric.Meter
+	LoadbalancerBackendEjections   metric.Int64Counter
+	LoadbalancerBackendLatency     metric.Int64Histogram
+	LoadbalancerBackendOutcome     metric.Int64Counter
+	LoadbalancerNumBackendUpdates  metric.Int64Counter
+	LoadbalancerNumBackends        metric.Int64Gauge
+	LoadbalancerNumEjectedBackends metric.Int64Gauge
+	LoadbalancerNumResolutions     metric.Int64Counter
+	LoadbalancerNumRingUpdates     metric.Int64Counter
+	LoadbalancerRingMovedKeyspace  metric.Float64Gauge
 }
 
 // TelemetryBuilderOption applies changes to default builder.
@@ -57,6 +61,12 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 	}
 	builder.meter = Meter(settings)
 	var err, errs error
+	builder.LoadbalancerBackendEjections, err = getLeveledMeter(builder.meter, configtelemetry.LevelBasic, settings.MetricsLevel).Int64Counter(
+		"otelcol_loadbalancer_backend_ejections",
+		metric.WithDescription("Number of times a backend was ejected from the hash ring after consecutive export failures."),
+		metric.WithUnit("{ejections}"),
+	)
+	errs = errors.Join(errs, err)
 	builder.LoadbalancerBackendLatency, err = getLeveledMeter(builder.meter, configtelemetry.LevelBasic, settings.MetricsLevel).Int64Histogram(
 		"otelcol_loadbalancer_backend_latency",
 		metric.WithDescription("Response latency in ms for the backends."),
@@ -82,12 +92,30 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 		metric.WithUnit("{backends}"),
 	)
 	errs = errors.Join(errs, err)
+	builder.LoadbalancerNumEjectedBackends, err = getLeveledMeter(builder.meter, configtelemetry.LevelBasic, settings.MetricsLevel).Int64Gauge(
+		"otelcol_loadbalancer_num_ejected_backends",
+		metric.WithDescription("Current number of backends ejected from the hash ring."),
+		metric.WithUnit("{backends}"),
+	)
+	errs = errors.Join(errs, err)
 	builder.LoadbalancerNumResolutions, err = getLeveledMeter(builder.meter, configtelemetry.LevelBasic, settings.MetricsLevel).Int64Counter(
 		"otelcol_loadbalancer_num_resolutions",
 		metric.WithDescription("Number of times the resolver has triggered new resolutions."),
 		metric.WithUnit("{resolutions}"),
 	)
 	errs = errors.Join(errs, err)
+	builder.LoadbalancerNumRingUpdates, err = getLeveledMeter(builder.meter, configtelemetry.LevelBasic, settings.MetricsLevel).Int64Counter(
+		"otelcol_loadbalancer_num_ring_updates",
+		metric.WithDescription("Number of times the hash ring was rebuilt, either because of backend changes or ejections."),
+		metric.WithUnit("{updates}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerRingMovedKeyspace, err = getLeveledMeter(builder.meter, configtelemetry.LevelBasic, settings.MetricsLevel).Float64Gauge(
+		"otelcol_loadbalancer_ring_moved_keyspace",
+		metric.WithDescription("Fraction of the key space assigned to a different backend by the last hash ring update."),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
 	return &builder, errs
 }
 
@@ -9,20 +9,28 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 )
 
 const (
-	defaultPort = "4317"
+	defaultPort     = "4317"
+	defaultHTTPPort = "4318"
+
+	defaultConsecutiveFailures = 5
+	defaultEjectionDuration    = 30 * time.Second
+	defaultMaxEjectionPercent  = 50
 )
 
 var (
 	errNoResolver                = errors.New("no resolvers specified for the exporter")
 	errMultipleResolversProvided = errors.New("only one resolver should be specified")
+	errInvalidHealthCheck        = errors.New("invalid passive health check settings: consecutive_failures and ejection_duration must be positive and max_ejection_percent between 0 and 100")
 )
 
 type componentFactory func(ctx context.Context, endpoint string) (component.Component, error)
@@ -34,8 +42,20 @@ type loadBalancer struct {
 	res  resolver
 	ring *hashRing
 
+	// resolved holds the latest endpoints from the resolver, and metadata their weight and priority
+	resolved []string
+	metadata map[string]endpointMetadata
+
+	healthCheck PassiveHealthCheckSettings
+	// ejected holds the timers readmitting the ejected endpoints, keyed by endpoint
+	ejected map[string]*time.Timer
+
 	componentFactory componentFactory
 	exporters        map[string]*wrappedExporter
+	telemetry        *metadata.TelemetryBuilder
+
+	// defaultPort is the port of the protocol, used for the endpoints without a port
+	defaultPort string
 
 	stopped    bool
 	updateLock sync.RWMutex
@@ -45,6 +65,13 @@ type loadBalancer struct {
 func newLoadBalancer(logger *zap.Logger, cfg component.Config, factory componentFactory, telemetry *metadata.TelemetryBuilder) (*loadBalancer, error) {
 	oCfg := cfg.(*Config)
 
+	if oCfg.PassiveHealthCheck.Enabled {
+		hc := oCfg.PassiveHealthCheck
+		if hc.ConsecutiveFailures <= 0 || hc.EjectionDuration <= 0 || hc.MaxEjectionPercent < 0 || hc.MaxEjectionPercent > 100 {
+			return nil, errInvalidHealthCheck
+		}
+	}
+
 	var count = 0
 	if oCfg.Resolver.DNS != nil {
 		count++
@@ -67,6 +94,8 @@ func newLoadBalancer(logger *zap.Logger, cfg component.Config, factory component
 		var err error
 		res, err = newStaticResolver(
 			oCfg.Resolver.Static.Hostnames,
+			oCfg.Resolver.Static.Weights,
+			oCfg.Resolver.Static.Priorities,
 			telemetry,
 		)
 		if err != nil {
@@ -83,6 +112,7 @@ func newLoadBalancer(logger *zap.Logger, cfg component.Config, factory component
 			oCfg.Resolver.DNS.Port,
 			oCfg.Resolver.DNS.Interval,
 			oCfg.Resolver.DNS.Timeout,
+			oCfg.Resolver.DNS.RecordType,
 			telemetry,
 		)
 		if err != nil {
@@ -131,11 +161,20 @@ func newLoadBalancer(logger *zap.Logger, cfg component.Config, factory component
 		return nil, errNoResolver
 	}
 
+	port := defaultPort
+	if oCfg.Protocol.OTLPHTTP != nil {
+		port = defaultHTTPPort
+	}
+
 	return &loadBalancer{
 		logger:           logger,
 		res:              res,
+		healthCheck:      oCfg.PassiveHealthCheck,
+		ejected:          map[string]*time.Timer{},
 		componentFactory: factory,
 		exporters:        map[string]*wrappedExporter{},
+		defaultPort:      port,
+		telemetry:        telemetry,
 	}, nil
 }
 
@@ -146,26 +185,131 @@ func (lb *loadBalancer) Start(ctx context.Context, host component.Host) error {
 }
 
 func (lb *loadBalancer) onBackendChanges(resolved []string) {
-	newRing := newHashRing(resolved)
+	var md map[string]endpointMetadata
+	if mr, ok := lb.res.(metadataResolver); ok {
+		md = mr.metadata()
+	}
 
-	if !newRing.equal(lb.ring) {
-		lb.updateLock.Lock()
-		defer lb.updateLock.Unlock()
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
 
-		lb.ring = newRing
+	lb.resolved = resolved
+	lb.metadata = md
 
-		// TODO: set a timeout?
-		ctx := context.Background()
+	// the endpoints that are gone don't need to be readmitted anymore
+	for endpoint, timer := range lb.ejected {
+		if !endpointFound(endpoint, resolved) {
+			timer.Stop()
+			delete(lb.ejected, endpoint)
+		}
+	}
+
+	lb.updateRing()
+
+	// TODO: set a timeout?
+	ctx := context.Background()
+
+	// the exporters are kept for all the resolved endpoints, including the ones out of the ring,
+	// so that they are ready when the endpoints are readmitted or promoted
+	lb.addMissingExporters(ctx, resolved)
+	lb.removeExtraExporters(ctx, resolved)
+}
 
-		// add the missing exporters first
-		lb.addMissingExporters(ctx, resolved)
-		lb.removeExtraExporters(ctx, resolved)
+// updateRing rebuilds the ring with the healthy endpoints of the best priority, reporting whether the ring changed.
+// When all the endpoints are ejected, all of them are used, as failing data is better than dropping it.
+// Callers must hold the update lock.
+func (lb *loadBalancer) updateRing() bool {
+	var endpoints []string
+	bestPriority := 0
+	for _, healthOnly := range []bool{true, false} {
+		for _, endpoint := range lb.resolved {
+			if _, ejected := lb.ejected[endpoint]; healthOnly && ejected {
+				continue
+			}
+			priority := lb.metadata[endpoint].priority
+			switch {
+			case len(endpoints) == 0 || priority < bestPriority:
+				endpoints = []string{endpoint}
+				bestPriority = priority
+			case priority == bestPriority:
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+		if len(endpoints) > 0 {
+			break
+		}
 	}
+
+	weights := make(map[string]int, len(lb.metadata))
+	for endpoint, md := range lb.metadata {
+		weights[endpoint] = md.weight
+	}
+	newRing := newWeightedHashRing(endpoints, weights)
+	if newRing.equal(lb.ring) {
+		return false
+	}
+
+	ctx := context.Background()
+	if lb.ring != nil {
+		lb.telemetry.LoadbalancerRingMovedKeyspace.Record(ctx, lb.ring.movedKeyspace(newRing))
+	}
+	lb.telemetry.LoadbalancerNumRingUpdates.Add(ctx, 1)
+	lb.ring = newRing
+	return true
+}
+
+// onExportResult tracks the consecutive failures of the exporter, ejecting its endpoint from the ring
+// once they reach the configured threshold.
+func (lb *loadBalancer) onExportResult(exp *wrappedExporter, endpoint string, err error) {
+	if !lb.healthCheck.Enabled {
+		return
+	}
+	if err == nil {
+		exp.consecutiveFailures.Store(0)
+		return
+	}
+	if exp.consecutiveFailures.Add(1) < int64(lb.healthCheck.ConsecutiveFailures) {
+		return
+	}
+
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
+	if _, ejected := lb.ejected[endpoint]; ejected || lb.stopped || !endpointFound(endpoint, lb.resolved) {
+		return
+	}
+	if (len(lb.ejected)+1)*100 > len(lb.resolved)*lb.healthCheck.MaxEjectionPercent {
+		lb.logger.Warn("not ejecting the failing endpoint, as the maximum number of ejected endpoints was reached", zap.String("endpoint", endpoint))
+		return
+	}
+
+	lb.logger.Warn("ejecting the failing endpoint from the ring", zap.String("endpoint", endpoint),
+		zap.Int64("consecutive_failures", exp.consecutiveFailures.Load()), zap.Duration("duration", lb.healthCheck.EjectionDuration))
+	lb.ejected[endpoint] = time.AfterFunc(lb.healthCheck.EjectionDuration, func() {
+		lb.readmit(exp, endpoint)
+	})
+	lb.telemetry.LoadbalancerBackendEjections.Add(context.Background(), 1, metric.WithAttributeSet(exp.endpointAttr))
+	lb.telemetry.LoadbalancerNumEjectedBackends.Record(context.Background(), int64(len(lb.ejected)))
+	lb.updateRing()
+}
+
+// readmit adds the ejected endpoint back to the ring.
+func (lb *loadBalancer) readmit(exp *wrappedExporter, endpoint string) {
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
+	if _, ejected := lb.ejected[endpoint]; !ejected || lb.stopped {
+		return
+	}
+
+	lb.logger.Info("readmitting the ejected endpoint to the ring", zap.String("endpoint", endpoint))
+	delete(lb.ejected, endpoint)
+	exp.consecutiveFailures.Store(0)
+	lb.telemetry.LoadbalancerNumEjectedBackends.Record(context.Background(), int64(len(lb.ejected)))
+	lb.updateRing()
 }
 
 func (lb *loadBalancer) addMissingExporters(ctx context.Context, endpoints []string) {
 	for _, endpoint := range endpoints {
-		endpoint = endpointWithPort(endpoint)
+		endpoint = endpointWithPort(endpoint, lb.defaultPort)
 
 		if _, exists := lb.exporters[endpoint]; !exists {
 			exp, err := lb.componentFactory(ctx, endpoint)
@@ -183,9 +327,9 @@ func (lb *loadBalancer) addMissingExporters(ctx context.Context, endpoints []str
 	}
 }
 
-func endpointWithPort(endpoint string) string {
+func endpointWithPort(endpoint, port string) string {
 	if !strings.Contains(endpoint, ":") {
-		endpoint = fmt.Sprintf("%s:%s", endpoint, defaultPort)
+		endpoint = fmt.Sprintf("%s:%s", endpoint, port)
 	}
 	return endpoint
 }
@@ -193,7 +337,7 @@ func endpointWithPort(endpoint string) string {
 func (lb *loadBalancer) removeExtraExporters(ctx context.Context, endpoints []string) {
 	endpointsWithPort := make([]string, len(endpoints))
 	for i, e := range endpoints {
-		endpointsWithPort[i] = endpointWithPort(e)
+		endpointsWithPort[i] = endpointWithPort(e, lb.defaultPort)
 	}
 	for existing := range lb.exporters {
 		if !endpointFound(existing, endpointsWithPort) {
@@ -219,7 +363,14 @@ func endpointFound(endpoint string, endpoints []string) bool {
 
 func (lb *loadBalancer) Shutdown(ctx context.Context) error {
 	err := lb.res.shutdown(ctx)
+
+	lb.updateLock.Lock()
 	lb.stopped = true
+	for endpoint, timer := range lb.ejected {
+		timer.Stop()
+		delete(lb.ejected, endpoint)
+	}
+	lb.updateLock.Unlock()
 
 	for _, e := range lb.exporters {
 		err = errors.Join(err, e.Shutdown(ctx))
@@ -235,7 +386,7 @@ func (lb *loadBalancer) exporterAndEndpoint(identifier []byte) (*wrappedExporter
 	lb.updateLock.RLock()
 	defer lb.updateLock.RUnlock()
 	endpoint := lb.ring.endpointFor(identifier)
-	exp, found := lb.exporters[endpointWithPort(endpoint)]
+	exp, found := lb.exporters[endpointWithPort(endpoint, lb.defaultPort)]
 	if !found {
 		// something is really wrong... how come we couldn't find the exporter??
 		return nil, "", fmt.Errorf("couldn't find the exporter for the endpoint %q", endpoint)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -255,7 +256,7 @@ func TestRemoveExtraExporters(t *testing.T) {
 
 	// verify
 	assert.Len(t, p.exporters, 1)
-	assert.NotContains(t, p.exporters, endpointWithPort("endpoint-2"))
+	assert.NotContains(t, p.exporters, endpointWithPort("endpoint-2", defaultPort))
 }
 
 func TestAddMissingExporters(t *testing.T) {
@@ -361,10 +362,31 @@ func TestEndpointWithPort(t *testing.T) {
 			"endpoint-1:55690",
 		},
 	} {
-		assert.Equal(t, tt.expected, endpointWithPort(tt.input))
+		assert.Equal(t, tt.expected, endpointWithPort(tt.input, defaultPort))
 	}
 }
 
+func TestOTLPHTTPDefaultPort(t *testing.T) {
+	// prepare
+	ts, tb := getTelemetryAssets(t)
+	cfg := simpleConfig()
+	cfg.Protocol.OTLPHTTP = defaultOTLPHTTPConfig()
+	var created []string
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		created = append(created, endpoint)
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(ts.Logger, cfg, componentFactory, tb)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2:4319"})
+
+	// verify
+	assert.ElementsMatch(t, []string{"endpoint-1:4318", "endpoint-2:4319"}, created)
+}
+
 func TestFailedExporterInRing(t *testing.T) {
 	// this test is based on the discussion in the original PR for this exporter:
 	// https://github.com/open-telemetry/opentelemetry-collector-contrib/pull/1542#discussion_r521268180
@@ -390,7 +412,7 @@ func TestFailedExporterInRing(t *testing.T) {
 	// this behavior. As the solution would require more locks/syncs/checks, we should probably wait to see
 	// if this is really a problem in the real world
 	resEndpoint := "endpoint-2"
-	delete(p.exporters, endpointWithPort(resEndpoint))
+	delete(p.exporters, endpointWithPort(resEndpoint, defaultPort))
 
 	// sanity check
 	require.Contains(t, p.res.(*staticResolver).endpoints, resEndpoint)
@@ -449,6 +471,153 @@ func TestNewLoadBalancerInvalidServiceAwsResolver(t *testing.T) {
 	assert.True(t, clientcmd.IsConfigurationInvalid(err) || errors.Is(err, errNoServiceName))
 }
 
+func TestNewLoadBalancerInvalidHealthCheck(t *testing.T) {
+	// prepare
+	ts, tb := getTelemetryAssets(t)
+	cfg := simpleConfig()
+	cfg.PassiveHealthCheck = PassiveHealthCheckSettings{Enabled: true, ConsecutiveFailures: 0}
+
+	// test
+	p, err := newLoadBalancer(ts.Logger, cfg, nil, tb)
+
+	// verify
+	require.Nil(t, p)
+	require.Equal(t, errInvalidHealthCheck, err)
+}
+
+func TestPassiveHealthCheckEjection(t *testing.T) {
+	// prepare
+	ts, tb := getTelemetryAssets(t)
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			Static: &StaticResolver{Hostnames: []string{"endpoint-1", "endpoint-2", "endpoint-3", "endpoint-4"}},
+		},
+		PassiveHealthCheck: PassiveHealthCheckSettings{
+			Enabled:             true,
+			ConsecutiveFailures: 2,
+			EjectionDuration:    100 * time.Millisecond,
+			MaxEjectionPercent:  50,
+		},
+	}
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(ts.Logger, cfg, componentFactory, tb)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+	exportErr := errors.New("export failed")
+	fail := func(endpoint string) {
+		p.onExportResult(p.exporters[endpointWithPort(endpoint, defaultPort)], endpoint, exportErr)
+	}
+
+	// test
+	fail("endpoint-1")
+
+	// verify
+	assert.Len(t, ringEndpoints(p), 4, "a single failure should not eject the endpoint")
+
+	// test
+	fail("endpoint-1")
+
+	// verify
+	assert.NotContains(t, ringEndpoints(p), "endpoint-1")
+	assert.Contains(t, p.exporters, endpointWithPort("endpoint-1", defaultPort), "the exporter should be kept while ejected")
+
+	// test
+	// a success resets the consecutive failures
+	fail("endpoint-2")
+	p.onExportResult(p.exporters[endpointWithPort("endpoint-2", defaultPort)], "endpoint-2", nil)
+	fail("endpoint-2")
+
+	// verify
+	assert.Contains(t, ringEndpoints(p), "endpoint-2")
+
+	// test
+	fail("endpoint-2")
+	fail("endpoint-3")
+	fail("endpoint-3")
+
+	// verify
+	assert.ElementsMatch(t, []string{"endpoint-3", "endpoint-4"}, ringEndpoints(p), "no more than half of the endpoints should be ejected")
+
+	// the ejected endpoints are readmitted after the ejection duration
+	assert.Eventually(t, func() bool {
+		p.updateLock.RLock()
+		defer p.updateLock.RUnlock()
+		return len(p.ejected) == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.Len(t, ringEndpoints(p), 4)
+}
+
+func TestPriorityFallback(t *testing.T) {
+	// prepare
+	ts, tb := getTelemetryAssets(t)
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			Static: &StaticResolver{
+				Hostnames:  []string{"endpoint-1", "endpoint-2", "endpoint-3"},
+				Priorities: map[string]int{"endpoint-3": 1},
+			},
+		},
+		PassiveHealthCheck: PassiveHealthCheckSettings{
+			Enabled:             true,
+			ConsecutiveFailures: 1,
+			EjectionDuration:    time.Minute,
+			MaxEjectionPercent:  100,
+		},
+	}
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(ts.Logger, cfg, componentFactory, tb)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+	exportErr := errors.New("export failed")
+	fail := func(endpoint string) {
+		p.onExportResult(p.exporters[endpointWithPort(endpoint, defaultPort)], endpoint, exportErr)
+	}
+
+	// verify
+	assert.ElementsMatch(t, []string{"endpoint-1", "endpoint-2"}, ringEndpoints(p))
+	assert.Len(t, p.exporters, 3, "the exporter of the lower priority endpoint should be ready")
+
+	// test
+	fail("endpoint-1")
+	fail("endpoint-2")
+
+	// verify
+	assert.ElementsMatch(t, []string{"endpoint-3"}, ringEndpoints(p))
+
+	// test
+	fail("endpoint-3")
+
+	// verify
+	assert.ElementsMatch(t, []string{"endpoint-1", "endpoint-2"}, ringEndpoints(p), "all the endpoints are ejected, the best priority should be used")
+}
+
+func ringEndpoints(p *loadBalancer) []string {
+	p.updateLock.RLock()
+	defer p.updateLock.RUnlock()
+
+	var endpoints []string
+	for _, item := range p.ring.items {
+		if !endpointFound(item.endpoint, endpoints) {
+			endpoints = append(endpoints, item.endpoint)
+		}
+	}
+	return endpoints
+}
+
 func newNopMockExporter() *wrappedExporter {
 	return newWrappedExporter(mockComponent{}, "mock")
 }
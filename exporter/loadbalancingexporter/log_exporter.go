@@ -12,7 +12,6 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
-	"go.opentelemetry.io/collector/exporter/otlpexporter"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/otel/metric"
@@ -20,12 +19,17 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 )
 
 var _ exporter.Logs = (*logExporterImp)(nil)
 
 type logExporterImp struct {
-	loadBalancer *loadBalancer
+	loadBalancer      *loadBalancer
+	routingKey        routingKey
+	routingAttributes []string
+	routingExpression *ottl.Statement[ottllog.TransformContext]
 
 	started    bool
 	shutdownWg sync.WaitGroup
@@ -38,10 +42,10 @@ func newLogsExporter(params exporter.Settings, cfg component.Config) (*logExport
 	if err != nil {
 		return nil, err
 	}
-	exporterFactory := otlpexporter.NewFactory()
+	exporterFactory := protocolExporterFactory(cfg.(*Config))
 	cfFunc := func(ctx context.Context, endpoint string) (component.Component, error) {
-		oCfg := buildExporterConfig(cfg.(*Config), endpoint)
-		return exporterFactory.CreateLogs(ctx, params, &oCfg)
+		oCfg := buildProtocolExporterConfig(cfg.(*Config), endpoint)
+		return exporterFactory.CreateLogs(ctx, params, oCfg)
 	}
 
 	lb, err := newLoadBalancer(params.Logger, cfg, cfFunc, telemetry)
@@ -49,10 +53,33 @@ func newLogsExporter(params exporter.Settings, cfg component.Config) (*logExport
 		return nil, err
 	}
 
-	return &logExporterImp{
+	logExporter := logExporterImp{
 		loadBalancer: lb,
+		routingKey:   traceIDRouting,
 		telemetry:    telemetry,
-	}, nil
+	}
+
+	// the logs are routed by their trace ID unless they are routed by attributes or by an OTTL expression,
+	// the other routing keys being meant for the other signals
+	switch cfg.(*Config).RoutingKey {
+	case attrRoutingStr:
+		if len(cfg.(*Config).RoutingAttributes) == 0 {
+			return nil, errNoRoutingAttributes
+		}
+		logExporter.routingKey = attrRouting
+		logExporter.routingAttributes = cfg.(*Config).RoutingAttributes
+	case ottlRoutingStr:
+		parser, err := ottllog.NewParser(routingFunctions[ottllog.TransformContext](), params.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+		logExporter.routingExpression, err = newRoutingExpression(parser, cfg.(*Config).RoutingExpression)
+		if err != nil {
+			return nil, err
+		}
+		logExporter.routingKey = ottlRouting
+	}
+	return &logExporter, nil
 }
 
 func (e *logExporterImp) Capabilities() consumer.Capabilities {
@@ -76,6 +103,18 @@ func (e *logExporterImp) Shutdown(ctx context.Context) error {
 
 func (e *logExporterImp) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 	var errs error
+	if e.routingKey == attrRouting || e.routingKey == ottlRouting {
+		keyed, unkeyed, err := e.splitLogsByRoutingKey(ctx, ld)
+		if err != nil {
+			return err
+		}
+		for key, batch := range keyed {
+			errs = multierr.Append(errs, e.exportLogs(ctx, batch, []byte(key)))
+		}
+		// the logs without a routing key are routed by their trace ID
+		ld = unkeyed
+	}
+
 	batches := batchpersignal.SplitLogs(ld)
 	for _, batch := range batches {
 		errs = multierr.Append(errs, e.consumeLog(ctx, batch))
@@ -94,7 +133,11 @@ func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 		balancingKey = random()
 	}
 
-	le, _, err := e.loadBalancer.exporterAndEndpoint(balancingKey[:])
+	return e.exportLogs(ctx, ld, balancingKey[:])
+}
+
+func (e *logExporterImp) exportLogs(ctx context.Context, ld plog.Logs, balancingKey []byte) error {
+	le, endpoint, err := e.loadBalancer.exporterAndEndpoint(balancingKey)
 	if err != nil {
 		return err
 	}
@@ -105,6 +148,7 @@ func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 	start := time.Now()
 	err = le.ConsumeLogs(ctx, ld)
 	duration := time.Since(start)
+	e.loadBalancer.onExportResult(le, endpoint, err)
 	e.telemetry.LoadbalancerBackendLatency.Record(ctx, duration.Milliseconds(), metric.WithAttributeSet(le.endpointAttr))
 	if err == nil {
 		e.telemetry.LoadbalancerBackendOutcome.Add(ctx, 1, metric.WithAttributeSet(le.successAttr))
@@ -115,6 +159,84 @@ func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 	return err
 }
 
+// splitLogsByRoutingKey groups the log records by the routing key built from their attributes or from the routing
+// expression. The log records for which no routing key could be built are returned apart.
+func (e *logExporterImp) splitLogsByRoutingKey(ctx context.Context, ld plog.Logs) (map[string]plog.Logs, plog.Logs, error) {
+	keyed := map[string]*logsBatch{}
+	unkeyed := &logsBatch{ld: plog.NewLogs()}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			logs := sl.LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				log := logs.At(k)
+
+				var key string
+				var found bool
+				if e.routingKey == attrRouting {
+					key, found = attributesRoutingKey(e.routingAttributes, log.Attributes(), sl.Scope().Attributes(), rl.Resource().Attributes())
+				} else {
+					var err error
+					key, found, err = expressionRoutingKey(ctx, e.routingExpression, ottllog.NewTransformContext(log, sl.Scope(), rl.Resource(), sl, rl))
+					if err != nil {
+						return nil, plog.Logs{}, err
+					}
+				}
+
+				batch := unkeyed
+				if found {
+					batch = keyed[key]
+					if batch == nil {
+						batch = &logsBatch{ld: plog.NewLogs()}
+						keyed[key] = batch
+					}
+				}
+				log.CopyTo(batch.scopeLogsFor(i, j, rl, sl).LogRecords().AppendEmpty())
+			}
+		}
+	}
+
+	results := make(map[string]plog.Logs, len(keyed))
+	for key, batch := range keyed {
+		results[key] = batch.ld
+	}
+	return results, unkeyed.ld, nil
+}
+
+// logsBatch builds a plog.Logs out of log records taken in order from another plog.Logs,
+// without repeating the resource and the scope of consecutive log records.
+type logsBatch struct {
+	ld               plog.Logs
+	sl               plog.ScopeLogs
+	rlIndex, slIndex int
+	hasResourceLogs  bool
+}
+
+func (b *logsBatch) scopeLogsFor(rlIndex, slIndex int, rl plog.ResourceLogs, sl plog.ScopeLogs) plog.ScopeLogs {
+	if b.hasResourceLogs && b.rlIndex == rlIndex && b.slIndex == slIndex {
+		return b.sl
+	}
+
+	var rlClone plog.ResourceLogs
+	if b.hasResourceLogs && b.rlIndex == rlIndex {
+		rlClone = b.ld.ResourceLogs().At(b.ld.ResourceLogs().Len() - 1)
+	} else {
+		rlClone = b.ld.ResourceLogs().AppendEmpty()
+		rl.Resource().CopyTo(rlClone.Resource())
+		rlClone.SetSchemaUrl(rl.SchemaUrl())
+	}
+
+	b.sl = rlClone.ScopeLogs().AppendEmpty()
+	sl.Scope().CopyTo(b.sl.Scope())
+	b.sl.SetSchemaUrl(sl.SchemaUrl())
+	b.rlIndex, b.slIndex, b.hasResourceLogs = rlIndex, slIndex, true
+	return b.sl
+}
+
 func traceIDFromLogs(ld plog.Logs) pcommon.TraceID {
 	rl := ld.ResourceLogs()
 	if rl.Len() == 0 {
@@ -297,6 +297,68 @@ func TestLogsWithoutTraceID(t *testing.T) {
 }
 
 // this test validates that exporter is can concurrently change the endpoints while consuming logs.
+func TestSplitLogsByRoutingKey(t *testing.T) {
+	for _, tt := range []struct {
+		config          *Config
+		expectedKeyed   [][]string
+		expectedUnkeyed []string
+	}{
+		{
+			config:          attrBasedRoutingConfig(),
+			expectedKeyed:   [][]string{{"log-1", "log-3"}, {"log-2"}},
+			expectedUnkeyed: []string{"log-4"},
+		},
+		{
+			config:          ottlBasedRoutingConfig(),
+			expectedKeyed:   [][]string{{"log-1", "log-2", "log-3"}},
+			expectedUnkeyed: []string{"log-4"},
+		},
+	} {
+		t.Run(tt.config.RoutingKey, func(t *testing.T) {
+			// prepare
+			p, err := newLogsExporter(exportertest.NewNopSettings(), tt.config)
+			require.NoError(t, err)
+
+			ld := plog.NewLogs()
+			rl := ld.ResourceLogs().AppendEmpty()
+			rl.Resource().Attributes().PutStr("tenant.id", "tenant-1")
+			records := rl.ScopeLogs().AppendEmpty().LogRecords()
+			records.AppendEmpty().Body().SetStr("log-1")
+			records.AppendEmpty().Body().SetStr("log-2")
+			records.AppendEmpty().Body().SetStr("log-3")
+			records.At(1).Attributes().PutStr("tenant.id", "tenant-2")
+			rl = ld.ResourceLogs().AppendEmpty()
+			rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log-4")
+
+			// test
+			keyed, unkeyed, err := p.splitLogsByRoutingKey(context.Background(), ld)
+
+			// verify
+			require.NoError(t, err)
+			var keyedBodies [][]string
+			for _, batch := range keyed {
+				assert.Equal(t, 1, batch.ResourceLogs().Len(), "consecutive records should share their resource")
+				keyedBodies = append(keyedBodies, logBodies(batch))
+			}
+			assert.ElementsMatch(t, tt.expectedKeyed, keyedBodies)
+			assert.Equal(t, tt.expectedUnkeyed, logBodies(unkeyed))
+		})
+	}
+}
+
+func logBodies(ld plog.Logs) []string {
+	var bodies []string
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		for j := 0; j < ld.ResourceLogs().At(i).ScopeLogs().Len(); j++ {
+			records := ld.ResourceLogs().At(i).ScopeLogs().At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				bodies = append(bodies, records.At(k).Body().AsString())
+			}
+		}
+	}
+	return bodies
+}
+
 func TestConsumeLogs_ConcurrentResolverChange(t *testing.T) {
 	ts, tb := getTelemetryAssets(t)
 	consumeStarted := make(chan struct{})
@@ -359,7 +421,7 @@ func TestRollingUpdatesWhenConsumeLogs(t *testing.T) {
 	// simulate rolling updates, the dns resolver should resolve in the following order
 	// ["127.0.0.1"] -> ["127.0.0.1", "127.0.0.2"] -> ["127.0.0.2"]
 	ts, tb := getTelemetryAssets(t)
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, tb)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, "", tb)
 	require.NoError(t, err)
 
 	mu := sync.Mutex{}
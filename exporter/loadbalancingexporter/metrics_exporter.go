@@ -13,7 +13,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
-	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	conventions "go.opentelemetry.io/collector/semconv/v1.27.0"
 	"go.opentelemetry.io/otel/metric"
@@ -22,13 +22,17 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/identity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 )
 
 var _ exporter.Metrics = (*metricExporterImp)(nil)
 
 type metricExporterImp struct {
-	loadBalancer *loadBalancer
-	routingKey   routingKey
+	loadBalancer      *loadBalancer
+	routingKey        routingKey
+	routingAttributes []string
+	routingExpression *ottl.Statement[ottldatapoint.TransformContext]
 
 	stopped    bool
 	shutdownWg sync.WaitGroup
@@ -40,10 +44,10 @@ func newMetricsExporter(params exporter.Settings, cfg component.Config) (*metric
 	if err != nil {
 		return nil, err
 	}
-	exporterFactory := otlpexporter.NewFactory()
+	exporterFactory := protocolExporterFactory(cfg.(*Config))
 	cfFunc := func(ctx context.Context, endpoint string) (component.Component, error) {
-		oCfg := buildExporterConfig(cfg.(*Config), endpoint)
-		return exporterFactory.CreateMetrics(ctx, params, &oCfg)
+		oCfg := buildProtocolExporterConfig(cfg.(*Config), endpoint)
+		return exporterFactory.CreateMetrics(ctx, params, oCfg)
 	}
 
 	lb, err := newLoadBalancer(params.Logger, cfg, cfFunc, telemetry)
@@ -67,6 +71,22 @@ func newMetricsExporter(params exporter.Settings, cfg component.Config) (*metric
 		metricExporter.routingKey = metricNameRouting
 	case streamIDRoutingStr:
 		metricExporter.routingKey = streamIDRouting
+	case attrRoutingStr:
+		if len(cfg.(*Config).RoutingAttributes) == 0 {
+			return nil, errNoRoutingAttributes
+		}
+		metricExporter.routingKey = attrRouting
+		metricExporter.routingAttributes = cfg.(*Config).RoutingAttributes
+	case ottlRoutingStr:
+		parser, err := ottldatapoint.NewParser(routingFunctions[ottldatapoint.TransformContext](), params.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+		metricExporter.routingExpression, err = newRoutingExpression(parser, cfg.(*Config).RoutingExpression)
+		if err != nil {
+			return nil, err
+		}
+		metricExporter.routingKey = ottlRouting
 	default:
 		return nil, fmt.Errorf("unsupported routing_key: %q", cfg.(*Config).RoutingKey)
 	}
@@ -104,6 +124,12 @@ func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 		batches = splitMetricsByMetricName(md)
 	case streamIDRouting:
 		batches = splitMetricsByStreamID(md)
+	case attrRouting, ottlRouting:
+		var err error
+		batches, err = e.splitMetricsByRoutingKey(ctx, md)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Now assign each batch to an exporter, and merge as we go
@@ -135,6 +161,7 @@ func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 
 		exp.consumeWG.Done()
 		errs = multierr.Append(errs, err)
+		e.loadBalancer.onExportResult(exp, exporterEndpoints[exp], err)
 		e.telemetry.LoadbalancerBackendLatency.Record(ctx, duration.Milliseconds(), metric.WithAttributeSet(exp.endpointAttr))
 		if err == nil {
 			e.telemetry.LoadbalancerBackendOutcome.Add(ctx, 1, metric.WithAttributeSet(exp.successAttr))
@@ -352,6 +379,143 @@ func splitMetricsByStreamID(md pmetric.Metrics) map[string]pmetric.Metrics {
 	return results
 }
 
+// splitMetricsByRoutingKey groups the data points by the routing key built from their attributes or from the routing
+// expression. The data points for which no routing key could be built are grouped by their stream ID.
+func (e *metricExporterImp) splitMetricsByRoutingKey(ctx context.Context, md pmetric.Metrics) (map[string]pmetric.Metrics, error) {
+	results := map[string]pmetric.Metrics{}
+	add := func(key string, newMD pmetric.Metrics) {
+		if existing, ok := results[key]; ok {
+			metrics.Merge(existing, newMD)
+		} else {
+			results[key] = newMD
+		}
+	}
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				m := sm.Metrics().At(k)
+				metricID := identity.OfResourceMetric(rm.Resource(), sm.Scope(), m)
+
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					gauge := m.Gauge()
+
+					for l := 0; l < gauge.DataPoints().Len(); l++ {
+						dp := gauge.DataPoints().At(l)
+						key, err := dataPointRoutingKey(ctx, e, rm, sm, m, metricID, dp)
+						if err != nil {
+							return nil, err
+						}
+
+						newMD, mClone := cloneMetricWithoutType(rm, sm, m)
+						dp.CopyTo(mClone.SetEmptyGauge().DataPoints().AppendEmpty())
+						add(key, newMD)
+					}
+				case pmetric.MetricTypeSum:
+					sum := m.Sum()
+
+					for l := 0; l < sum.DataPoints().Len(); l++ {
+						dp := sum.DataPoints().At(l)
+						key, err := dataPointRoutingKey(ctx, e, rm, sm, m, metricID, dp)
+						if err != nil {
+							return nil, err
+						}
+
+						newMD, mClone := cloneMetricWithoutType(rm, sm, m)
+						sumClone := mClone.SetEmptySum()
+						sumClone.SetIsMonotonic(sum.IsMonotonic())
+						sumClone.SetAggregationTemporality(sum.AggregationTemporality())
+						dp.CopyTo(sumClone.DataPoints().AppendEmpty())
+						add(key, newMD)
+					}
+				case pmetric.MetricTypeHistogram:
+					histogram := m.Histogram()
+
+					for l := 0; l < histogram.DataPoints().Len(); l++ {
+						dp := histogram.DataPoints().At(l)
+						key, err := dataPointRoutingKey(ctx, e, rm, sm, m, metricID, dp)
+						if err != nil {
+							return nil, err
+						}
+
+						newMD, mClone := cloneMetricWithoutType(rm, sm, m)
+						histogramClone := mClone.SetEmptyHistogram()
+						histogramClone.SetAggregationTemporality(histogram.AggregationTemporality())
+						dp.CopyTo(histogramClone.DataPoints().AppendEmpty())
+						add(key, newMD)
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					expHistogram := m.ExponentialHistogram()
+
+					for l := 0; l < expHistogram.DataPoints().Len(); l++ {
+						dp := expHistogram.DataPoints().At(l)
+						key, err := dataPointRoutingKey(ctx, e, rm, sm, m, metricID, dp)
+						if err != nil {
+							return nil, err
+						}
+
+						newMD, mClone := cloneMetricWithoutType(rm, sm, m)
+						expHistogramClone := mClone.SetEmptyExponentialHistogram()
+						expHistogramClone.SetAggregationTemporality(expHistogram.AggregationTemporality())
+						dp.CopyTo(expHistogramClone.DataPoints().AppendEmpty())
+						add(key, newMD)
+					}
+				case pmetric.MetricTypeSummary:
+					summary := m.Summary()
+
+					for l := 0; l < summary.DataPoints().Len(); l++ {
+						dp := summary.DataPoints().At(l)
+						key, err := dataPointRoutingKey(ctx, e, rm, sm, m, metricID, dp)
+						if err != nil {
+							return nil, err
+						}
+
+						newMD, mClone := cloneMetricWithoutType(rm, sm, m)
+						dp.CopyTo(mClone.SetEmptySummary().DataPoints().AppendEmpty())
+						add(key, newMD)
+					}
+				}
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// dataPointRoutingKey builds the routing key of the data point from its attributes or from the routing expression,
+// falling back to its stream ID.
+func dataPointRoutingKey[DP interface{ Attributes() pcommon.Map }](
+	ctx context.Context,
+	e *metricExporterImp,
+	rm pmetric.ResourceMetrics,
+	sm pmetric.ScopeMetrics,
+	m pmetric.Metric,
+	metricID identity.Metric,
+	dp DP,
+) (string, error) {
+	var key string
+	var found bool
+	if e.routingKey == attrRouting {
+		key, found = attributesRoutingKey(e.routingAttributes, dp.Attributes(), sm.Scope().Attributes(), rm.Resource().Attributes())
+	} else {
+		var err error
+		tCtx := ottldatapoint.NewTransformContext(dp, m, sm.Metrics(), sm.Scope(), rm.Resource(), sm, rm)
+		key, found, err = expressionRoutingKey(ctx, e.routingExpression, tCtx)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !found {
+		return identity.OfStream(metricID, dp).String(), nil
+	}
+	return key, nil
+}
+
 func cloneMetricWithoutType(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) (md pmetric.Metrics, mClone pmetric.Metric) {
 	md = pmetric.NewMetrics()
 
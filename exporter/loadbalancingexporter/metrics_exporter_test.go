@@ -310,6 +310,70 @@ func TestSplitMetrics(t *testing.T) {
 	}
 }
 
+func TestSplitMetricsByRoutingKey(t *testing.T) {
+	for _, tt := range []struct {
+		config   *Config
+		expected [][]string
+	}{
+		{
+			config:   attrBasedRoutingConfig(),
+			expected: [][]string{{"gauge", "sum"}, {"gauge"}, {"histogram"}},
+		},
+		{
+			config:   ottlBasedRoutingConfig(),
+			expected: [][]string{{"gauge", "sum"}, {"histogram"}},
+		},
+	} {
+		t.Run(tt.config.RoutingKey, func(t *testing.T) {
+			// prepare
+			ts, _ := getTelemetryAssets(t)
+			p, err := newMetricsExporter(ts, tt.config)
+			require.NoError(t, err)
+
+			md := pmetric.NewMetrics()
+			rm := md.ResourceMetrics().AppendEmpty()
+			rm.Resource().Attributes().PutStr("tenant.id", "tenant-1")
+			metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+			gauge := metrics.AppendEmpty()
+			gauge.SetName("gauge")
+			gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+			dp := gauge.Gauge().DataPoints().AppendEmpty()
+			dp.SetIntValue(2)
+			dp.Attributes().PutStr("tenant.id", "tenant-2")
+			sum := metrics.AppendEmpty()
+			sum.SetName("sum")
+			sum.SetEmptySum().DataPoints().AppendEmpty().SetIntValue(3)
+			histogram := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+			histogram.SetName("histogram")
+			histogram.SetEmptyHistogram().DataPoints().AppendEmpty().SetCount(4)
+
+			// test
+			batches, err := p.splitMetricsByRoutingKey(context.Background(), md)
+
+			// verify
+			require.NoError(t, err)
+			var names [][]string
+			for _, batch := range batches {
+				names = append(names, metricNames(batch))
+			}
+			assert.ElementsMatch(t, tt.expected, names)
+		})
+	}
+}
+
+func metricNames(md pmetric.Metrics) []string {
+	var names []string
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		for j := 0; j < md.ResourceMetrics().At(i).ScopeMetrics().Len(); j++ {
+			metrics := md.ResourceMetrics().At(i).ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				names = append(names, metrics.At(k).Name())
+			}
+		}
+	}
+	return names
+}
+
 func TestConsumeMetrics_SingleEndpoint(t *testing.T) {
 	ts, tb := getTelemetryAssets(t)
 	t.Parallel()
@@ -742,7 +806,7 @@ func TestRollingUpdatesWhenConsumeMetrics(t *testing.T) {
 
 	// simulate rolling updates, the dns resolver should resolve in the following order
 	// ["127.0.0.1"] -> ["127.0.0.1", "127.0.0.2"] -> ["127.0.0.2"]
-	res, err := newDNSResolver(ts.Logger, "service-1", "", 5*time.Second, 1*time.Second, tb)
+	res, err := newDNSResolver(ts.Logger, "service-1", "", 5*time.Second, 1*time.Second, "", tb)
 	require.NoError(t, err)
 
 	mu := sync.Mutex{}
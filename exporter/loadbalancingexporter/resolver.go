@@ -23,3 +23,18 @@ type resolver interface {
 	// Make sure to register the callbacks before starting the exporter.
 	onChange(func([]string))
 }
+
+// endpointMetadata holds the routing settings of an endpoint, as provided by the resolver.
+type endpointMetadata struct {
+	// weight is the number of positions of the endpoint in the ring
+	weight int
+	// priority of the endpoint, the endpoints with the lowest value are preferred
+	priority int
+}
+
+// metadataResolver is implemented by the resolvers able to provide the weight and the priority of the endpoints.
+type metadataResolver interface {
+	// metadata returns the routing settings for the current endpoints, keyed by endpoint.
+	// Endpoints without an entry use the default weight and a priority of 0.
+	metadata() map[string]endpointMetadata
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,15 +21,22 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 )
 
-var _ resolver = (*dnsResolver)(nil)
+var (
+	_ resolver         = (*dnsResolver)(nil)
+	_ metadataResolver = (*dnsResolver)(nil)
+)
 
 const (
 	defaultResInterval = 5 * time.Second
 	defaultResTimeout  = time.Second
+
+	aRecordType   = "A"
+	srvRecordType = "SRV"
 )
 
 var (
-	errNoHostname = errors.New("no hostname specified to resolve the backends")
+	errNoHostname        = errors.New("no hostname specified to resolve the backends")
+	errUnknownRecordType = errors.New("unknown DNS record type, must be either A or SRV")
 
 	dnsResolverAttr           = attribute.String("resolver", "dns")
 	dnsResolverAttrSet        = attribute.NewSet(dnsResolverAttr)
@@ -43,8 +52,10 @@ type dnsResolver struct {
 	resolver    netResolver
 	resInterval time.Duration
 	resTimeout  time.Duration
+	srv         bool
 
 	endpoints         []string
+	endpointsMetadata map[string]endpointMetadata
 	onChangeCallbacks []func([]string)
 
 	stopCh             chan (struct{})
@@ -56,6 +67,7 @@ type dnsResolver struct {
 
 type netResolver interface {
 	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
 }
 
 func newDNSResolver(
@@ -64,11 +76,20 @@ func newDNSResolver(
 	port string,
 	interval time.Duration,
 	timeout time.Duration,
+	recordType string,
 	tb *metadata.TelemetryBuilder,
 ) (*dnsResolver, error) {
 	if len(hostname) == 0 {
 		return nil, errNoHostname
 	}
+	var srv bool
+	switch strings.ToUpper(recordType) {
+	case aRecordType, "":
+	case srvRecordType:
+		srv = true
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownRecordType, recordType)
+	}
 	if interval == 0 {
 		interval = defaultResInterval
 	}
@@ -83,6 +104,7 @@ func newDNSResolver(
 		resolver:    &net.Resolver{},
 		resInterval: interval,
 		resTimeout:  timeout,
+		srv:         srv,
 		stopCh:      make(chan struct{}),
 		telemetry:   tb,
 	}, nil
@@ -134,7 +156,14 @@ func (r *dnsResolver) resolve(ctx context.Context) ([]string, error) {
 	r.shutdownWg.Add(1)
 	defer r.shutdownWg.Done()
 
-	addrs, err := r.resolver.LookupIPAddr(ctx, r.hostname)
+	var backends []string
+	var backendsMetadata map[string]endpointMetadata
+	var err error
+	if r.srv {
+		backends, backendsMetadata, err = r.lookupSRV(ctx)
+	} else {
+		backends, err = r.lookupIPAddr(ctx)
+	}
 	if err != nil {
 		r.telemetry.LoadbalancerNumResolutions.Add(ctx, 1, metric.WithAttributeSet(dnsResolverFailureAttrSet))
 		return nil, err
@@ -142,6 +171,38 @@ func (r *dnsResolver) resolve(ctx context.Context) ([]string, error) {
 
 	r.telemetry.LoadbalancerNumResolutions.Add(ctx, 1, metric.WithAttributeSet(dnsResolverSuccessAttrSet))
 
+	// keep it always in the same order
+	sort.Strings(backends)
+
+	r.updateLock.Lock()
+	if equalStringSlice(r.endpoints, backends) && equalMetadata(r.endpointsMetadata, backendsMetadata) {
+		r.updateLock.Unlock()
+		return r.endpoints, nil
+	}
+
+	// the list has changed!
+	r.endpoints = backends
+	r.endpointsMetadata = backendsMetadata
+	r.updateLock.Unlock()
+	r.telemetry.LoadbalancerNumBackends.Record(ctx, int64(len(backends)), metric.WithAttributeSet(dnsResolverAttrSet))
+	r.telemetry.LoadbalancerNumBackendUpdates.Add(ctx, 1, metric.WithAttributeSet(dnsResolverAttrSet))
+
+	// propagate the change
+	r.changeCallbackLock.RLock()
+	for _, callback := range r.onChangeCallbacks {
+		callback(r.endpoints)
+	}
+	r.changeCallbackLock.RUnlock()
+
+	return r.endpoints, nil
+}
+
+func (r *dnsResolver) lookupIPAddr(ctx context.Context) ([]string, error) {
+	addrs, err := r.resolver.LookupIPAddr(ctx, r.hostname)
+	if err != nil {
+		return nil, err
+	}
+
 	backends := make([]string, len(addrs))
 	for i, ip := range addrs {
 		var backend string
@@ -159,29 +220,44 @@ func (r *dnsResolver) resolve(ctx context.Context) ([]string, error) {
 
 		backends[i] = backend
 	}
+	return backends, nil
+}
 
-	// keep it always in the same order
-	sort.Strings(backends)
-
-	if equalStringSlice(r.endpoints, backends) {
-		return r.endpoints, nil
+// lookupSRV resolves the backends from the SRV records of the hostname, which provide the weight and
+// the priority of each backend. The port of the records is used unless a port is specified in the configuration.
+func (r *dnsResolver) lookupSRV(ctx context.Context) ([]string, map[string]endpointMetadata, error) {
+	_, records, err := r.resolver.LookupSRV(ctx, "", "", r.hostname)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// the list has changed!
-	r.updateLock.Lock()
-	r.endpoints = backends
-	r.updateLock.Unlock()
-	r.telemetry.LoadbalancerNumBackends.Record(ctx, int64(len(backends)), metric.WithAttributeSet(dnsResolverAttrSet))
-	r.telemetry.LoadbalancerNumBackendUpdates.Add(ctx, 1, metric.WithAttributeSet(dnsResolverAttrSet))
+	backends := make([]string, 0, len(records))
+	backendsMetadata := make(map[string]endpointMetadata, len(records))
+	for _, record := range records {
+		port := strconv.FormatUint(uint64(record.Port), 10)
+		if r.port != "" {
+			port = r.port
+		}
+		backend := net.JoinHostPort(strings.TrimSuffix(record.Target, "."), port)
+		if _, found := backendsMetadata[backend]; found {
+			continue
+		}
 
-	// propagate the change
-	r.changeCallbackLock.RLock()
-	for _, callback := range r.onChangeCallbacks {
-		callback(r.endpoints)
+		md := endpointMetadata{weight: int(record.Weight), priority: int(record.Priority)}
+		if md.weight == 0 {
+			// a weight of 0 means that no weight was chosen for the backend
+			md.weight = defaultWeight
+		}
+		backends = append(backends, backend)
+		backendsMetadata[backend] = md
 	}
-	r.changeCallbackLock.RUnlock()
+	return backends, backendsMetadata, nil
+}
 
-	return r.endpoints, nil
+func (r *dnsResolver) metadata() map[string]endpointMetadata {
+	r.updateLock.Lock()
+	defer r.updateLock.Unlock()
+	return r.endpointsMetadata
 }
 
 func (r *dnsResolver) onChange(f func([]string)) {
@@ -190,6 +266,18 @@ func (r *dnsResolver) onChange(f func([]string)) {
 	r.onChangeCallbacks = append(r.onChangeCallbacks, f)
 }
 
+func equalMetadata(source, candidate map[string]endpointMetadata) bool {
+	if len(source) != len(candidate) {
+		return false
+	}
+	for endpoint, md := range source {
+		if c, ok := candidate[endpoint]; !ok || c != md {
+			return false
+		}
+	}
+	return true
+}
+
 func equalStringSlice(source, candidate []string) bool {
 	if len(source) != len(candidate) {
 		return false
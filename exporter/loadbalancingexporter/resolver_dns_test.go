@@ -20,7 +20,7 @@ import (
 func TestInitialDNSResolution(t *testing.T) {
 	// prepare
 	_, tb := getTelemetryAssets(t)
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, tb)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, "", tb)
 	require.NoError(t, err)
 
 	res.resolver = &mockDNSResolver{
@@ -53,7 +53,7 @@ func TestInitialDNSResolution(t *testing.T) {
 func TestInitialDNSResolutionWithPort(t *testing.T) {
 	// prepare
 	_, tb := getTelemetryAssets(t)
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "55690", 5*time.Second, 1*time.Second, tb)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "55690", 5*time.Second, 1*time.Second, "", tb)
 	require.NoError(t, err)
 
 	res.resolver = &mockDNSResolver{
@@ -86,17 +86,61 @@ func TestInitialDNSResolutionWithPort(t *testing.T) {
 func TestErrNoHostname(t *testing.T) {
 	// test
 	_, tb := getTelemetryAssets(t)
-	res, err := newDNSResolver(zap.NewNop(), "", "", 5*time.Second, 1*time.Second, tb)
+	res, err := newDNSResolver(zap.NewNop(), "", "", 5*time.Second, 1*time.Second, "", tb)
 
 	// verify
 	assert.Nil(t, res)
 	assert.Equal(t, errNoHostname, err)
 }
 
+func TestErrUnknownRecordType(t *testing.T) {
+	// test
+	_, tb := getTelemetryAssets(t)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, "CNAME", tb)
+
+	// verify
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, errUnknownRecordType)
+}
+
+func TestInitialSRVResolution(t *testing.T) {
+	// prepare
+	_, tb := getTelemetryAssets(t)
+	res, err := newDNSResolver(zap.NewNop(), "_otlp._tcp.service-1", "", 5*time.Second, 1*time.Second, "srv", tb)
+	require.NoError(t, err)
+
+	res.resolver = &mockDNSResolver{
+		onLookupSRV: func(_ context.Context, name string) ([]*net.SRV, error) {
+			assert.Equal(t, "_otlp._tcp.service-1", name)
+			return []*net.SRV{
+				{Target: "collector-1.service-1.", Port: 4317, Priority: 1, Weight: 200},
+				{Target: "collector-0.service-1.", Port: 4317, Priority: 0, Weight: 0},
+			}, nil
+		},
+	}
+
+	// test
+	var resolved []string
+	res.onChange(func(endpoints []string) {
+		resolved = endpoints
+	})
+	require.NoError(t, res.start(context.Background()))
+	defer func() {
+		require.NoError(t, res.shutdown(context.Background()))
+	}()
+
+	// verify
+	assert.Equal(t, []string{"collector-0.service-1:4317", "collector-1.service-1:4317"}, resolved)
+	assert.Equal(t, map[string]endpointMetadata{
+		"collector-0.service-1:4317": {weight: defaultWeight},
+		"collector-1.service-1:4317": {weight: 200, priority: 1},
+	}, res.metadata())
+}
+
 func TestCantResolve(t *testing.T) {
 	// prepare
 	_, tb := getTelemetryAssets(t)
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, tb)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, "", tb)
 	require.NoError(t, err)
 
 	expectedErr := errors.New("some expected error")
@@ -117,7 +161,7 @@ func TestCantResolve(t *testing.T) {
 func TestOnChange(t *testing.T) {
 	// prepare
 	_, tb := getTelemetryAssets(t)
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, tb)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, "", tb)
 	require.NoError(t, err)
 
 	resolve := []net.IPAddr{
@@ -185,7 +229,7 @@ func TestEqualStringSlice(t *testing.T) {
 func TestPeriodicallyResolve(t *testing.T) {
 	// prepare
 	_, tb := getTelemetryAssets(t)
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 10*time.Millisecond, 1*time.Second, tb)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 10*time.Millisecond, 1*time.Second, "", tb)
 	require.NoError(t, err)
 
 	counter := &atomic.Int64{}
@@ -244,7 +288,7 @@ func TestPeriodicallyResolve(t *testing.T) {
 func TestPeriodicallyResolveFailure(t *testing.T) {
 	// prepare
 	_, tb := getTelemetryAssets(t)
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 10*time.Millisecond, 1*time.Second, tb)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 10*time.Millisecond, 1*time.Second, "", tb)
 	require.NoError(t, err)
 
 	expectedErr := errors.New("some expected error")
@@ -288,7 +332,7 @@ func TestPeriodicallyResolveFailure(t *testing.T) {
 func TestShutdownClearsCallbacks(t *testing.T) {
 	// prepare
 	_, tb := getTelemetryAssets(t)
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, tb)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, "", tb)
 	require.NoError(t, err)
 
 	res.resolver = &mockDNSResolver{}
@@ -315,6 +359,15 @@ var _ netResolver = (*mockDNSResolver)(nil)
 type mockDNSResolver struct {
 	net.Resolver
 	onLookupIPAddr func(context.Context, string) ([]net.IPAddr, error)
+	onLookupSRV    func(context.Context, string) ([]*net.SRV, error)
+}
+
+func (m *mockDNSResolver) LookupSRV(ctx context.Context, _, _, name string) (string, []*net.SRV, error) {
+	if m.onLookupSRV != nil {
+		records, err := m.onLookupSRV(ctx, name)
+		return name, records, err
+	}
+	return name, nil, nil
 }
 
 func (m *mockDNSResolver) LookupIPAddr(ctx context.Context, hostname string) ([]net.IPAddr, error) {
@@ -31,7 +31,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 )
 
-var _ resolver = (*k8sResolver)(nil)
+var (
+	_ resolver         = (*k8sResolver)(nil)
+	_ metadataResolver = (*k8sResolver)(nil)
+)
 
 var (
 	errNoSvc = errors.New("no service specified to resolve the backends")
@@ -60,6 +63,7 @@ type k8sResolver struct {
 	lwTimeout time.Duration
 
 	endpoints         []string
+	endpointsMetadata map[string]endpointMetadata
 	onChangeCallbacks []func([]string)
 
 	stopCh             chan struct{}
@@ -186,13 +190,18 @@ func (r *k8sResolver) resolve(ctx context.Context) ([]string, error) {
 	defer r.shutdownWg.Done()
 
 	var backends []string
-	r.endpointsStore.Range(func(address, _ any) bool {
+	backendsMetadata := map[string]endpointMetadata{}
+	r.endpointsStore.Range(func(address, weight any) bool {
 		addr := address.(string)
+		md := endpointMetadata{weight: weight.(int)}
 		if len(r.port) == 0 {
 			backends = append(backends, addr)
+			backendsMetadata[addr] = md
 		} else {
 			for _, port := range r.port {
-				backends = append(backends, net.JoinHostPort(addr, strconv.FormatInt(int64(port), 10)))
+				backend := net.JoinHostPort(addr, strconv.FormatInt(int64(port), 10))
+				backends = append(backends, backend)
+				backendsMetadata[backend] = md
 			}
 		}
 		return true
@@ -202,13 +211,14 @@ func (r *k8sResolver) resolve(ctx context.Context) ([]string, error) {
 	// keep it always in the same order
 	sort.Strings(backends)
 
-	if slices.Equal(r.Endpoints(), backends) {
+	if slices.Equal(r.Endpoints(), backends) && equalMetadata(r.metadata(), backendsMetadata) {
 		return r.Endpoints(), nil
 	}
 
 	// the list has changed!
 	r.updateLock.Lock()
 	r.endpoints = backends
+	r.endpointsMetadata = backendsMetadata
 	r.updateLock.Unlock()
 	r.telemetry.LoadbalancerNumBackends.Record(ctx, int64(len(backends)), metric.WithAttributeSet(k8sResolverAttrSet))
 	r.telemetry.LoadbalancerNumBackendUpdates.Add(ctx, 1, metric.WithAttributeSet(k8sResolverAttrSet))
@@ -233,6 +243,12 @@ func (r *k8sResolver) Endpoints() []string {
 	return r.endpoints
 }
 
+func (r *k8sResolver) metadata() map[string]endpointMetadata {
+	r.updateLock.RLock()
+	defer r.updateLock.RUnlock()
+	return r.endpointsMetadata
+}
+
 const inClusterNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
 
 func getInClusterNamespace() (string, error) {
@@ -5,6 +5,8 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"sync"
 
 	"go.opentelemetry.io/otel/metric"
@@ -17,6 +19,10 @@ import (
 
 var _ cache.ResourceEventHandler = (*handler)(nil)
 
+// weightsAnnotation is the annotation of the Endpoints object holding the weights of the backends,
+// as a comma-separated list of pod names and weights, like "collector-0=200,collector-1=50".
+const weightsAnnotation = "loadbalancing.opentelemetry.io/weights"
+
 type handler struct {
 	endpoints *sync.Map
 	callback  func(ctx context.Context) ([]string, error)
@@ -25,23 +31,17 @@ type handler struct {
 }
 
 func (h handler) OnAdd(obj any, _ bool) {
-	var endpoints []string
+	var endpoints map[string]int
 
 	switch object := obj.(type) {
 	case *corev1.Endpoints:
-		endpoints = convertToEndpoints(object)
+		endpoints = h.convertToWeightedEndpoints(object)
 	default: // unsupported
 		h.logger.Warn("Got an unexpected Kubernetes data type during the inclusion of a new pods for the service", zap.Any("obj", obj))
 		h.telemetry.LoadbalancerNumResolutions.Add(context.Background(), 1, metric.WithAttributeSet(k8sResolverFailureAttrSet))
 		return
 	}
-	changed := false
-	for _, ep := range endpoints {
-		if _, loaded := h.endpoints.LoadOrStore(ep, true); !loaded {
-			changed = true
-		}
-	}
-	if changed {
+	if h.storeEndpoints(endpoints) {
 		_, _ = h.callback(context.Background())
 	}
 }
@@ -63,13 +63,7 @@ func (h handler) OnUpdate(oldObj, newObj any) {
 			h.telemetry.LoadbalancerNumResolutions.Add(context.Background(), 1, metric.WithAttributeSet(k8sResolverFailureAttrSet))
 			return
 		}
-		changed := false
-		for _, ep := range convertToEndpoints(newEps) {
-			if _, loaded := h.endpoints.LoadOrStore(ep, true); !loaded {
-				changed = true
-			}
-		}
-		if changed {
+		if h.storeEndpoints(h.convertToWeightedEndpoints(newEps)) {
 			_, _ = h.callback(context.Background())
 		}
 	default: // unsupported
@@ -102,6 +96,53 @@ func (h handler) OnDelete(obj any) {
 	}
 }
 
+// storeEndpoints stores the endpoints along with their weights, reporting whether any of them is new or has a new weight.
+func (h handler) storeEndpoints(endpoints map[string]int) bool {
+	changed := false
+	for ep, weight := range endpoints {
+		if previous, loaded := h.endpoints.Swap(ep, weight); !loaded || previous != weight {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// convertToWeightedEndpoints returns the addresses of the Endpoints object along with their weights,
+// which are read from the weights annotation for the addresses backed by a pod.
+func (h handler) convertToWeightedEndpoints(eps *corev1.Endpoints) map[string]int {
+	weights := h.parseWeights(eps.Annotations[weightsAnnotation])
+	endpoints := map[string]int{}
+	for _, subsets := range eps.Subsets {
+		for _, addr := range subsets.Addresses {
+			weight := defaultWeight
+			if addr.TargetRef != nil {
+				if w, ok := weights[addr.TargetRef.Name]; ok {
+					weight = w
+				}
+			}
+			endpoints[addr.IP] = weight
+		}
+	}
+	return endpoints
+}
+
+func (h handler) parseWeights(annotation string) map[string]int {
+	weights := map[string]int{}
+	if annotation == "" {
+		return weights
+	}
+	for _, entry := range strings.Split(annotation, ",") {
+		name, value, found := strings.Cut(strings.TrimSpace(entry), "=")
+		weight, err := strconv.Atoi(value)
+		if !found || err != nil || weight <= 0 {
+			h.logger.Warn("Ignoring an invalid entry in the weights annotation", zap.String("annotation", weightsAnnotation), zap.String("entry", entry))
+			continue
+		}
+		weights[name] = weight
+	}
+	return weights
+}
+
 func convertToEndpoints(eps ...*corev1.Endpoints) []string {
 	var ipAddress []string
 	for _, ep := range eps {
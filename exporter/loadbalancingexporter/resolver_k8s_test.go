@@ -6,6 +6,7 @@ package loadbalancingexporter
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -66,12 +67,12 @@ func TestK8sResolve(t *testing.T) {
 		assert.Equal(t, expectInit, res.Endpoints())
 
 		return &suiteContext{
-				endpoint:  endpoint,
-				clientset: cl,
-				resolver:  res,
-			}, func(*testing.T) {
-				require.NoError(t, res.shutdown(context.Background()))
-			}
+			endpoint:  endpoint,
+			clientset: cl,
+			resolver:  res,
+		}, func(*testing.T) {
+			require.NoError(t, res.shutdown(context.Background()))
+		}
 	}
 	tests := []struct {
 		name       string
@@ -255,3 +256,61 @@ func Test_newK8sResolver(t *testing.T) {
 		})
 	}
 }
+
+func TestK8sHandlerWeights(t *testing.T) {
+	// prepare
+	_, tb := getTelemetryAssets(t)
+	endpoints := &sync.Map{}
+	callbacks := 0
+	h := handler{
+		endpoints: endpoints,
+		callback: func(context.Context) ([]string, error) {
+			callbacks++
+			return nil, nil
+		},
+		logger:    zap.NewNop(),
+		telemetry: tb,
+	}
+	eps := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "lb",
+			Namespace:   "default",
+			Annotations: map[string]string{weightsAnnotation: "collector-0=200, collector-1=invalid"},
+		},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{
+					{IP: "10.10.0.10", TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "collector-0"}},
+					{IP: "10.10.0.11", TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "collector-1"}},
+					{IP: "10.10.0.12"},
+				},
+			},
+		},
+	}
+
+	// test
+	h.OnAdd(eps, false)
+
+	// verify
+	assert.Equal(t, 1, callbacks)
+	for ip, expected := range map[string]int{
+		"10.10.0.10": 200,
+		"10.10.0.11": defaultWeight,
+		"10.10.0.12": defaultWeight,
+	} {
+		weight, found := endpoints.Load(ip)
+		require.True(t, found)
+		assert.Equal(t, expected, weight)
+	}
+
+	// test
+	// a new weight for a known address is a change as well
+	updated := eps.DeepCopy()
+	updated.Annotations[weightsAnnotation] = "collector-0=200,collector-1=50"
+	h.OnAdd(updated, false)
+
+	// verify
+	assert.Equal(t, 2, callbacks)
+	weight, _ := endpoints.Load("10.10.0.11")
+	assert.Equal(t, 50, weight)
+}
@@ -6,6 +6,7 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"sync"
 
@@ -15,7 +16,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 )
 
-var _ resolver = (*staticResolver)(nil)
+var (
+	_ resolver         = (*staticResolver)(nil)
+	_ metadataResolver = (*staticResolver)(nil)
+)
 
 var (
 	errNoEndpoints               = errors.New("no endpoints specified for the static resolver")
@@ -26,17 +30,42 @@ var (
 
 type staticResolver struct {
 	endpoints         []string
+	endpointsMetadata map[string]endpointMetadata
 	onChangeCallbacks []func([]string)
 	once              sync.Once // we trigger the onChange only once
 
 	telemetry *metadata.TelemetryBuilder
 }
 
-func newStaticResolver(endpoints []string, tb *metadata.TelemetryBuilder) (*staticResolver, error) {
+func newStaticResolver(endpoints []string, weights map[string]int, priorities map[string]int, tb *metadata.TelemetryBuilder) (*staticResolver, error) {
 	if len(endpoints) == 0 {
 		return nil, errNoEndpoints
 	}
 
+	endpointsMetadata := make(map[string]endpointMetadata, len(endpoints))
+	for _, endpoint := range endpoints {
+		endpointsMetadata[endpoint] = endpointMetadata{weight: defaultWeight}
+	}
+	for endpoint, weight := range weights {
+		md, ok := endpointsMetadata[endpoint]
+		if !ok {
+			return nil, fmt.Errorf("weight specified for the unknown hostname %q", endpoint)
+		}
+		if weight <= 0 {
+			return nil, fmt.Errorf("invalid weight %d for the hostname %q: must be positive", weight, endpoint)
+		}
+		md.weight = weight
+		endpointsMetadata[endpoint] = md
+	}
+	for endpoint, priority := range priorities {
+		md, ok := endpointsMetadata[endpoint]
+		if !ok {
+			return nil, fmt.Errorf("priority specified for the unknown hostname %q", endpoint)
+		}
+		md.priority = priority
+		endpointsMetadata[endpoint] = md
+	}
+
 	// make sure we won't change the provided slice
 	endpointsCopy := make([]string, len(endpoints))
 	copy(endpointsCopy, endpoints)
@@ -45,8 +74,9 @@ func newStaticResolver(endpoints []string, tb *metadata.TelemetryBuilder) (*stat
 	sort.Strings(endpointsCopy)
 
 	return &staticResolver{
-		endpoints: endpointsCopy,
-		telemetry: tb,
+		endpoints:         endpointsCopy,
+		endpointsMetadata: endpointsMetadata,
+		telemetry:         tb,
 	}, nil
 }
 
@@ -80,3 +110,7 @@ func (r *staticResolver) resolve(ctx context.Context) ([]string, error) {
 func (r *staticResolver) onChange(f func([]string)) {
 	r.onChangeCallbacks = append(r.onChangeCallbacks, f)
 }
+
+func (r *staticResolver) metadata() map[string]endpointMetadata {
+	return r.endpointsMetadata
+}
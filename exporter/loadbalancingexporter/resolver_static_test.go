@@ -15,7 +15,7 @@ func TestInitialResolution(t *testing.T) {
 	// prepare
 	_, tb := getTelemetryAssets(t)
 	provided := []string{"endpoint-2", "endpoint-1"}
-	res, err := newStaticResolver(provided, tb)
+	res, err := newStaticResolver(provided, nil, nil, tb)
 	require.NoError(t, err)
 
 	// test
@@ -37,7 +37,7 @@ func TestResolvedOnlyOnce(t *testing.T) {
 	// prepare
 	_, tb := getTelemetryAssets(t)
 	expected := []string{"endpoint-1", "endpoint-2"}
-	res, err := newStaticResolver(expected, tb)
+	res, err := newStaticResolver(expected, nil, nil, tb)
 	require.NoError(t, err)
 
 	counter := 0
@@ -64,9 +64,62 @@ func TestFailOnMissingEndpoints(t *testing.T) {
 	var expected []string
 
 	// test
-	res, err := newStaticResolver(expected, tb)
+	res, err := newStaticResolver(expected, nil, nil, tb)
 
 	// verify
 	assert.Equal(t, errNoEndpoints, err)
 	assert.Nil(t, res)
 }
+
+func TestStaticResolverMetadata(t *testing.T) {
+	// prepare
+	_, tb := getTelemetryAssets(t)
+	provided := []string{"endpoint-1", "endpoint-2"}
+
+	// test
+	res, err := newStaticResolver(provided, map[string]int{"endpoint-1": 200}, map[string]int{"endpoint-2": 1}, tb)
+	require.NoError(t, err)
+
+	// verify
+	assert.Equal(t, map[string]endpointMetadata{
+		"endpoint-1": {weight: 200},
+		"endpoint-2": {weight: defaultWeight, priority: 1},
+	}, res.metadata())
+}
+
+func TestStaticResolverInvalidMetadata(t *testing.T) {
+	_, tb := getTelemetryAssets(t)
+	provided := []string{"endpoint-1", "endpoint-2"}
+
+	for _, tt := range []struct {
+		desc       string
+		weights    map[string]int
+		priorities map[string]int
+		expected   string
+	}{
+		{
+			desc:     "weight for unknown hostname",
+			weights:  map[string]int{"endpoint-3": 10},
+			expected: `weight specified for the unknown hostname "endpoint-3"`,
+		},
+		{
+			desc:     "non-positive weight",
+			weights:  map[string]int{"endpoint-1": 0},
+			expected: `invalid weight 0 for the hostname "endpoint-1": must be positive`,
+		},
+		{
+			desc:       "priority for unknown hostname",
+			priorities: map[string]int{"endpoint-3": 1},
+			expected:   `priority specified for the unknown hostname "endpoint-3"`,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// test
+			res, err := newStaticResolver(provided, tt.weights, tt.priorities, tb)
+
+			// verify
+			assert.EqualError(t, err, tt.expected)
+			assert.Nil(t, res)
+		})
+	}
+}
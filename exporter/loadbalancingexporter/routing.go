@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+var (
+	errNoRoutingAttributes = errors.New("routing_attributes must be specified when routing by attributes")
+	errNoRoutingExpression = errors.New("routing_expression must be specified when routing by an OTTL expression")
+)
+
+// attributesRoutingKey builds the routing key from the values of the given attributes, each one being looked up in
+// the given maps in order, from the most specific to the least specific. It reports whether any attribute was found.
+func attributesRoutingKey(names []string, attrs ...pcommon.Map) (string, bool) {
+	var sb strings.Builder
+	found := false
+	for _, name := range names {
+		for _, m := range attrs {
+			if v, ok := m.Get(name); ok {
+				sb.WriteString(v.AsString())
+				found = true
+				break
+			}
+		}
+		// separate the values, so that the keys of different values can't collide
+		sb.WriteByte(0)
+	}
+	return sb.String(), found
+}
+
+// routeFunctionName is the name of the function wrapping the routing expression, as OTTL only parses whole
+// statements. The function returns the value of the expression, which is then the result of the statement.
+const routeFunctionName = "route"
+
+type routeArguments[K any] struct {
+	Key ottl.Getter[K]
+}
+
+// routingFunctions returns the functions available to the routing expression: the standard converters and the
+// function wrapping the expression itself.
+func routingFunctions[K any]() map[string]ottl.Factory[K] {
+	functions := ottlfuncs.StandardConverters[K]()
+	route := ottl.NewFactory(routeFunctionName, &routeArguments[K]{}, createRouteFunction[K])
+	functions[route.Name()] = route
+	return functions
+}
+
+func createRouteFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*routeArguments[K])
+	if !ok {
+		return nil, errors.New("route args must be of type *routeArguments[K]")
+	}
+	return args.Key.Get, nil
+}
+
+// newRoutingExpression parses the OTTL value expression the routing key is built from. The parser must have been
+// created with the routingFunctions.
+func newRoutingExpression[K any](parser ottl.Parser[K], expression string) (*ottl.Statement[K], error) {
+	if expression == "" {
+		return nil, errNoRoutingExpression
+	}
+	statement, err := parser.ParseStatement(fmt.Sprintf("%s(%s)", routeFunctionName, expression))
+	if err != nil {
+		return nil, fmt.Errorf("invalid routing_expression %q: %w", expression, err)
+	}
+	return statement, nil
+}
+
+// expressionRoutingKey evaluates the routing expression for the given context. It reports false when the expression
+// evaluates to nil or to an empty value, as no routing key can be built from it.
+func expressionRoutingKey[K any](ctx context.Context, expr *ottl.Statement[K], tCtx K) (string, bool, error) {
+	val, _, err := expr.Execute(ctx, tCtx)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to evaluate the routing expression: %w", err)
+	}
+
+	var key string
+	switch v := val.(type) {
+	case nil:
+	case string:
+		key = v
+	case []byte:
+		key = string(v)
+	case pcommon.Value:
+		key = v.AsString()
+	default:
+		key = fmt.Sprint(v)
+	}
+	return key, key != "", nil
+}
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+func TestAttributesRoutingKey(t *testing.T) {
+	// prepare
+	resource := pcommon.NewMap()
+	resource.PutStr("tenant.id", "tenant-1")
+	resource.PutStr("k8s.namespace.name", "ns-1")
+	record := pcommon.NewMap()
+	record.PutStr("k8s.namespace.name", "ns-2")
+	record.PutInt("shard", 3)
+
+	for _, tt := range []struct {
+		desc          string
+		names         []string
+		expectedKey   string
+		expectedFound bool
+	}{
+		{
+			desc:          "most specific attribute first",
+			names:         []string{"tenant.id", "k8s.namespace.name"},
+			expectedKey:   "tenant-1\x00ns-2\x00",
+			expectedFound: true,
+		},
+		{
+			desc:          "non-string attribute",
+			names:         []string{"shard"},
+			expectedKey:   "3\x00",
+			expectedFound: true,
+		},
+		{
+			desc:          "some attributes missing",
+			names:         []string{"missing", "tenant.id"},
+			expectedKey:   "\x00tenant-1\x00",
+			expectedFound: true,
+		},
+		{
+			desc:          "all attributes missing",
+			names:         []string{"missing"},
+			expectedKey:   "\x00",
+			expectedFound: false,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// test
+			key, found := attributesRoutingKey(tt.names, record, resource)
+
+			// verify
+			assert.Equal(t, tt.expectedKey, key)
+			assert.Equal(t, tt.expectedFound, found)
+		})
+	}
+}
+
+func TestExpressionRoutingKey(t *testing.T) {
+	// prepare
+	parser, err := ottllog.NewParser(routingFunctions[ottllog.TransformContext](), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("tenant.id", "tenant-1")
+	sl := rl.ScopeLogs().AppendEmpty()
+	log := sl.LogRecords().AppendEmpty()
+	log.Attributes().PutInt("shard", 3)
+	tCtx := ottllog.NewTransformContext(log, sl.Scope(), rl.Resource(), sl, rl)
+
+	for _, tt := range []struct {
+		desc          string
+		expression    string
+		expectedKey   string
+		expectedFound bool
+	}{
+		{
+			desc:          "string",
+			expression:    `resource.attributes["tenant.id"]`,
+			expectedKey:   "tenant-1",
+			expectedFound: true,
+		},
+		{
+			desc:          "converter",
+			expression:    `Concat([resource.attributes["tenant.id"], attributes["shard"]], "/")`,
+			expectedKey:   "tenant-1/3",
+			expectedFound: true,
+		},
+		{
+			desc:          "int",
+			expression:    `attributes["shard"]`,
+			expectedKey:   "3",
+			expectedFound: true,
+		},
+		{
+			desc:          "nil",
+			expression:    `attributes["missing"]`,
+			expectedFound: false,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			expr, err := newRoutingExpression(parser, tt.expression)
+			require.NoError(t, err)
+
+			// test
+			key, found, err := expressionRoutingKey(context.Background(), expr, tCtx)
+
+			// verify
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedKey, key)
+			assert.Equal(t, tt.expectedFound, found)
+		})
+	}
+}
+
+func TestNewRoutingExpressionInvalid(t *testing.T) {
+	parser, err := ottllog.NewParser(routingFunctions[ottllog.TransformContext](), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	_, err = newRoutingExpression(parser, "")
+	assert.Equal(t, errNoRoutingExpression, err)
+
+	_, err = newRoutingExpression(parser, `attributes[`)
+	assert.ErrorContains(t, err, "invalid routing_expression")
+}
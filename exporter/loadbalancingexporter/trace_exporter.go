@@ -14,12 +14,16 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/exporter/otlphttpexporter"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 )
 
 var _ exporter.Traces = (*traceExporterImp)(nil)
@@ -27,8 +31,10 @@ var _ exporter.Traces = (*traceExporterImp)(nil)
 type exporterTraces map[*wrappedExporter]ptrace.Traces
 
 type traceExporterImp struct {
-	loadBalancer *loadBalancer
-	routingKey   routingKey
+	loadBalancer      *loadBalancer
+	routingKey        routingKey
+	routingAttributes []string
+	routingExpression *ottl.Statement[ottlspan.TransformContext]
 
 	stopped    bool
 	shutdownWg sync.WaitGroup
@@ -42,10 +48,10 @@ func newTracesExporter(params exporter.Settings, cfg component.Config) (*traceEx
 		return nil, err
 	}
 
-	exporterFactory := otlpexporter.NewFactory()
+	exporterFactory := protocolExporterFactory(cfg.(*Config))
 	cfFunc := func(ctx context.Context, endpoint string) (component.Component, error) {
-		oCfg := buildExporterConfig(cfg.(*Config), endpoint)
-		return exporterFactory.CreateTraces(ctx, params, &oCfg)
+		oCfg := buildProtocolExporterConfig(cfg.(*Config), endpoint)
+		return exporterFactory.CreateTraces(ctx, params, oCfg)
 	}
 
 	lb, err := newLoadBalancer(params.Logger, cfg, cfFunc, telemetry)
@@ -62,6 +68,22 @@ func newTracesExporter(params exporter.Settings, cfg component.Config) (*traceEx
 	switch cfg.(*Config).RoutingKey {
 	case svcRoutingStr:
 		traceExporter.routingKey = svcRouting
+	case attrRoutingStr:
+		if len(cfg.(*Config).RoutingAttributes) == 0 {
+			return nil, errNoRoutingAttributes
+		}
+		traceExporter.routingKey = attrRouting
+		traceExporter.routingAttributes = cfg.(*Config).RoutingAttributes
+	case ottlRoutingStr:
+		parser, err := ottlspan.NewParser(routingFunctions[ottlspan.TransformContext](), params.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+		traceExporter.routingExpression, err = newRoutingExpression(parser, cfg.(*Config).RoutingExpression)
+		if err != nil {
+			return nil, err
+		}
+		traceExporter.routingKey = ottlRouting
 	case traceIDRoutingStr, "":
 	default:
 		return nil, fmt.Errorf("unsupported routing_key: %s", cfg.(*Config).RoutingKey)
@@ -69,12 +91,59 @@ func newTracesExporter(params exporter.Settings, cfg component.Config) (*traceEx
 	return &traceExporter, nil
 }
 
+// protocolExporterFactory returns the factory of the exporters for the configured protocol
+func protocolExporterFactory(cfg *Config) exporter.Factory {
+	switch {
+	case cfg.Protocol.OTLPHTTP != nil:
+		return otlphttpexporter.NewFactory()
+	case cfg.Protocol.OTelArrow != nil:
+		return otelarrowexporter.NewFactory()
+	default:
+		return otlpexporter.NewFactory()
+	}
+}
+
+// buildProtocolExporterConfig builds the config of the exporter for the given backend, using the configured protocol
+func buildProtocolExporterConfig(cfg *Config, endpoint string) component.Config {
+	switch {
+	case cfg.Protocol.OTLPHTTP != nil:
+		oCfg := buildOTLPHTTPExporterConfig(cfg, endpoint)
+		return &oCfg
+	case cfg.Protocol.OTelArrow != nil:
+		oCfg := buildOTelArrowExporterConfig(cfg, endpoint)
+		return &oCfg
+	default:
+		oCfg := buildExporterConfig(cfg, endpoint)
+		return &oCfg
+	}
+}
+
 func buildExporterConfig(cfg *Config, endpoint string) otlpexporter.Config {
 	oCfg := cfg.Protocol.OTLP
 	oCfg.Endpoint = endpoint
 	return oCfg
 }
 
+func buildOTLPHTTPExporterConfig(cfg *Config, endpoint string) otlphttpexporter.Config {
+	oCfg := *cfg.Protocol.OTLPHTTP
+	scheme := "https"
+	if oCfg.TLSSetting.Insecure {
+		scheme = "http"
+	}
+	oCfg.Endpoint = fmt.Sprintf("%s://%s", scheme, endpoint)
+	// the signal-specific endpoints would bypass the load balancing
+	oCfg.TracesEndpoint = ""
+	oCfg.MetricsEndpoint = ""
+	oCfg.LogsEndpoint = ""
+	return oCfg
+}
+
+func buildOTelArrowExporterConfig(cfg *Config, endpoint string) otelarrowexporter.Config {
+	oCfg := *cfg.Protocol.OTelArrow
+	oCfg.Endpoint = endpoint
+	return oCfg
+}
+
 func (e *traceExporterImp) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
@@ -91,10 +160,40 @@ func (e *traceExporterImp) Shutdown(ctx context.Context) error {
 }
 
 func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
-	batches := batchpersignal.SplitTraces(td)
-
 	exporterSegregatedTraces := make(exporterTraces)
 	endpoints := make(map[*wrappedExporter]string)
+	route := func(rid string, batch ptrace.Traces) error {
+		exp, endpoint, err := e.loadBalancer.exporterAndEndpoint([]byte(rid))
+		if err != nil {
+			return err
+		}
+
+		_, ok := exporterSegregatedTraces[exp]
+		if !ok {
+			exp.consumeWG.Add(1)
+			exporterSegregatedTraces[exp] = ptrace.NewTraces()
+		}
+		exporterSegregatedTraces[exp] = mergeTraces(exporterSegregatedTraces[exp], batch)
+
+		endpoints[exp] = endpoint
+		return nil
+	}
+
+	if e.routingKey == attrRouting || e.routingKey == ottlRouting {
+		keyed, unkeyed, err := e.splitTracesByRoutingKey(ctx, td)
+		if err != nil {
+			return err
+		}
+		for rid, batch := range keyed {
+			if err := route(rid, batch); err != nil {
+				return err
+			}
+		}
+		// the spans without a routing key are routed by their trace ID
+		td = unkeyed
+	}
+
+	batches := batchpersignal.SplitTraces(td)
 	for _, batch := range batches {
 		routingID, err := routingIdentifiersFromTraces(batch, e.routingKey)
 		if err != nil {
@@ -102,19 +201,9 @@ func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 		}
 
 		for rid := range routingID {
-			exp, endpoint, err := e.loadBalancer.exporterAndEndpoint([]byte(rid))
-			if err != nil {
+			if err := route(rid, batch); err != nil {
 				return err
 			}
-
-			_, ok := exporterSegregatedTraces[exp]
-			if !ok {
-				exp.consumeWG.Add(1)
-				exporterSegregatedTraces[exp] = ptrace.NewTraces()
-			}
-			exporterSegregatedTraces[exp] = mergeTraces(exporterSegregatedTraces[exp], batch)
-
-			endpoints[exp] = endpoint
 		}
 	}
 
@@ -126,6 +215,7 @@ func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 		exp.consumeWG.Done()
 		errs = multierr.Append(errs, err)
 		duration := time.Since(start)
+		e.loadBalancer.onExportResult(exp, endpoints[exp], err)
 		e.telemetry.LoadbalancerBackendLatency.Record(ctx, duration.Milliseconds(), metric.WithAttributeSet(exp.endpointAttr))
 		if err == nil {
 			e.telemetry.LoadbalancerBackendOutcome.Add(ctx, 1, metric.WithAttributeSet(exp.successAttr))
@@ -137,6 +227,84 @@ func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 	return errs
 }
 
+// splitTracesByRoutingKey groups the spans by the routing key built from their attributes or from the routing
+// expression. The spans for which no routing key could be built are returned apart.
+func (e *traceExporterImp) splitTracesByRoutingKey(ctx context.Context, td ptrace.Traces) (map[string]ptrace.Traces, ptrace.Traces, error) {
+	keyed := map[string]*tracesBatch{}
+	unkeyed := &tracesBatch{td: ptrace.NewTraces()}
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+
+				var key string
+				var found bool
+				if e.routingKey == attrRouting {
+					key, found = attributesRoutingKey(e.routingAttributes, span.Attributes(), ss.Scope().Attributes(), rs.Resource().Attributes())
+				} else {
+					var err error
+					key, found, err = expressionRoutingKey(ctx, e.routingExpression, ottlspan.NewTransformContext(span, ss.Scope(), rs.Resource(), ss, rs))
+					if err != nil {
+						return nil, ptrace.Traces{}, err
+					}
+				}
+
+				batch := unkeyed
+				if found {
+					batch = keyed[key]
+					if batch == nil {
+						batch = &tracesBatch{td: ptrace.NewTraces()}
+						keyed[key] = batch
+					}
+				}
+				span.CopyTo(batch.scopeSpansFor(i, j, rs, ss).Spans().AppendEmpty())
+			}
+		}
+	}
+
+	results := make(map[string]ptrace.Traces, len(keyed))
+	for key, batch := range keyed {
+		results[key] = batch.td
+	}
+	return results, unkeyed.td, nil
+}
+
+// tracesBatch builds a ptrace.Traces out of spans taken in order from another ptrace.Traces,
+// without repeating the resource and the scope of consecutive spans.
+type tracesBatch struct {
+	td               ptrace.Traces
+	ss               ptrace.ScopeSpans
+	rsIndex, ssIndex int
+	hasResourceSpans bool
+}
+
+func (b *tracesBatch) scopeSpansFor(rsIndex, ssIndex int, rs ptrace.ResourceSpans, ss ptrace.ScopeSpans) ptrace.ScopeSpans {
+	if b.hasResourceSpans && b.rsIndex == rsIndex && b.ssIndex == ssIndex {
+		return b.ss
+	}
+
+	var rsClone ptrace.ResourceSpans
+	if b.hasResourceSpans && b.rsIndex == rsIndex {
+		rsClone = b.td.ResourceSpans().At(b.td.ResourceSpans().Len() - 1)
+	} else {
+		rsClone = b.td.ResourceSpans().AppendEmpty()
+		rs.Resource().CopyTo(rsClone.Resource())
+		rsClone.SetSchemaUrl(rs.SchemaUrl())
+	}
+
+	b.ss = rsClone.ScopeSpans().AppendEmpty()
+	ss.Scope().CopyTo(b.ss.Scope())
+	b.ss.SetSchemaUrl(ss.SchemaUrl())
+	b.rsIndex, b.ssIndex, b.hasResourceSpans = rsIndex, ssIndex, true
+	return b.ss
+}
+
 func routingIdentifiersFromTraces(td ptrace.Traces, key routingKey) (map[string]bool, error) {
 	ids := make(map[string]bool)
 	rs := td.ResourceSpans()
@@ -19,17 +19,20 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/exporter/otlphttpexporter"
 	"go.opentelemetry.io/collector/otelcol/otelcoltest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	conventions "go.opentelemetry.io/collector/semconv/v1.27.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter"
 )
 
 func TestNewTracesExporter(t *testing.T) {
@@ -277,6 +280,156 @@ func TestServiceBasedRoutingForSameTraceId(t *testing.T) {
 	}
 }
 
+func TestNewTracesExporterRoutingKeys(t *testing.T) {
+	for _, tt := range []struct {
+		desc   string
+		config *Config
+		verify func(t *testing.T, p *traceExporterImp, err error)
+	}{
+		{
+			desc:   "attributes",
+			config: attrBasedRoutingConfig(),
+			verify: func(t *testing.T, p *traceExporterImp, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, attrRouting, p.routingKey)
+			},
+		},
+		{
+			desc:   "attributes without attributes",
+			config: &Config{Resolver: simpleConfig().Resolver, RoutingKey: attrRoutingStr},
+			verify: func(t *testing.T, _ *traceExporterImp, err error) {
+				assert.Equal(t, errNoRoutingAttributes, err)
+			},
+		},
+		{
+			desc:   "ottl",
+			config: ottlBasedRoutingConfig(),
+			verify: func(t *testing.T, p *traceExporterImp, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, ottlRouting, p.routingKey)
+			},
+		},
+		{
+			desc:   "ottl without expression",
+			config: &Config{Resolver: simpleConfig().Resolver, RoutingKey: ottlRoutingStr},
+			verify: func(t *testing.T, _ *traceExporterImp, err error) {
+				assert.Equal(t, errNoRoutingExpression, err)
+			},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// test
+			p, err := newTracesExporter(exportertest.NewNopSettings(), tt.config)
+
+			// verify
+			tt.verify(t, p, err)
+		})
+	}
+}
+
+func TestSplitTracesByRoutingKey(t *testing.T) {
+	for _, tt := range []struct {
+		config          *Config
+		expectedKeyed   [][]string
+		expectedUnkeyed []string
+	}{
+		{
+			config:          attrBasedRoutingConfig(),
+			expectedKeyed:   [][]string{{"span-1", "span-3"}, {"span-2"}},
+			expectedUnkeyed: []string{"span-4"},
+		},
+		{
+			config:          ottlBasedRoutingConfig(),
+			expectedKeyed:   [][]string{{"span-1", "span-2", "span-3"}},
+			expectedUnkeyed: []string{"span-4"},
+		},
+	} {
+		t.Run(tt.config.RoutingKey, func(t *testing.T) {
+			// prepare
+			p, err := newTracesExporter(exportertest.NewNopSettings(), tt.config)
+			require.NoError(t, err)
+
+			td := ptrace.NewTraces()
+			rs := td.ResourceSpans().AppendEmpty()
+			rs.Resource().Attributes().PutStr("tenant.id", "tenant-1")
+			spans := rs.ScopeSpans().AppendEmpty().Spans()
+			spans.AppendEmpty().SetName("span-1")
+			spans.AppendEmpty().SetName("span-2")
+			spans.AppendEmpty().SetName("span-3")
+			spans.At(1).Attributes().PutStr("tenant.id", "tenant-2")
+			rs = td.ResourceSpans().AppendEmpty()
+			rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span-4")
+
+			// test
+			keyed, unkeyed, err := p.splitTracesByRoutingKey(context.Background(), td)
+
+			// verify
+			require.NoError(t, err)
+			var keyedNames [][]string
+			for _, batch := range keyed {
+				assert.Equal(t, 1, batch.ResourceSpans().Len(), "consecutive spans should share their resource")
+				keyedNames = append(keyedNames, spanNames(batch))
+			}
+			assert.ElementsMatch(t, tt.expectedKeyed, keyedNames)
+			assert.Equal(t, tt.expectedUnkeyed, spanNames(unkeyed))
+		})
+	}
+}
+
+func spanNames(td ptrace.Traces) []string {
+	var names []string
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		for j := 0; j < td.ResourceSpans().At(i).ScopeSpans().Len(); j++ {
+			spans := td.ResourceSpans().At(i).ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				names = append(names, spans.At(k).Name())
+			}
+		}
+	}
+	return names
+}
+
+func TestConsumeTracesAttributeBased(t *testing.T) {
+	ts, tb := getTelemetryAssets(t)
+	sink := new(consumertest.TracesSink)
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newMockTracesExporter(sink.ConsumeTraces), nil
+	}
+	lb, err := newLoadBalancer(ts.Logger, attrBasedRoutingConfig(), componentFactory, tb)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(ts, attrBasedRoutingConfig())
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	lb.addMissingExporters(context.Background(), []string{"endpoint-1", "endpoint-2"})
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	td := simpleTraces()
+	td.ResourceSpans().At(0).Resource().Attributes().PutStr("tenant.id", "tenant-1")
+	td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().AppendEmpty().SetName("without-trace-id")
+
+	// test
+	res := p.ConsumeTraces(context.Background(), td)
+
+	// verify
+	assert.NoError(t, res)
+	assert.Equal(t, 2, sink.SpanCount())
+}
+
 func TestConsumeTracesExporterNoEndpoint(t *testing.T) {
 	ts, tb := getTelemetryAssets(t)
 	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
@@ -378,6 +531,68 @@ func TestBuildExporterConfig(t *testing.T) {
 	assert.Equal(t, defaultCfg.RetryConfig, exporterCfg.RetryConfig)
 }
 
+func TestBuildProtocolExporterConfig(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		protocol Protocol
+		verify   func(t *testing.T, cfg component.Config)
+	}{
+		{
+			desc:     "otlp",
+			protocol: Protocol{OTLP: otlpexporter.Config{ClientConfig: configgrpc.ClientConfig{Endpoint: "should-be-replaced:4317"}}},
+			verify: func(t *testing.T, cfg component.Config) {
+				require.IsType(t, &otlpexporter.Config{}, cfg)
+				assert.Equal(t, "the-endpoint:4317", cfg.(*otlpexporter.Config).Endpoint)
+			},
+		},
+		{
+			desc: "otlphttp",
+			protocol: Protocol{OTLPHTTP: func() *otlphttpexporter.Config {
+				cfg := defaultOTLPHTTPConfig()
+				cfg.TracesEndpoint = "http://should-be-removed:4318/v1/traces"
+				return cfg
+			}()},
+			verify: func(t *testing.T, cfg component.Config) {
+				require.IsType(t, &otlphttpexporter.Config{}, cfg)
+				assert.Equal(t, "https://the-endpoint:4317", cfg.(*otlphttpexporter.Config).Endpoint)
+				assert.Empty(t, cfg.(*otlphttpexporter.Config).TracesEndpoint)
+			},
+		},
+		{
+			desc: "otlphttp insecure",
+			protocol: Protocol{OTLPHTTP: func() *otlphttpexporter.Config {
+				cfg := defaultOTLPHTTPConfig()
+				cfg.TLSSetting.Insecure = true
+				return cfg
+			}()},
+			verify: func(t *testing.T, cfg component.Config) {
+				require.IsType(t, &otlphttpexporter.Config{}, cfg)
+				assert.Equal(t, "http://the-endpoint:4317", cfg.(*otlphttpexporter.Config).Endpoint)
+			},
+		},
+		{
+			desc:     "otelarrow",
+			protocol: Protocol{OTelArrow: defaultOTelArrowConfig()},
+			verify: func(t *testing.T, cfg component.Config) {
+				require.IsType(t, &otelarrowexporter.Config{}, cfg)
+				assert.Equal(t, "the-endpoint:4317", cfg.(*otelarrowexporter.Config).Endpoint)
+			},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// prepare
+			cfg := &Config{Protocol: tt.protocol}
+
+			// test
+			exporterCfg := buildProtocolExporterConfig(cfg, "the-endpoint:4317")
+
+			// verify
+			tt.verify(t, exporterCfg)
+			assert.IsType(t, protocolExporterFactory(cfg).CreateDefaultConfig(), exporterCfg, "the factory should match the config")
+		})
+	}
+}
+
 func TestBatchWithTwoTraces(t *testing.T) {
 	ts, tb := getTelemetryAssets(t)
 	sink := new(consumertest.TracesSink)
@@ -462,7 +677,7 @@ func TestRollingUpdatesWhenConsumeTraces(t *testing.T) {
 
 	// simulate rolling updates, the dns resolver should resolve in the following order
 	// ["127.0.0.1"] -> ["127.0.0.1", "127.0.0.2"] -> ["127.0.0.2"]
-	res, err := newDNSResolver(ts.Logger, "service-1", "", 5*time.Second, 1*time.Second, tb)
+	res, err := newDNSResolver(ts.Logger, "service-1", "", 5*time.Second, 1*time.Second, "", tb)
 	require.NoError(t, err)
 
 	mu := sync.Mutex{}
@@ -738,6 +953,26 @@ func simpleConfig() *Config {
 	}
 }
 
+func attrBasedRoutingConfig() *Config {
+	return &Config{
+		Resolver: ResolverSettings{
+			Static: &StaticResolver{Hostnames: []string{"endpoint-1", "endpoint-2"}},
+		},
+		RoutingKey:        attrRoutingStr,
+		RoutingAttributes: []string{"tenant.id"},
+	}
+}
+
+func ottlBasedRoutingConfig() *Config {
+	return &Config{
+		Resolver: ResolverSettings{
+			Static: &StaticResolver{Hostnames: []string{"endpoint-1", "endpoint-2"}},
+		},
+		RoutingKey:        ottlRoutingStr,
+		RoutingExpression: `resource.attributes["tenant.id"]`,
+	}
+}
+
 func serviceBasedRoutingConfig() *Config {
 	return &Config{
 		Resolver: ResolverSettings{
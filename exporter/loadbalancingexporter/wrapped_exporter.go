@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
@@ -22,6 +23,9 @@ type wrappedExporter struct {
 	component.Component
 	consumeWG sync.WaitGroup
 
+	// consecutiveFailures is the number of exports that failed since the last successful one
+	consecutiveFailures atomic.Int64
+
 	// we store the attributes here for both cases, to avoid new allocations on the hot path
 	endpointAttr attribute.Set
 	successAttr  attribute.Set
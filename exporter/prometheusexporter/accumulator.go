@@ -14,6 +14,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 )
 
 type accumulatedValue struct {
@@ -85,6 +87,8 @@ func (a *lastValueAccumulator) addMetric(metric pmetric.Metric, il pcommon.Instr
 		return a.accumulateSum(metric, il, resourceAttrs, now)
 	case pmetric.MetricTypeHistogram:
 		return a.accumulateHistogram(metric, il, resourceAttrs, now)
+	case pmetric.MetricTypeExponentialHistogram:
+		return a.accumulateExponentialHistogram(metric, il, resourceAttrs, now)
 	case pmetric.MetricTypeSummary:
 		return a.accumulateSummary(metric, il, resourceAttrs, now)
 	default:
@@ -291,6 +295,67 @@ func (a *lastValueAccumulator) accumulateHistogram(metric pmetric.Metric, il pco
 	return
 }
 
+func (a *lastValueAccumulator) accumulateExponentialHistogram(metric pmetric.Metric, il pcommon.InstrumentationScope, resourceAttrs pcommon.Map, now time.Time) (n int) {
+	histogram := metric.ExponentialHistogram()
+	dps := histogram.DataPoints()
+
+	for i := 0; i < dps.Len(); i++ {
+		ip := dps.At(i)
+
+		signature := timeseriesSignature(il.Name(), metric, ip.Attributes(), resourceAttrs)
+		if ip.Flags().NoRecordedValue() {
+			a.registeredMetrics.Delete(signature)
+			return 0
+		}
+
+		v, ok := a.registeredMetrics.Load(signature)
+		if !ok {
+			// first data point
+			m := copyMetricMetadata(metric)
+			ip.CopyTo(m.SetEmptyExponentialHistogram().DataPoints().AppendEmpty())
+			m.ExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+			a.registeredMetrics.Store(signature, &accumulatedValue{value: m, resourceAttrs: resourceAttrs, scope: il, updated: now})
+			n++
+			continue
+		}
+		mv := v.(*accumulatedValue)
+
+		m := copyMetricMetadata(metric)
+		m.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+
+		switch histogram.AggregationTemporality() {
+		case pmetric.AggregationTemporalityDelta:
+			pp := mv.value.ExponentialHistogram().DataPoints().At(0) // previous aggregated value for time range
+			if ip.StartTimestamp().AsTime() != pp.Timestamp().AsTime() {
+				// treat misalignment as restart and reset, or violation of single-writer principle and drop
+				if ip.StartTimestamp().AsTime().After(pp.Timestamp().AsTime()) {
+					ip.CopyTo(m.ExponentialHistogram().DataPoints().AppendEmpty())
+				} else {
+					a.logger.With(
+						zap.String("metric_name", metric.Name()),
+					).Warn("Dropped misaligned exponential histogram datapoint")
+					continue
+				}
+			} else {
+				accumulateExponentialHistogramValues(pp, ip, m.ExponentialHistogram().DataPoints().AppendEmpty())
+			}
+		case pmetric.AggregationTemporalityCumulative:
+			if ip.Timestamp().AsTime().Before(mv.value.ExponentialHistogram().DataPoints().At(0).Timestamp().AsTime()) {
+				// only keep datapoint with latest timestamp
+				continue
+			}
+
+			ip.CopyTo(m.ExponentialHistogram().DataPoints().AppendEmpty())
+		default:
+			// unsupported temporality
+			continue
+		}
+		a.registeredMetrics.Store(signature, &accumulatedValue{value: m, resourceAttrs: resourceAttrs, scope: il, updated: now})
+		n++
+	}
+	return
+}
+
 // Collect returns a slice with relevant aggregated metrics and their resource attributes.
 func (a *lastValueAccumulator) Collect() ([]pmetric.Metric, []pcommon.Map) {
 	a.logger.Debug("Accumulator collect called")
@@ -382,3 +447,20 @@ func accumulateHistogramValues(prev, current, dest pmetric.HistogramDataPoint) {
 
 	dest.ExplicitBounds().FromRaw(newer.ExplicitBounds().AsRaw())
 }
+
+func accumulateExponentialHistogramValues(prev, current, dest pmetric.ExponentialHistogramDataPoint) {
+	older := prev
+	newer := current
+	if current.Timestamp().AsTime().Before(prev.Timestamp().AsTime()) {
+		older = current
+		newer = prev
+	}
+
+	older.CopyTo(dest)
+	exphistogram.Merge(dest, newer, 0)
+	newer.Attributes().CopyTo(dest.Attributes())
+	// the exemplars of the latest data point are kept instead of being accumulated
+	newer.Exemplars().CopyTo(dest.Exemplars())
+	dest.SetStartTimestamp(prev.StartTimestamp())
+	dest.SetTimestamp(newer.Timestamp())
+}
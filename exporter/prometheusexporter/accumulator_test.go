@@ -13,6 +13,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 )
 
 func TestAccumulateMetrics(t *testing.T) {
@@ -566,6 +568,92 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 	})
 }
 
+func TestAccumulateDeltaToCumulativeExponentialHistogram(t *testing.T) {
+	appendDeltaExponentialHistogram := func(startTs time.Time, ts time.Time, scale int32, offset int32, counts []uint64, metrics pmetric.MetricSlice) {
+		metric := metrics.AppendEmpty()
+		metric.SetName("test_metric")
+		metric.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		dp := metric.ExponentialHistogram().DataPoints().AppendEmpty()
+		dp.SetScale(scale)
+		dp.Positive().SetOffset(offset)
+		dp.Positive().BucketCounts().FromRaw(counts)
+		dp.SetZeroCount(1)
+		dp.SetCount(2)
+		dp.SetSum(3)
+		dp.Attributes().PutStr("label_1", "1")
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		dp.SetStartTimestamp(pcommon.NewTimestampFromTime(startTs))
+	}
+
+	startTs := time.Now().Add(-5 * time.Second)
+	ts1 := time.Now().Add(-4 * time.Second)
+	ts2 := time.Now().Add(-3 * time.Second)
+	ts3 := time.Now().Add(-2 * time.Second)
+	resourceMetrics := pmetric.NewResourceMetrics()
+	ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
+	ilm.Scope().SetName("test")
+	appendDeltaExponentialHistogram(startTs, ts1, 2, 3, []uint64{1, 2, 3}, ilm.Metrics())
+	// the buckets are merged at the lowest scale
+	appendDeltaExponentialHistogram(ts1, ts2, 1, 0, []uint64{4}, ilm.Metrics())
+	// a misaligned data point is dropped
+	appendDeltaExponentialHistogram(startTs, ts3, 1, 0, []uint64{5}, ilm.Metrics())
+
+	a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+	require.Equal(t, 2, a.Accumulate(resourceMetrics))
+
+	dp := ilm.Metrics().At(0).ExponentialHistogram().DataPoints().At(0)
+	signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), dp.Attributes(), pcommon.NewMap())
+	m, ok := a.registeredMetrics.Load(signature)
+	require.True(t, ok)
+	v := m.(*accumulatedValue).value
+	require.Equal(t, pmetric.AggregationTemporalityCumulative, v.ExponentialHistogram().AggregationTemporality())
+	got := v.ExponentialHistogram().DataPoints().At(0)
+	require.Equal(t, pcommon.NewTimestampFromTime(startTs), got.StartTimestamp())
+	require.Equal(t, pcommon.NewTimestampFromTime(ts2), got.Timestamp())
+	require.Equal(t, int32(1), got.Scale())
+	require.Equal(t, uint64(4), got.Count())
+	require.Equal(t, 6.0, got.Sum())
+	require.Equal(t, uint64(2), got.ZeroCount())
+	// the buckets 3, 4 and 5 at scale 2 are the buckets 1, 2 and 2 at scale 1
+	require.Equal(t, int32(0), got.Positive().Offset())
+	require.Equal(t, []uint64{4, 1, 5}, got.Positive().BucketCounts().AsRaw())
+}
+
+func TestAccumulateDeltaToCumulativeExponentialHistogramMaxSize(t *testing.T) {
+	startTs := time.Now().Add(-5 * time.Second)
+	ts1 := time.Now().Add(-4 * time.Second)
+	ts2 := time.Now().Add(-3 * time.Second)
+	resourceMetrics := pmetric.NewResourceMetrics()
+	ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
+	ilm.Scope().SetName("test")
+	// the values 1 and 2^40 are 40*2^20 buckets apart at scale 20
+	for i, offset := range []int32{0, 40 << 20} {
+		metric := ilm.Metrics().AppendEmpty()
+		metric.SetName("test_metric")
+		metric.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		dp := metric.ExponentialHistogram().DataPoints().AppendEmpty()
+		dp.SetScale(20)
+		dp.Positive().SetOffset(offset)
+		dp.Positive().BucketCounts().FromRaw([]uint64{1})
+		dp.SetCount(1)
+		dp.SetStartTimestamp(pcommon.NewTimestampFromTime([]time.Time{startTs, ts1}[i]))
+		dp.SetTimestamp(pcommon.NewTimestampFromTime([]time.Time{ts1, ts2}[i]))
+	}
+
+	a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+	require.Equal(t, 2, a.Accumulate(resourceMetrics))
+
+	dp := ilm.Metrics().At(0).ExponentialHistogram().DataPoints().At(0)
+	signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), dp.Attributes(), pcommon.NewMap())
+	m, ok := a.registeredMetrics.Load(signature)
+	require.True(t, ok)
+	got := m.(*accumulatedValue).value.ExponentialHistogram().DataPoints().At(0)
+	// the buckets are downscaled until they fit in the default maximum size
+	require.Equal(t, int32(1), got.Scale())
+	require.Equal(t, uint64(2), got.Count())
+	require.LessOrEqual(t, got.Positive().BucketCounts().Len(), exphistogram.DefaultMaxSize)
+}
+
 func TestAccumulateDroppedMetrics(t *testing.T) {
 	tests := []struct {
 		name       string
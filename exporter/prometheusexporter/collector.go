@@ -36,6 +36,7 @@ type collector struct {
 	constLabels       prometheus.Labels
 	metricFamilies    sync.Map
 	metricExpiration  time.Duration
+	nativeHistograms  NativeHistogramsConfig
 }
 
 type metricFamily struct {
@@ -52,6 +53,7 @@ func newCollector(config *Config, logger *zap.Logger) *collector {
 		constLabels:       config.ConstLabels,
 		addMetricSuffixes: config.AddMetricSuffixes,
 		metricExpiration:  config.MetricExpiration,
+		nativeHistograms:  config.NativeHistograms,
 	}
 }
 
@@ -109,6 +111,8 @@ func (c *collector) convertMetric(metric pmetric.Metric, resourceAttrs pcommon.M
 		return c.convertSum(metric, resourceAttrs)
 	case pmetric.MetricTypeHistogram:
 		return c.convertDoubleHistogram(metric, resourceAttrs)
+	case pmetric.MetricTypeExponentialHistogram:
+		return c.convertExponentialHistogram(metric, resourceAttrs)
 	case pmetric.MetricTypeSummary:
 		return c.convertSummary(metric, resourceAttrs)
 	}
@@ -314,6 +318,52 @@ func (c *collector) convertDoubleHistogram(metric pmetric.Metric, resourceAttrs
 	return m, nil
 }
 
+// convertExponentialHistogram converts the exponential histogram to a native histogram, with the classic buckets
+// of the fallback for the scrapers not negotiating the protobuf format.
+func (c *collector) convertExponentialHistogram(metric pmetric.Metric, resourceAttrs pcommon.Map) (prometheus.Metric, error) {
+	ip := metric.ExponentialHistogram().DataPoints().At(0)
+	desc, attributes, err := c.getMetricMetadata(metric, dto.MetricType_HISTOGRAM.Enum(), ip.Attributes(), resourceAttrs)
+	if err != nil {
+		return nil, err
+	}
+
+	var points map[float64]uint64
+	switch {
+	case c.nativeHistograms.ClassicFallback && len(c.nativeHistograms.ClassicBounds) > 0:
+		points = fixedClassicBuckets(ip, c.nativeHistograms.ClassicBounds)
+	case c.nativeHistograms.ClassicFallback:
+		points = classicBuckets(ip, c.nativeHistograms.MaxClassicBuckets)
+	}
+
+	var m prometheus.Metric
+	if ip.StartTimestamp().AsTime().Unix() > 0 {
+		m, err = prometheus.NewConstHistogramWithCreatedTimestamp(desc, ip.Count(), ip.Sum(), points, ip.StartTimestamp().AsTime(), attributes...)
+	} else {
+		m, err = prometheus.NewConstHistogram(desc, ip.Count(), ip.Sum(), points, attributes...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	exemplars := convertExemplars(ip.Exemplars())
+	if len(exemplars) > 0 {
+		m, err = prometheus.NewMetricWithExemplars(m, exemplars...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m, err = newNativeHistogram(m, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.sendTimestamps {
+		return prometheus.NewMetricWithTimestamp(ip.Timestamp().AsTime(), m), nil
+	}
+	return m, nil
+}
+
 func (c *collector) createTargetInfoMetrics(resourceAttrs []pcommon.Map) ([]prometheus.Metric, error) {
 	var lastErr error
 
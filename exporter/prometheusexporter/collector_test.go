@@ -5,6 +5,7 @@ package prometheusexporter
 
 import (
 	"encoding/hex"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
 	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -243,6 +245,113 @@ func TestConvertDoubleHistogramExemplar(t *testing.T) {
 	exemplarsEqual(t, promExporterExemplars, buckets[0].GetExemplar())
 }
 
+func TestConvertExponentialHistogram(t *testing.T) {
+	metric := pmetric.NewMetric()
+	metric.SetName("test_metric")
+	metric.SetDescription("this is test metric")
+
+	dp := metric.SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(time.Unix(100, 0)))
+	dp.SetScale(10)
+	dp.SetCount(9)
+	dp.SetSum(20)
+	dp.SetZeroCount(1)
+	dp.SetZeroThreshold(0.5)
+	// the buckets of indices 1022 to 1025 are merged into the buckets 255 and 256 at scale 8
+	dp.Positive().SetOffset(1022)
+	dp.Positive().BucketCounts().FromRaw([]uint64{1, 2, 3, 0})
+	dp.Negative().SetOffset(-1)
+	dp.Negative().BucketCounts().FromRaw([]uint64{2})
+	setTestExemplarWithDoubleValue(dp.Exemplars().AppendEmpty(), 3.0)
+
+	tests := []struct {
+		name             string
+		nativeHistograms NativeHistogramsConfig
+		wantBuckets      []*io_prometheus_client.Bucket
+	}{
+		{
+			name:             "without classic fallback",
+			nativeHistograms: NativeHistogramsConfig{},
+			wantBuckets: []*io_prometheus_client.Bucket{
+				{CumulativeCount: proto.Uint64(9), UpperBound: proto.Float64(math.Inf(1))},
+			},
+		},
+		{
+			name:             "with classic fallback",
+			nativeHistograms: NativeHistogramsConfig{ClassicFallback: true, MaxClassicBuckets: 2},
+			wantBuckets: []*io_prometheus_client.Bucket{
+				// the buckets are merged until scale -1, whose base is 4
+				{CumulativeCount: proto.Uint64(2), UpperBound: proto.Float64(-0.25)},
+				{CumulativeCount: proto.Uint64(3), UpperBound: proto.Float64(0.5)},
+				{CumulativeCount: proto.Uint64(9), UpperBound: proto.Float64(4)},
+			},
+		},
+		{
+			name:             "with classic fallback and fixed bounds",
+			nativeHistograms: NativeHistogramsConfig{ClassicFallback: true, ClassicBounds: []float64{-1, 0, 2, 10}},
+			wantBuckets: []*io_prometheus_client.Bucket{
+				{CumulativeCount: proto.Uint64(0), UpperBound: proto.Float64(-1)},
+				{CumulativeCount: proto.Uint64(3), UpperBound: proto.Float64(0)},
+				{CumulativeCount: proto.Uint64(6), UpperBound: proto.Float64(2)},
+				{CumulativeCount: proto.Uint64(9), UpperBound: proto.Float64(10)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := collector{
+				logger:           zap.NewNop(),
+				nativeHistograms: tt.nativeHistograms,
+			}
+
+			pbMetric, err := c.convertExponentialHistogram(metric, pcommon.NewMap())
+			require.NoError(t, err)
+			m := io_prometheus_client.Metric{}
+			require.NoError(t, pbMetric.Write(&m))
+
+			h := m.GetHistogram()
+			assert.Equal(t, uint64(9), h.GetSampleCount())
+			assert.Equal(t, 20.0, h.GetSampleSum())
+			assert.Equal(t, int64(100), h.GetCreatedTimestamp().GetSeconds())
+			assert.Equal(t, int32(8), h.GetSchema())
+			assert.Equal(t, 0.5, h.GetZeroThreshold())
+			assert.Equal(t, uint64(1), h.GetZeroCount())
+			require.Len(t, h.GetPositiveSpan(), 1)
+			assert.Equal(t, int32(256), h.GetPositiveSpan()[0].GetOffset())
+			assert.Equal(t, uint32(2), h.GetPositiveSpan()[0].GetLength())
+			assert.Equal(t, []int64{3, 0}, h.GetPositiveDelta())
+			require.Len(t, h.GetNegativeSpan(), 1)
+			assert.Equal(t, int32(0), h.GetNegativeSpan()[0].GetOffset())
+			assert.Equal(t, []int64{2}, h.GetNegativeDelta())
+
+			require.Len(t, h.GetBucket(), len(tt.wantBuckets))
+			for i, b := range h.GetBucket() {
+				assert.Equal(t, tt.wantBuckets[i].GetUpperBound(), b.GetUpperBound())
+				assert.Equal(t, tt.wantBuckets[i].GetCumulativeCount(), b.GetCumulativeCount())
+			}
+			require.Len(t, h.GetExemplars(), 1)
+			exemplarsEqual(t, dp.Exemplars().At(0), h.GetExemplars()[0])
+		})
+	}
+
+	// the exponential histograms with less resolution than the native histograms can't be converted
+	dp.SetScale(-5)
+	c := collector{logger: zap.NewNop()}
+	_, err := c.convertExponentialHistogram(metric, pcommon.NewMap())
+	assert.ErrorContains(t, err, "the scale must be >= -4")
+
+	// the native histograms without buckets have an empty span, to be recognized as native
+	dp.SetScale(0)
+	dp.Positive().BucketCounts().FromRaw(nil)
+	dp.Negative().BucketCounts().FromRaw(nil)
+	pbMetric, err := c.convertExponentialHistogram(metric, pcommon.NewMap())
+	require.NoError(t, err)
+	m := io_prometheus_client.Metric{}
+	require.NoError(t, pbMetric.Write(&m))
+	require.Len(t, m.GetHistogram().GetPositiveSpan(), 1)
+	assert.Equal(t, uint32(0), m.GetHistogram().GetPositiveSpan()[0].GetLength())
+}
+
 func TestConvertMonotonicSumExemplar(t *testing.T) {
 	// initialize empty metric
 	metric := pmetric.NewMetric()
@@ -4,6 +4,7 @@
 package prometheusexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
 
 import (
+	"errors"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -37,11 +38,39 @@ type Config struct {
 
 	// AddMetricSuffixes controls whether suffixes are added to metric names. Defaults to true.
 	AddMetricSuffixes bool `mapstructure:"add_metric_suffixes"`
+
+	// NativeHistograms configures the exposition of exponential histograms as Prometheus native histograms.
+	NativeHistograms NativeHistogramsConfig `mapstructure:"native_histograms"`
+}
+
+// NativeHistogramsConfig configures the exposition of exponential histograms. The native histograms are only
+// exposed to the scrapers negotiating the protobuf format, the other scrapers only get the classic buckets.
+type NativeHistogramsConfig struct {
+	// ClassicFallback enables the exposition of classic buckets along the native buckets, for the scrapers
+	// not negotiating the protobuf format. If false, these scrapers only get the count and sum.
+	ClassicFallback bool `mapstructure:"classic_fallback"`
+
+	// MaxClassicBuckets is the maximum number of classic buckets, not counting the zero bucket.
+	// The exponential buckets are merged until they fit.
+	MaxClassicBuckets int `mapstructure:"max_classic_buckets"`
+
+	// ClassicBounds are the fixed bounds of the classic buckets. If set, the counts of the exponential buckets
+	// are split between the classic buckets instead of merging the exponential buckets, and MaxClassicBuckets
+	// is ignored.
+	ClassicBounds []float64 `mapstructure:"classic_bounds"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.NativeHistograms.ClassicFallback && len(cfg.NativeHistograms.ClassicBounds) == 0 && cfg.NativeHistograms.MaxClassicBuckets < 2 {
+		return errors.New("native_histograms::max_classic_buckets must be at least 2")
+	}
+	for i := 1; i < len(cfg.NativeHistograms.ClassicBounds); i++ {
+		if cfg.NativeHistograms.ClassicBounds[i] <= cfg.NativeHistograms.ClassicBounds[i-1] {
+			return errors.New("native_histograms::classic_bounds must be strictly increasing")
+		}
+	}
 	return nil
 }
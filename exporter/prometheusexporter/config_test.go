@@ -53,6 +53,10 @@ func TestLoadConfig(t *testing.T) {
 				SendTimestamps:    true,
 				MetricExpiration:  60 * time.Minute,
 				AddMetricSuffixes: false,
+				NativeHistograms: NativeHistogramsConfig{
+					ClassicFallback:   false,
+					MaxClassicBuckets: 20,
+				},
 			},
 		},
 	}
@@ -71,3 +75,32 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expectedErr string
+	}{
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_max_classic_buckets"),
+			expectedErr: "native_histograms::max_classic_buckets must be at least 2",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_classic_bounds"),
+			expectedErr: "native_histograms::classic_bounds must be strictly increasing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+		})
+	}
+}
@@ -31,6 +31,10 @@ func createDefaultConfig() component.Config {
 		MetricExpiration:  time.Minute * 5,
 		EnableOpenMetrics: false,
 		AddMetricSuffixes: true,
+		NativeHistograms: NativeHistogramsConfig{
+			ClassicFallback:   true,
+			MaxClassicBuckets: 20,
+		},
 	}
 }
 
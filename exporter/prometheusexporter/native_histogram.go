@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
+)
+
+const (
+	// the range of the schemas of the Prometheus native histograms
+	nativeHistogramMinSchema = -4
+	nativeHistogramMaxSchema = 8
+)
+
+// nativeHistogram adds the native buckets to the histogram written by the wrapped metric, which has the
+// classic buckets, count, sum and created timestamp.
+type nativeHistogram struct {
+	prometheus.Metric
+
+	schema        int32
+	zeroThreshold float64
+	zeroCount     uint64
+	positiveSpans []*dto.BucketSpan
+	positiveDelta []int64
+	negativeSpans []*dto.BucketSpan
+	negativeDelta []int64
+}
+
+func (h *nativeHistogram) Write(pb *dto.Metric) error {
+	if err := h.Metric.Write(pb); err != nil {
+		return err
+	}
+	histogram := pb.GetHistogram()
+	if histogram == nil {
+		return fmt.Errorf("expected a histogram but got %v", pb)
+	}
+	histogram.Schema = proto.Int32(h.schema)
+	histogram.ZeroThreshold = proto.Float64(h.zeroThreshold)
+	histogram.ZeroCount = proto.Uint64(h.zeroCount)
+	histogram.PositiveSpan = h.positiveSpans
+	histogram.PositiveDelta = h.positiveDelta
+	histogram.NegativeSpan = h.negativeSpans
+	histogram.NegativeDelta = h.negativeDelta
+	if len(h.positiveSpans) == 0 && len(h.negativeSpans) == 0 {
+		// a span with no bucket tells the scrapers that the histogram is native, as done by the Prometheus client
+		histogram.PositiveSpan = []*dto.BucketSpan{{Offset: proto.Int32(0), Length: proto.Uint32(0)}}
+	}
+	// the exemplars of the native histograms aren't attached to buckets
+	for _, b := range histogram.GetBucket() {
+		if b.GetExemplar() != nil {
+			histogram.Exemplars = append(histogram.Exemplars, b.GetExemplar())
+		}
+	}
+	return nil
+}
+
+// newNativeHistogram returns the metric wrapping the classic histogram metric with the native buckets of the
+// exponential histogram data point.
+func newNativeHistogram(classic prometheus.Metric, dp pmetric.ExponentialHistogramDataPoint) (prometheus.Metric, error) {
+	scale := dp.Scale()
+	if scale < nativeHistogramMinSchema {
+		return nil, fmt.Errorf("cannot convert exponential to native histogram, the scale must be >= %d but is %d", nativeHistogramMinSchema, scale)
+	}
+	// the exponential histograms with more resolution than the native histograms are downscaled
+	by := max(0, scale-nativeHistogramMaxSchema)
+
+	h := &nativeHistogram{
+		Metric:        classic,
+		schema:        scale - by,
+		zeroThreshold: dp.ZeroThreshold(),
+		zeroCount:     dp.ZeroCount(),
+	}
+	h.positiveSpans, h.positiveDelta = nativeBuckets(dp.Positive(), by)
+	h.negativeSpans, h.negativeDelta = nativeBuckets(dp.Negative(), by)
+	return h, nil
+}
+
+// nativeBuckets converts the exponential buckets downscaled by the given amount to the span and deltas of the
+// native buckets.
+func nativeBuckets(buckets pmetric.ExponentialHistogramDataPointBuckets, by int32) ([]*dto.BucketSpan, []int64) {
+	offset, counts := exphistogram.Downscale(buckets.Offset(), buckets.BucketCounts().AsRaw(), by)
+	if len(counts) == 0 {
+		return nil, nil
+	}
+
+	deltas := make([]int64, len(counts))
+	prev := int64(0)
+	for i, count := range counts {
+		deltas[i] = int64(count) - prev
+		prev = int64(count)
+	}
+	// the exponential buckets are lower-exclusive while the native buckets are upper-exclusive,
+	// so the index of an exponential bucket is one less than the index of the native bucket
+	return []*dto.BucketSpan{{Offset: proto.Int32(offset + 1), Length: proto.Uint32(uint32(len(counts)))}}, deltas
+}
+
+// classicBuckets returns the cumulative counts of the classic buckets by upper bound, from the exponential
+// buckets merged until there are at most maxBuckets of them, not counting the zero bucket.
+func classicBuckets(dp pmetric.ExponentialHistogramDataPoint, maxBuckets int) map[float64]uint64 {
+	scale := dp.Scale()
+	posOffset, posCounts := dp.Positive().Offset(), dp.Positive().BucketCounts().AsRaw()
+	negOffset, negCounts := dp.Negative().Offset(), dp.Negative().BucketCounts().AsRaw()
+	for len(posCounts)+len(negCounts) > maxBuckets && (canDownscale(posOffset, posCounts) || canDownscale(negOffset, negCounts)) {
+		posOffset, posCounts = exphistogram.Downscale(posOffset, posCounts, 1)
+		negOffset, negCounts = exphistogram.Downscale(negOffset, negCounts, 1)
+		scale--
+	}
+
+	points := make(map[float64]uint64, len(posCounts)+len(negCounts)+1)
+	cumCount := uint64(0)
+	// the negative bucket of index i is [-base^(i+1), -base^i)
+	for i := len(negCounts) - 1; i >= 0; i-- {
+		cumCount += negCounts[i]
+		points[-exphistogram.Bound(negOffset+int32(i), scale)] = cumCount
+	}
+	cumCount += dp.ZeroCount()
+	points[dp.ZeroThreshold()] = cumCount
+	// the positive bucket of index i is (base^i, base^(i+1)]
+	for i, count := range posCounts {
+		cumCount += count
+		points[exphistogram.Bound(posOffset+int32(i)+1, scale)] = cumCount
+	}
+	return points
+}
+
+// fixedClassicBuckets returns the cumulative counts of the classic buckets by upper bound, with the fixed bounds
+// splitting the counts of the exponential buckets.
+func fixedClassicBuckets(dp pmetric.ExponentialHistogramDataPoint, bounds []float64) map[float64]uint64 {
+	hdp := pmetric.NewHistogramDataPoint()
+	exphistogram.ToHistogramDataPoint(dp, bounds, hdp)
+	points := make(map[float64]uint64, len(bounds))
+	cumCount := uint64(0)
+	for i, bound := range bounds {
+		cumCount += hdp.BucketCounts().At(i)
+		points[bound] = cumCount
+	}
+	return points
+}
+
+// canDownscale reports whether downscaling can still merge the buckets, which it can't once their indices are -1 and 0.
+func canDownscale(offset int32, counts []uint64) bool {
+	return len(counts) > 0 && (offset < -1 || offset+int32(len(counts))-1 > 0)
+}
@@ -5,6 +5,7 @@ package prometheusexporter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +13,8 @@ import (
 	"testing"
 	"time"
 
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -455,6 +458,84 @@ func TestPrometheusExporter_endToEndWithResource(t *testing.T) {
 	}
 }
 
+func TestPrometheusExporter_endToEndNativeHistograms(t *testing.T) {
+	cfg := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: "localhost:7778",
+		},
+		MetricExpiration: 120 * time.Minute,
+		NativeHistograms: NativeHistogramsConfig{
+			ClassicFallback:   true,
+			MaxClassicBuckets: 2,
+		},
+	}
+
+	factory := NewFactory()
+	set := exportertest.NewNopSettings()
+	exp, err := factory.CreateMetrics(context.Background(), set, cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, exp.Shutdown(context.Background()))
+	})
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	md := pmetric.NewMetrics()
+	metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("test_hist")
+	histogram := metric.SetEmptyExponentialHistogram()
+	histogram.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := histogram.DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	dp.SetScale(0)
+	dp.SetCount(3)
+	dp.SetSum(5)
+	dp.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+	require.NoError(t, exp.ConsumeMetrics(context.Background(), md))
+
+	// the scrapers of the text format get the classic buckets
+	rsp, err := http.Get("http://localhost:7778/metrics")
+	require.NoError(t, err, "Failed to perform a scrape")
+	blob, _ := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	for _, w := range []string{
+		`# TYPE test_hist histogram`,
+		`test_hist_bucket{le="0"} 0`,
+		`test_hist_bucket{le="2"} 1`,
+		`test_hist_bucket{le="4"} 3`,
+		`test_hist_bucket{le="+Inf"} 3`,
+		`test_hist_count 3`,
+	} {
+		assert.Contains(t, string(blob), w)
+	}
+
+	// the scrapers of the protobuf format get the native buckets
+	req, err := http.NewRequest(http.MethodGet, "http://localhost:7778/metrics", nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeProtoDelim)))
+	rsp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err, "Failed to perform a scrape")
+	defer rsp.Body.Close()
+	decoder := expfmt.NewDecoder(rsp.Body, expfmt.ResponseFormat(rsp.Header))
+	var found bool
+	for {
+		mf := &dto.MetricFamily{}
+		if err = decoder.Decode(mf); errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		if mf.GetName() != "test_hist" {
+			continue
+		}
+		found = true
+		h := mf.GetMetric()[0].GetHistogram()
+		assert.Equal(t, int32(0), h.GetSchema())
+		require.Len(t, h.GetPositiveSpan(), 1)
+		assert.Equal(t, int32(1), h.GetPositiveSpan()[0].GetOffset())
+		assert.Equal(t, []int64{1, 1}, h.GetPositiveDelta())
+	}
+	assert.True(t, found)
+}
+
 func metricBuilder(delta int64, prefix, job, instance string) pmetric.Metrics {
 	md := pmetric.NewMetrics()
 	rms := md.ResourceMetrics().AppendEmpty()
@@ -54,6 +54,29 @@ type Config struct {
 
 	// SendMetadata controls whether prometheus metadata will be generated and sent
 	SendMetadata bool `mapstructure:"send_metadata"`
+
+	// RemoteWriteProtoMsg is the protobuf message sent to the remote endpoint: "prometheus.WriteRequest"
+	// for remote write 1.0, or "io.prometheus.write.v2.Request" for remote write 2.0.
+	RemoteWriteProtoMsg protoMsg `mapstructure:"protobuf_message"`
+}
+
+// protoMsg is the protobuf message sent to the remote endpoint.
+type protoMsg string
+
+const (
+	// protoMsgV1 is the remote write 1.0 message.
+	protoMsgV1 protoMsg = "prometheus.WriteRequest"
+	// protoMsgV2 is the remote write 2.0 message.
+	protoMsgV2 protoMsg = "io.prometheus.write.v2.Request"
+)
+
+// Validate checks if the protobuf message is supported.
+func (m protoMsg) Validate() error {
+	switch m {
+	case protoMsgV1, protoMsgV2:
+		return nil
+	}
+	return fmt.Errorf("unknown remote write protobuf message %v, supported: %v, %v", m, protoMsgV1, protoMsgV2)
 }
 
 type CreatedMetric struct {
@@ -117,5 +140,10 @@ func (cfg *Config) Validate() error {
 		cfg.MaxBatchSizeBytes = 3000000
 	}
 
+	// the protobuf message is validated by its own Validate method
+	if cfg.RemoteWriteProtoMsg == protoMsgV2 && cfg.WAL != nil {
+		return fmt.Errorf("the WAL is not supported with the %q protobuf message", protoMsgV2)
+	}
+
 	return nil
 }
@@ -79,7 +79,8 @@ func TestLoadConfig(t *testing.T) {
 				TargetInfo: &TargetInfo{
 					Enabled: true,
 				},
-				CreatedMetric: &CreatedMetric{Enabled: true},
+				CreatedMetric:       &CreatedMetric{Enabled: true},
+				RemoteWriteProtoMsg: protoMsgV1,
 			},
 		},
 		{
@@ -90,6 +91,14 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "negative_num_consumers"),
 			errorMessage: "remote write consumer number can't be negative",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_protobuf_message"),
+			errorMessage: "unknown remote write protobuf message prometheus.WriteRequestV3, supported: prometheus.WriteRequest, io.prometheus.write.v2.Request",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "remote_write_v2_wal"),
+			errorMessage: `the WAL is not supported with the "io.prometheus.write.v2.Request" protobuf message`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -136,3 +145,18 @@ func TestDisabledTargetInfo(t *testing.T) {
 
 	assert.False(t, cfg.(*Config).TargetInfo.Enabled)
 }
+
+func TestRemoteWriteProtoMsg(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.Equal(t, protoMsgV1, cfg.(*Config).RemoteWriteProtoMsg)
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "remote_write_v2").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	assert.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t, protoMsgV2, cfg.(*Config).RemoteWriteProtoMsg)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
 	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configretry"
@@ -85,6 +86,7 @@ type prwExporter struct {
 	exporterSettings     prometheusremotewrite.Settings
 	telemetry            prwTelemetry
 	batchTimeSeriesState batchTimeSeriesState
+	protoMsg             protoMsg
 }
 
 func newPRWTelemetry(set exporter.Settings) (prwTelemetry, error) {
@@ -141,6 +143,7 @@ func newPRWExporter(cfg *Config, set exporter.Settings) (*prwExporter, error) {
 		},
 		telemetry:            prwTelemetry,
 		batchTimeSeriesState: newBatchTimeSericesState(),
+		protoMsg:             cfg.RemoteWriteProtoMsg,
 	}
 
 	if prwe.exporterSettings.ExportCreatedMetric {
@@ -191,6 +194,9 @@ func (prwe *prwExporter) PushMetrics(ctx context.Context, md pmetric.Metrics) er
 	case <-prwe.closeChan:
 		return errors.New("shutdown has been called")
 	default:
+		if prwe.protoMsg == protoMsgV2 {
+			return prwe.pushMetricsV2(ctx, md)
+		}
 
 		tsMap, err := prometheusremotewrite.FromMetrics(md, prwe.exporterSettings)
 		if err != nil {
@@ -210,6 +216,34 @@ func (prwe *prwExporter) PushMetrics(ctx context.Context, md pmetric.Metrics) er
 	}
 }
 
+// pushMetricsV2 converts metrics to Prometheus remote write 2.0 TimeSeries and sends them to the remote endpoint.
+// The metadata of the series is part of the 2.0 format, so it's always sent.
+func (prwe *prwExporter) pushMetricsV2(ctx context.Context, md pmetric.Metrics) error {
+	tsMap, symbolsTable, err := prometheusremotewrite.FromMetricsV2(md, prwe.exporterSettings)
+	if err != nil {
+		prwe.telemetry.recordTranslationFailure(ctx)
+		prwe.settings.Logger.Debug("failed to translate metrics, exporting remaining metrics", zap.Error(err), zap.Int("translated", len(tsMap)))
+	}
+
+	prwe.telemetry.recordTranslatedTimeSeries(ctx, len(tsMap))
+
+	// There are no metrics to export, so return.
+	if len(tsMap) == 0 {
+		return nil
+	}
+
+	// Call export even if a conversion error, since there may be points that were successfully converted.
+	requests, err := batchTimeSeriesV2(tsMap, symbolsTable.Symbols(), prwe.maxBatchSizeBytes, &prwe.batchTimeSeriesState)
+	if err != nil {
+		return err
+	}
+	messages := make([]proto.Message, 0, len(requests))
+	for _, request := range requests {
+		messages = append(messages, request)
+	}
+	return prwe.exportMessages(ctx, messages)
+}
+
 func validateAndSanitizeExternalLabels(cfg *Config) (map[string]string, error) {
 	sanitizedLabels := make(map[string]string)
 	for key, value := range cfg.ExternalLabels {
@@ -248,7 +282,16 @@ func (prwe *prwExporter) handleExport(ctx context.Context, tsMap map[string]*pro
 
 // export sends a Snappy-compressed WriteRequest containing TimeSeries to a remote write endpoint in order
 func (prwe *prwExporter) export(ctx context.Context, requests []*prompb.WriteRequest) error {
-	input := make(chan *prompb.WriteRequest, len(requests))
+	messages := make([]proto.Message, 0, len(requests))
+	for _, request := range requests {
+		messages = append(messages, request)
+	}
+	return prwe.exportMessages(ctx, messages)
+}
+
+// exportMessages sends the Snappy-compressed remote write requests to the remote write endpoint in order
+func (prwe *prwExporter) exportMessages(ctx context.Context, requests []proto.Message) error {
+	input := make(chan proto.Message, len(requests))
 	for _, request := range requests {
 		input <- request
 	}
@@ -289,7 +332,7 @@ func (prwe *prwExporter) export(ctx context.Context, requests []*prompb.WriteReq
 	return errs
 }
 
-func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequest) error {
+func (prwe *prwExporter) execute(ctx context.Context, writeReq proto.Message) error {
 	buf := bufferPool.Get().(*buffer)
 	buf.protobuf.Reset()
 	defer bufferPool.Put(buf)
@@ -330,9 +373,15 @@ func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequ
 
 		// Add necessary headers specified by:
 		// https://cortexmetrics.io/docs/apis/#remote-api
+		// https://prometheus.io/docs/specs/remote_write_spec_2_0/#protocol
 		req.Header.Add("Content-Encoding", "snappy")
-		req.Header.Set("Content-Type", "application/x-protobuf")
-		req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+		if _, ok := writeReq.(*writev2.Request); ok {
+			req.Header.Set("Content-Type", "application/x-protobuf;proto="+string(protoMsgV2))
+			req.Header.Set("X-Prometheus-Remote-Write-Version", "2.0.0")
+		} else {
+			req.Header.Set("Content-Type", "application/x-protobuf")
+			req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+		}
 		req.Header.Set("User-Agent", prwe.userAgentHeader)
 
 		resp, err := prwe.client.Do(req)
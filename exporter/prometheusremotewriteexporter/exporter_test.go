@@ -18,8 +18,10 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/model/value"
 	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -800,6 +802,55 @@ func Test_PushMetrics(t *testing.T) {
 	}
 }
 
+// Test_PushMetricsV2 checks that the metrics are sent as remote write 2.0 requests when configured.
+func Test_PushMetricsV2(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2.0.0", r.Header.Get("X-Prometheus-Remote-Write-Version"))
+		assert.Equal(t, "application/x-protobuf;proto=io.prometheus.write.v2.Request", r.Header.Get("Content-Type"))
+		assert.Equal(t, "snappy", r.Header.Get("Content-Encoding"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		dest, err := snappy.Decode(nil, body)
+		require.NoError(t, err)
+		writeReq := &writev2.Request{}
+		require.NoError(t, proto.Unmarshal(dest, writeReq))
+
+		b := labels.NewScratchBuilder(0)
+		for _, ts := range writeReq.Timeseries {
+			received = append(received, ts.ToLabels(&b, writeReq.Symbols).Get(labels.MetricName))
+			if ts.Metadata.Type == writev2.Metadata_METRIC_TYPE_HISTOGRAM {
+				assert.Len(t, ts.Histograms, 1)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.ClientConfig.Endpoint = server.URL
+	cfg.RemoteWriteProtoMsg = protoMsgV2
+	cfg.RemoteWriteQueue.NumConsumers = 1
+	cfg.TargetInfo.Enabled = false
+	cfg.AddMetricSuffixes = false
+	require.NoError(t, cfg.Validate())
+
+	prwe, err := newPRWExporter(cfg, exportertest.NewNopSettings())
+	require.NoError(t, err)
+	require.NoError(t, prwe.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, prwe.Shutdown(context.Background()))
+	}()
+
+	md := getMetricsFromMetricList(
+		validMetrics1[validDoubleGauge],
+		getExpHistogramMetric("exponential_hist", lbs1, time1, &floatVal1, uint64(2), 2, []uint64{1, 1}),
+	)
+	require.NoError(t, prwe.PushMetrics(context.Background(), md))
+	assert.ElementsMatch(t, []string{validMetrics1[validDoubleGauge].Name(), "exponential_hist"}, received)
+}
+
 func Test_validateAndSanitizeExternalLabels(t *testing.T) {
 	tests := []struct {
 		name                string
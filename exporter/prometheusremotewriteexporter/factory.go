@@ -91,6 +91,8 @@ func createDefaultConfig() component.Config {
 		AddMetricSuffixes: true,
 		SendMetadata:      false,
 		ClientConfig:      clientConfig,
+		// remote write 1.0 is the most widely supported by the remote endpoints
+		RemoteWriteProtoMsg: protoMsgV1,
 		// TODO(jbd): Adjust the default queue size.
 		RemoteWriteQueue: RemoteWriteQueue{
 			Enabled:      true,
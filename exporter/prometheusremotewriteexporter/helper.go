@@ -9,6 +9,7 @@ import (
 	"sort"
 
 	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 )
 
 type batchTimeSeriesState struct {
@@ -93,6 +94,129 @@ func batchTimeSeries(tsMap map[string]*prompb.TimeSeries, maxBatchByteSize int,
 	return requests, nil
 }
 
+// batchTimeSeriesV2 splits series into multiple batch remote write 2.0 requests. The references of the series
+// are to the symbols, so they are re-indexed to a symbols table holding only the symbols of the request.
+func batchTimeSeriesV2(tsMap map[string]*writev2.TimeSeries, symbols []string, maxBatchByteSize int, state *batchTimeSeriesState) ([]*writev2.Request, error) {
+	if len(tsMap) == 0 {
+		return nil, errors.New("invalid tsMap: cannot be empty map")
+	}
+
+	// Allocate a buffer size of at least 10, or twice the last # of requests we sent
+	requests := make([]*writev2.Request, 0, max(10, state.nextRequestBufferSize))
+
+	// Allocate a time series buffer 2x the last time series batch size or the length of the input if smaller
+	tsArray := make([]writev2.TimeSeries, 0, min(state.nextTimeSeriesBufferSize, len(tsMap)))
+	table := newBatchSymbolsTable()
+	sizeOfCurrentBatch := 0
+
+	i := 0
+	for _, v := range tsMap {
+		sizeOfSeries := v.Size() + table.sizeOfNewSymbols(v, symbols)
+
+		if len(tsArray) > 0 && sizeOfCurrentBatch+sizeOfSeries >= maxBatchByteSize {
+			state.nextTimeSeriesBufferSize = max(10, 2*len(tsArray))
+			requests = append(requests, convertTimeseriesToRequestV2(tsArray, table.symbols))
+
+			tsArray = make([]writev2.TimeSeries, 0, min(state.nextTimeSeriesBufferSize, len(tsMap)-i))
+			table = newBatchSymbolsTable()
+			sizeOfCurrentBatch = 0
+			sizeOfSeries = v.Size() + table.sizeOfNewSymbols(v, symbols)
+		}
+
+		tsArray = append(tsArray, table.reindex(v, symbols))
+		sizeOfCurrentBatch += sizeOfSeries
+		i++
+	}
+
+	if len(tsArray) != 0 {
+		requests = append(requests, convertTimeseriesToRequestV2(tsArray, table.symbols))
+	}
+
+	state.nextRequestBufferSize = 2 * len(requests)
+	return requests, nil
+}
+
+// batchSymbolsTable is the symbols table of a single remote write 2.0 request.
+type batchSymbolsTable struct {
+	// refs maps the references to the symbols of all the series to the references to the symbols of the table.
+	refs    map[uint32]uint32
+	symbols []string
+}
+
+func newBatchSymbolsTable() *batchSymbolsTable {
+	// The first symbol of every request must be the empty string.
+	return &batchSymbolsTable{
+		refs:    map[uint32]uint32{0: 0},
+		symbols: []string{""},
+	}
+}
+
+// sizeOfNewSymbols returns the size of the symbols of the series that are not in the table yet.
+func (t *batchSymbolsTable) sizeOfNewSymbols(ts *writev2.TimeSeries, symbols []string) int {
+	size := 0
+	seen := map[uint32]struct{}{}
+	forEachSymbolRef(ts, func(ref *uint32) {
+		if _, ok := t.refs[*ref]; ok {
+			return
+		}
+		if _, ok := seen[*ref]; ok {
+			return
+		}
+		seen[*ref] = struct{}{}
+		size += len(symbols[*ref])
+	})
+	return size
+}
+
+// reindex returns a copy of the series referencing the symbols of the table, adding the missing ones.
+func (t *batchSymbolsTable) reindex(ts *writev2.TimeSeries, symbols []string) writev2.TimeSeries {
+	out := *ts
+	out.LabelsRefs = append([]uint32(nil), ts.LabelsRefs...)
+	if len(ts.Exemplars) != 0 {
+		out.Exemplars = make([]writev2.Exemplar, len(ts.Exemplars))
+		for i, e := range ts.Exemplars {
+			out.Exemplars[i] = e
+			out.Exemplars[i].LabelsRefs = append([]uint32(nil), e.LabelsRefs...)
+		}
+	}
+	forEachSymbolRef(&out, func(ref *uint32) {
+		newRef, ok := t.refs[*ref]
+		if !ok {
+			newRef = uint32(len(t.symbols))
+			t.refs[*ref] = newRef
+			t.symbols = append(t.symbols, symbols[*ref])
+		}
+		*ref = newRef
+	})
+	return out
+}
+
+func forEachSymbolRef(ts *writev2.TimeSeries, f func(ref *uint32)) {
+	for i := range ts.LabelsRefs {
+		f(&ts.LabelsRefs[i])
+	}
+	for i := range ts.Exemplars {
+		for j := range ts.Exemplars[i].LabelsRefs {
+			f(&ts.Exemplars[i].LabelsRefs[j])
+		}
+	}
+	f(&ts.Metadata.HelpRef)
+	f(&ts.Metadata.UnitRef)
+}
+
+func convertTimeseriesToRequestV2(tsArray []writev2.TimeSeries, symbols []string) *writev2.Request {
+	for i := range tsArray {
+		sL := tsArray[i].Samples
+		sort.Slice(sL, func(i, j int) bool {
+			return sL[i].Timestamp < sL[j].Timestamp
+		})
+	}
+	return &writev2.Request{
+		Symbols:    symbols,
+		Timeseries: tsArray,
+	}
+}
+
 func convertTimeseriesToRequest(tsArray []prompb.TimeSeries) *prompb.WriteRequest {
 	// the remote_write endpoint only requires the timeseries.
 	// otlp defines it's own way to handle metric metadata
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -80,6 +81,72 @@ func Test_batchTimeSeries(t *testing.T) {
 	}
 }
 
+// Test_batchTimeSeriesV2 checks batchTimeSeriesV2 return the correct number of requests
+// depending on byte size, with only the symbols of its series in every request.
+func Test_batchTimeSeriesV2(t *testing.T) {
+	symbols := []string{"", "__name__", "test_metric_0", "test_metric_1", "label", "value", "help"}
+	newTimeSeries := func(name uint32, timestamps ...int64) *writev2.TimeSeries {
+		ts := &writev2.TimeSeries{
+			LabelsRefs: []uint32{1, name, 4, 5},
+			Metadata:   writev2.Metadata{HelpRef: 6},
+		}
+		for _, timestamp := range timestamps {
+			ts.Samples = append(ts.Samples, writev2.Sample{Value: floatVal1, Timestamp: timestamp})
+		}
+		return ts
+	}
+	symbolsOf := func(request *writev2.Request) map[string]string {
+		ts := request.Timeseries[0]
+		return map[string]string{
+			request.Symbols[ts.LabelsRefs[0]]: request.Symbols[ts.LabelsRefs[1]],
+			request.Symbols[ts.LabelsRefs[2]]: request.Symbols[ts.LabelsRefs[3]],
+			"help":                            request.Symbols[ts.Metadata.HelpRef],
+			"unit":                            request.Symbols[ts.Metadata.UnitRef],
+		}
+	}
+
+	_, err := batchTimeSeriesV2(map[string]*writev2.TimeSeries{}, symbols, 100, &batchTimeSeriesState{})
+	assert.Error(t, err)
+
+	tsMap := map[string]*writev2.TimeSeries{
+		"0": newTimeSeries(2, msTime2, msTime1),
+		"1": newTimeSeries(3, msTime1, msTime2, msTime3),
+	}
+	state := newBatchTimeSericesState()
+	requests, err := batchTimeSeriesV2(tsMap, symbols, 300, &state)
+	assert.NoError(t, err)
+	assert.Len(t, requests, 1)
+	assert.ElementsMatch(t, symbols, requests[0].Symbols)
+	assert.Empty(t, requests[0].Symbols[0])
+	assert.Len(t, requests[0].Timeseries, 2)
+	for _, ts := range requests[0].Timeseries {
+		assert.Equal(t, msTime1, ts.Samples[0].Timestamp)
+	}
+
+	// every request only carries the symbols of its series
+	state = newBatchTimeSericesState()
+	requests, err = batchTimeSeriesV2(tsMap, symbols, tsMap["1"].Size()+30, &state)
+	assert.NoError(t, err)
+	assert.Len(t, requests, 2)
+	var names []string
+	for _, request := range requests {
+		assert.Len(t, request.Timeseries, 1)
+		assert.Len(t, request.Symbols, 6)
+		assert.Empty(t, request.Symbols[0])
+		labels := symbolsOf(request)
+		assert.Equal(t, "value", labels["label"])
+		assert.Equal(t, "help", labels["help"])
+		assert.Empty(t, labels["unit"])
+		names = append(names, labels["__name__"])
+	}
+	assert.ElementsMatch(t, []string{"test_metric_0", "test_metric_1"}, names)
+	assert.Equal(t, 4, state.nextRequestBufferSize)
+
+	// the series of the input are not modified
+	assert.Equal(t, []uint32{1, 2, 4, 5}, tsMap["0"].LabelsRefs)
+	assert.Equal(t, []uint32{1, 3, 4, 5}, tsMap["1"].LabelsRefs)
+}
+
 func Test_batchTimeSeriesUpdatesStateForLargeBatches(t *testing.T) {
 	labels := getPromLabels(label11, value11, label12, value12, label21, value21, label22, value22)
 	sample1 := getSample(floatVal1, msTime1)
@@ -21,6 +21,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/correlation"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 )
 
@@ -138,6 +139,10 @@ type Config struct {
 	// Whether to send histogram metrics in OTLP format to Splunk Observability.
 	// Default value is set to false.
 	SendOTLPHistograms bool `mapstructure:"send_otlp_histograms"`
+
+	// ExponentialHistograms defines how exponential histograms are converted to histograms or summaries
+	// before being dispatched to Splunk Observability, which doesn't support them.
+	ExponentialHistograms exphistogram.Config `mapstructure:"exponential_histograms"`
 }
 
 type DimensionClientConfig struct {
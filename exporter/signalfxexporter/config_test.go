@@ -27,6 +27,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 )
 
@@ -116,6 +117,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				NonAlphanumericDimensionChars: "_-.",
 				SendOTLPHistograms:            false,
+				ExponentialHistograms:         exphistogram.NewDefaultConfig(),
 			},
 		},
 		{
@@ -282,6 +284,11 @@ func TestLoadConfig(t *testing.T) {
 				},
 				NonAlphanumericDimensionChars: "_-.",
 				SendOTLPHistograms:            true,
+				ExponentialHistograms: exphistogram.Config{
+					ConvertTo:      exphistogram.ConvertToHistogram,
+					ExplicitBounds: []float64{0.1, 1, 10},
+					Quantiles:      exphistogram.NewDefaultConfig().Quantiles,
+				},
 			},
 		},
 	}
@@ -84,6 +84,7 @@ func newSignalFxExporter(
 		config.NonAlphanumericDimensionChars,
 		config.DropHistogramBuckets,
 		!config.SendOTLPHistograms, // if SendOTLPHistograms is true, do not process histograms when converting to SFx
+		config.ExponentialHistograms,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create metric converter: %w", err)
@@ -40,6 +40,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/utils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 	metadata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 )
@@ -190,7 +191,7 @@ func TestConsumeMetrics(t *testing.T) {
 			client, err := cfg.ToClient(context.Background(), componenttest.NewNopHost(), exportertest.NewNopSettings().TelemetrySettings)
 			require.NoError(t, err)
 
-			c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", false, true)
+			c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", false, true, exphistogram.NewDefaultConfig())
 			require.NoError(t, err)
 			require.NotNil(t, c)
 			dpClient := &sfxDPClient{
@@ -867,6 +868,7 @@ func TestConsumeMetadata(t *testing.T) {
 		cfg.NonAlphanumericDimensionChars,
 		false,
 		true,
+		exphistogram.NewDefaultConfig(),
 	)
 	require.NoError(t, err)
 	type args struct {
@@ -1229,7 +1231,7 @@ func BenchmarkExporterConsumeData(b *testing.B) {
 	serverURL, err := url.Parse(server.URL)
 	assert.NoError(b, err)
 
-	c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", false, true)
+	c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", false, true, exphistogram.NewDefaultConfig())
 	require.NoError(b, err)
 	require.NotNil(b, c)
 	dpClient := &sfxDPClient{
@@ -1424,7 +1426,7 @@ func TestTLSIngestConnection(t *testing.T) {
 func TestDefaultSystemCPUTimeExcludedAndTranslated(t *testing.T) {
 	translator, err := translation.NewMetricTranslator(defaultTranslationRules, 3600, make(chan struct{}))
 	require.NoError(t, err)
-	converter, err := translation.NewMetricsConverter(zap.NewNop(), translator, defaultExcludeMetrics, nil, "_-.", false, true)
+	converter, err := translation.NewMetricsConverter(zap.NewNop(), translator, defaultExcludeMetrics, nil, "_-.", false, true, exphistogram.NewDefaultConfig())
 	require.NoError(t, err)
 
 	md := pmetric.NewMetrics()
@@ -1468,7 +1470,8 @@ func TestTLSAPIConnection(t *testing.T) {
 		cfg.IncludeMetrics,
 		cfg.NonAlphanumericDimensionChars,
 		false,
-		true)
+		true,
+		exphistogram.NewDefaultConfig())
 	require.NoError(t, err)
 
 	metadata := []*metadata.MetadataUpdate{
@@ -1594,7 +1597,7 @@ func BenchmarkExporterConsumeDataWithOTLPHistograms(b *testing.B) {
 	serverURL, err := url.Parse(server.URL)
 	assert.NoError(b, err)
 
-	c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", false, false)
+	c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", false, false, exphistogram.NewDefaultConfig())
 	require.NoError(b, err)
 	require.NotNil(b, c)
 	dpClient := &sfxDPClient{
@@ -1814,7 +1817,7 @@ func TestConsumeMixedMetrics(t *testing.T) {
 			client, err := cfg.ToClient(context.Background(), componenttest.NewNopHost(), exportertest.NewNopSettings().TelemetrySettings)
 			require.NoError(t, err)
 
-			c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", false, false)
+			c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", false, false, exphistogram.NewDefaultConfig())
 			require.NoError(t, err)
 			require.NotNil(t, c)
 			sfxClient := &sfxDPClient{
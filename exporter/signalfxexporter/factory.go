@@ -18,6 +18,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/correlation"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchperresourceattr"
 )
@@ -77,6 +78,7 @@ func createDefaultConfig() component.Config {
 			IdleConnTimeout:     idleConnTimeout,
 			Timeout:             timeout,
 		},
+		ExponentialHistograms: exphistogram.NewDefaultConfig(),
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	metadata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 )
 
@@ -205,6 +206,7 @@ func TestGetDimensionUpdateFromMetadata(t *testing.T) {
 				"-_.",
 				false,
 				true,
+				exphistogram.NewDefaultConfig(),
 			)
 			require.NoError(t, err)
 			assert.Equal(t, tt.want, getDimensionUpdateFromMetadata(tt.args.metadata, *converter))
@@ -14,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/signalfx"
 )
@@ -37,6 +38,7 @@ type MetricsConverter struct {
 	translator           *signalfx.FromTranslator
 	dropHistogramBuckets bool
 	processHistograms    bool
+	expHistograms        exphistogram.Config
 }
 
 // NewMetricsConverter creates a MetricsConverter from the passed in logger and
@@ -49,7 +51,8 @@ func NewMetricsConverter(
 	includes []dpfilters.MetricFilter,
 	nonAlphanumericDimChars string,
 	dropHistogramBuckets bool,
-	processHistograms bool) (*MetricsConverter, error) {
+	processHistograms bool,
+	expHistograms exphistogram.Config) (*MetricsConverter, error) {
 	fs, err := dpfilters.NewFilterSet(excludes, includes)
 	if err != nil {
 		return nil, err
@@ -62,6 +65,7 @@ func NewMetricsConverter(
 		translator:           &signalfx.FromTranslator{},
 		dropHistogramBuckets: dropHistogramBuckets,
 		processHistograms:    processHistograms,
+		expHistograms:        expHistograms,
 	}, nil
 }
 
@@ -73,6 +77,8 @@ func (c *MetricsConverter) Start() {
 
 // MetricsToSignalFxV2 converts the passed in MetricsData to SFx datapoints
 // and if processHistograms is set, histogram metrics are not converted to SFx format.
+// Exponential histograms are first converted to histograms or summaries as configured
+// by expHistograms, and are always converted to SFx format, unless they are not converted.
 // It returns those datapoints and the number of time series that had to be
 // dropped because of errors or warnings.
 func (c *MetricsConverter) MetricsToSignalFxV2(md pmetric.Metrics) []*sfxpb.DataPoint {
@@ -87,7 +93,15 @@ func (c *MetricsConverter) MetricsToSignalFxV2(md pmetric.Metrics) []*sfxpb.Data
 			var initialDps []*sfxpb.DataPoint
 			for k := 0; k < ilm.Metrics().Len(); k++ {
 				currentMetric := ilm.Metrics().At(k)
-				dps := c.translator.FromMetric(currentMetric, extraDimensions, c.dropHistogramBuckets, c.processHistograms)
+				processHistograms := c.processHistograms
+				if currentMetric.Type() == pmetric.MetricTypeExponentialHistogram && c.expHistograms.ConvertTo != exphistogram.ConvertToNone {
+					converted := pmetric.NewMetric()
+					c.expHistograms.ConvertMetric(currentMetric, converted)
+					currentMetric = converted
+					// the exponential histograms aren't sent in OTLP format
+					processHistograms = true
+				}
+				dps := c.translator.FromMetric(currentMetric, extraDimensions, c.dropHistogramBuckets, processHistograms)
 				initialDps = append(initialDps, dps...)
 			}
 
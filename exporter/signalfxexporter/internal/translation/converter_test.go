@@ -5,6 +5,7 @@ package translation
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"testing"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/maps"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 )
 
 const (
@@ -603,7 +605,7 @@ func Test_MetricDataToSignalFxV2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c, err := NewMetricsConverter(logger, nil, tt.excludeMetrics, tt.includeMetrics, "", true, true)
+			c, err := NewMetricsConverter(logger, nil, tt.excludeMetrics, tt.includeMetrics, "", true, true, exphistogram.NewDefaultConfig())
 			require.NoError(t, err)
 			md := tt.metricsFn()
 			gotSfxDataPoints := c.MetricsToSignalFxV2(md)
@@ -832,7 +834,7 @@ func Test_MetricDataToSignalFxV2WithHistogramBuckets(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c, err := NewMetricsConverter(logger, nil, tt.excludeMetrics, tt.includeMetrics, "", false, true)
+			c, err := NewMetricsConverter(logger, nil, tt.excludeMetrics, tt.includeMetrics, "", false, true, exphistogram.NewDefaultConfig())
 			require.NoError(t, err)
 			md := tt.metricsFn()
 			gotSfxDataPoints := c.MetricsToSignalFxV2(md)
@@ -982,7 +984,7 @@ func Test_MetricDataToSignalFxV2WithHistogramBuckets(t *testing.T) {
 
 	for _, tt := range testsWithDropHistogramBuckets {
 		t.Run(tt.name, func(t *testing.T) {
-			c, err := NewMetricsConverter(logger, nil, tt.excludeMetrics, tt.includeMetrics, "", true, true)
+			c, err := NewMetricsConverter(logger, nil, tt.excludeMetrics, tt.includeMetrics, "", true, true, exphistogram.NewDefaultConfig())
 			require.NoError(t, err)
 			md := tt.metricsFn()
 			gotSfxDataPoints := c.MetricsToSignalFxV2(md)
@@ -1085,7 +1087,7 @@ func Test_MetricDataToSignalFxV2WithHistogramBuckets(t *testing.T) {
 
 	for _, tt := range testsWithProcessHistogramsFalse {
 		t.Run(tt.name, func(t *testing.T) {
-			c, err := NewMetricsConverter(logger, nil, tt.excludeMetrics, tt.includeMetrics, "", true, false)
+			c, err := NewMetricsConverter(logger, nil, tt.excludeMetrics, tt.includeMetrics, "", true, false, exphistogram.NewDefaultConfig())
 			require.NoError(t, err)
 			md := tt.metricsFn()
 			gotSfxDataPoints := c.MetricsToSignalFxV2(md)
@@ -1134,11 +1136,77 @@ func TestMetricDataToSignalFxV2WithTranslation(t *testing.T) {
 			},
 		},
 	}
-	c, err := NewMetricsConverter(zap.NewNop(), translator, nil, nil, "", false, true)
+	c, err := NewMetricsConverter(zap.NewNop(), translator, nil, nil, "", false, true, exphistogram.NewDefaultConfig())
 	require.NoError(t, err)
 	assert.EqualValues(t, expected, c.MetricsToSignalFxV2(md))
 }
 
+func TestMetricDataToSignalFxV2WithExponentialHistograms(t *testing.T) {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("exp_histo")
+	eh := m.SetEmptyExponentialHistogram()
+	eh.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := eh.DataPoints().AppendEmpty()
+	dp.SetCount(8)
+	dp.SetSum(24)
+	// the buckets (1, 2], (2, 4] and (4, 8]
+	dp.Positive().BucketCounts().FromRaw([]uint64{2, 4, 2})
+
+	tests := []struct {
+		name     string
+		cfg      exphistogram.Config
+		expected map[string]float64
+	}{
+		{
+			name: "histogram",
+			cfg:  exphistogram.Config{ConvertTo: exphistogram.ConvertToHistogram, ExplicitBounds: []float64{2, 4}},
+			expected: map[string]float64{
+				"exp_histo_count":          8,
+				"exp_histo_sum":            24,
+				"exp_histo_bucket;le=2":    2,
+				"exp_histo_bucket;le=4":    6,
+				"exp_histo_bucket;le=+Inf": 8,
+			},
+		},
+		{
+			name: "summary",
+			cfg:  exphistogram.Config{ConvertTo: exphistogram.ConvertToSummary, Quantiles: []float64{0.5}},
+			expected: map[string]float64{
+				"exp_histo_count":                 8,
+				"exp_histo_sum":                   24,
+				"exp_histo_quantile;quantile=0.5": math.Exp2(1.5),
+			},
+		},
+		{
+			name:     "none",
+			cfg:      exphistogram.Config{ConvertTo: exphistogram.ConvertToNone},
+			expected: map[string]float64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the exponential histograms are converted even if the histograms are sent in OTLP format
+			c, err := NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", false, false, tt.cfg)
+			require.NoError(t, err)
+
+			got := map[string]float64{}
+			for _, sfxDP := range c.MetricsToSignalFxV2(md) {
+				key := sfxDP.Metric
+				for _, dim := range sfxDP.Dimensions {
+					key += ";" + dim.Key + "=" + dim.Value
+				}
+				if sfxDP.Value.IntValue != nil {
+					got[key] = float64(*sfxDP.Value.IntValue)
+				} else {
+					got[key] = *sfxDP.Value.DoubleValue
+				}
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
 func TestDimensionKeyCharsWithPeriod(t *testing.T) {
 	translator, err := NewMetricTranslator([]Rule{
 		{
@@ -1173,7 +1241,7 @@ func TestDimensionKeyCharsWithPeriod(t *testing.T) {
 			},
 		},
 	}
-	c, err := NewMetricsConverter(zap.NewNop(), translator, nil, nil, "_-.", false, true)
+	c, err := NewMetricsConverter(zap.NewNop(), translator, nil, nil, "_-.", false, true, exphistogram.NewDefaultConfig())
 	require.NoError(t, err)
 	assert.EqualValues(t, expected, c.MetricsToSignalFxV2(md))
 }
@@ -1190,7 +1258,7 @@ func TestInvalidNumberOfDimensions(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		dp.Attributes().PutStr(fmt.Sprint("dim_key_", i), fmt.Sprint("dim_val_", i))
 	}
-	c, err := NewMetricsConverter(logger, nil, nil, nil, "_-.", false, true)
+	c, err := NewMetricsConverter(logger, nil, nil, nil, "_-.", false, true, exphistogram.NewDefaultConfig())
 	require.NoError(t, err)
 	assert.Len(t, c.MetricsToSignalFxV2(md), 1)
 	// No log message should be printed
@@ -1296,7 +1364,7 @@ func TestNewMetricsConverter(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewMetricsConverter(zap.NewNop(), nil, tt.excludes, nil, "", false, true)
+			got, err := NewMetricsConverter(zap.NewNop(), nil, tt.excludes, nil, "", false, true, exphistogram.NewDefaultConfig())
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
@@ -1356,7 +1424,7 @@ func TestMetricsConverter_ConvertDimension(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c, err := NewMetricsConverter(zap.NewNop(), tt.fields.metricTranslator, nil, nil, tt.fields.nonAlphanumericDimChars, false, true)
+			c, err := NewMetricsConverter(zap.NewNop(), tt.fields.metricTranslator, nil, nil, tt.fields.nonAlphanumericDimChars, false, true, exphistogram.NewDefaultConfig())
 			require.NoError(t, err)
 			if got := c.ConvertDimension(tt.args.dim); got != tt.want {
 				t.Errorf("ConvertDimension() = %v, want %v", got, tt.want)
@@ -17,6 +17,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 )
 
 type byContent []*sfxpb.DataPoint
@@ -2960,7 +2962,7 @@ func testConverter(t *testing.T, mapping map[string]string) *MetricsConverter {
 	tr, err := NewMetricTranslator(rules, 1, make(chan struct{}))
 	require.NoError(t, err)
 
-	c, err := NewMetricsConverter(zap.NewNop(), tr, nil, nil, "", false, true)
+	c, err := NewMetricsConverter(zap.NewNop(), tr, nil, nil, "", false, true, exphistogram.NewDefaultConfig())
 	require.NoError(t, err)
 	return c
 }
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exphistogram // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// Buckets are the buckets of one half of an Aggregation.
+type Buckets interface {
+	Offset() int32
+	Len() uint32
+	At(i uint32) uint64
+}
+
+// Aggregation is an exponential histogram recorded in memory, like the
+// `lightstep/go-expohisto` structure.Histogram[float64].
+type Aggregation[B Buckets] interface {
+	Count() uint64
+	Sum() float64
+	Min() float64
+	Max() float64
+	ZeroCount() uint64
+	Scale() int32
+	Positive() B
+	Negative() B
+}
+
+// CopyAggregationTo copies the aggregation to the data point dest. The min and max are only set if the
+// aggregation isn't empty.
+func CopyAggregationTo[B Buckets](agg Aggregation[B], dest pmetric.ExponentialHistogramDataPoint) {
+	dest.SetCount(agg.Count())
+	dest.SetSum(agg.Sum())
+	if agg.Count() != 0 {
+		dest.SetMin(agg.Min())
+		dest.SetMax(agg.Max())
+	}
+
+	dest.SetZeroCount(agg.ZeroCount())
+	dest.SetScale(agg.Scale())
+
+	copyBuckets(agg.Positive(), dest.Positive())
+	copyBuckets(agg.Negative(), dest.Negative())
+}
+
+func copyBuckets(in Buckets, out pmetric.ExponentialHistogramDataPointBuckets) {
+	out.SetOffset(in.Offset())
+	out.BucketCounts().EnsureCapacity(int(in.Len()))
+	for i := uint32(0); i < in.Len(); i++ {
+		out.BucketCounts().Append(in.At(i))
+	}
+}
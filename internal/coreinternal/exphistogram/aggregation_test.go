@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exphistogram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+type testBuckets struct {
+	offset int32
+	counts []uint64
+}
+
+func (b *testBuckets) Offset() int32      { return b.offset }
+func (b *testBuckets) Len() uint32        { return uint32(len(b.counts)) }
+func (b *testBuckets) At(i uint32) uint64 { return b.counts[i] }
+
+type testAggregation struct {
+	count, zeroCount   uint64
+	sum, min, max      float64
+	scale              int32
+	positive, negative *testBuckets
+}
+
+func (a *testAggregation) Count() uint64          { return a.count }
+func (a *testAggregation) Sum() float64           { return a.sum }
+func (a *testAggregation) Min() float64           { return a.min }
+func (a *testAggregation) Max() float64           { return a.max }
+func (a *testAggregation) ZeroCount() uint64      { return a.zeroCount }
+func (a *testAggregation) Scale() int32           { return a.scale }
+func (a *testAggregation) Positive() *testBuckets { return a.positive }
+func (a *testAggregation) Negative() *testBuckets { return a.negative }
+
+func TestCopyAggregationTo(t *testing.T) {
+	agg := &testAggregation{
+		count:     5,
+		zeroCount: 1,
+		sum:       4.5,
+		min:       -1.5,
+		max:       3,
+		scale:     1,
+		positive:  &testBuckets{offset: 1, counts: []uint64{1, 0, 2}},
+		negative:  &testBuckets{offset: 0, counts: []uint64{1}},
+	}
+	dp := pmetric.NewExponentialHistogramDataPoint()
+	CopyAggregationTo[*testBuckets](agg, dp)
+
+	want := pmetric.NewExponentialHistogramDataPoint()
+	want.SetCount(5)
+	want.SetZeroCount(1)
+	want.SetSum(4.5)
+	want.SetMin(-1.5)
+	want.SetMax(3)
+	want.SetScale(1)
+	want.Positive().SetOffset(1)
+	want.Positive().BucketCounts().FromRaw([]uint64{1, 0, 2})
+	want.Negative().BucketCounts().FromRaw([]uint64{1})
+	assert.Equal(t, want, dp)
+}
+
+func TestCopyAggregationToEmpty(t *testing.T) {
+	agg := &testAggregation{min: 1, max: -1, positive: &testBuckets{}, negative: &testBuckets{}}
+	dp := pmetric.NewExponentialHistogramDataPoint()
+	CopyAggregationTo[*testBuckets](agg, dp)
+
+	assert.Equal(t, uint64(0), dp.Count())
+	assert.False(t, dp.HasMin())
+	assert.False(t, dp.HasMax())
+	assert.Equal(t, 0, dp.Positive().BucketCounts().Len())
+}
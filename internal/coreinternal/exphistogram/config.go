@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exphistogram // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ConversionType is the type of the metrics the exponential histograms are converted to.
+type ConversionType string
+
+const (
+	// ConvertToHistogram converts the exponential histograms to histograms with explicit bounds.
+	ConvertToHistogram ConversionType = "histogram"
+	// ConvertToSummary converts the exponential histograms to summaries with quantiles.
+	ConvertToSummary ConversionType = "summary"
+	// ConvertToNone doesn't convert the exponential histograms, so they are dropped by the backends that don't support them.
+	ConvertToNone ConversionType = "none"
+)
+
+var (
+	// defaultExplicitBounds are the default bounds of the explicit bucket histograms of the OpenTelemetry SDKs.
+	defaultExplicitBounds = []float64{0, 5, 10, 25, 50, 75, 100, 250, 500, 750, 1000, 2500, 5000, 7500, 10000}
+	defaultQuantiles      = []float64{0.5, 0.9, 0.95, 0.99}
+)
+
+// Config defines how the exponential histograms are converted for the backends that don't support them.
+type Config struct {
+	// ConvertTo is the type of the metrics the exponential histograms are converted to, either "histogram" or "summary",
+	// or "none" to not convert them.
+	ConvertTo ConversionType `mapstructure:"convert_to"`
+	// ExplicitBounds are the bucket bounds of the histograms, used when converting to histograms.
+	ExplicitBounds []float64 `mapstructure:"explicit_bounds"`
+	// Quantiles are the quantiles of the summaries, used when converting to summaries.
+	Quantiles []float64 `mapstructure:"quantiles"`
+}
+
+// NewDefaultConfig returns the default config, converting the exponential histograms to histograms with the
+// default bounds of the OpenTelemetry SDKs.
+func NewDefaultConfig() Config {
+	return Config{
+		ConvertTo:      ConvertToHistogram,
+		ExplicitBounds: append([]float64(nil), defaultExplicitBounds...),
+		Quantiles:      append([]float64(nil), defaultQuantiles...),
+	}
+}
+
+// Validate checks if the config is valid.
+func (c Config) Validate() error {
+	switch c.ConvertTo {
+	case ConvertToHistogram:
+		for i := 1; i < len(c.ExplicitBounds); i++ {
+			if c.ExplicitBounds[i] <= c.ExplicitBounds[i-1] {
+				return errors.New("explicit_bounds must be strictly increasing")
+			}
+		}
+	case ConvertToSummary:
+		if len(c.Quantiles) == 0 {
+			return errors.New("quantiles must not be empty when converting to summaries")
+		}
+		for _, q := range c.Quantiles {
+			if q < 0 || q > 1 {
+				return fmt.Errorf("quantile %v must be between 0 and 1", q)
+			}
+		}
+	case ConvertToNone:
+	default:
+		return fmt.Errorf("convert_to must be %q, %q or %q, got %q", ConvertToHistogram, ConvertToSummary, ConvertToNone, c.ConvertTo)
+	}
+	return nil
+}
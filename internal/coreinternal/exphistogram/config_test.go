@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exphistogram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{
+			name: "default",
+			cfg:  NewDefaultConfig(),
+		},
+		{
+			name: "summary",
+			cfg:  Config{ConvertTo: ConvertToSummary, Quantiles: []float64{0, 0.5, 1}},
+		},
+		{
+			name: "none",
+			cfg:  Config{ConvertTo: ConvertToNone},
+		},
+		{
+			name:    "invalid conversion type",
+			cfg:     Config{ConvertTo: "gauge"},
+			wantErr: `convert_to must be "histogram", "summary" or "none", got "gauge"`,
+		},
+		{
+			name:    "bounds not increasing",
+			cfg:     Config{ConvertTo: ConvertToHistogram, ExplicitBounds: []float64{1, 2, 2}},
+			wantErr: "explicit_bounds must be strictly increasing",
+		},
+		{
+			name:    "no quantiles",
+			cfg:     Config{ConvertTo: ConvertToSummary},
+			wantErr: "quantiles must not be empty when converting to summaries",
+		},
+		{
+			name:    "quantile out of range",
+			cfg:     Config{ConvertTo: ConvertToSummary, Quantiles: []float64{0.5, 1.5}},
+			wantErr: "quantile 1.5 must be between 0 and 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exphistogram converts the exponential histograms to histograms with explicit bounds or to summaries,
+// for the exporters whose backends don't support exponential histograms.
+package exphistogram // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
+
+import (
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// ConvertMetrics replaces in place the exponential histograms of the metrics with the metrics they are converted to.
+// It does nothing if the conversion type is ConvertToNone.
+func (c Config) ConvertMetrics(md pmetric.Metrics) {
+	if c.ConvertTo == ConvertToNone {
+		return
+	}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				if m.Type() != pmetric.MetricTypeExponentialHistogram {
+					continue
+				}
+				converted := pmetric.NewMetric()
+				c.ConvertMetric(m, converted)
+				converted.MoveTo(m)
+			}
+		}
+	}
+}
+
+// ConvertMetric converts the exponential histogram metric to the histogram or summary metric dest.
+// It does nothing if the metric isn't an exponential histogram or if the conversion type is ConvertToNone.
+func (c Config) ConvertMetric(src pmetric.Metric, dest pmetric.Metric) {
+	if src.Type() != pmetric.MetricTypeExponentialHistogram || c.ConvertTo == ConvertToNone {
+		return
+	}
+	dest.SetName(src.Name())
+	dest.SetDescription(src.Description())
+	dest.SetUnit(src.Unit())
+	src.Metadata().CopyTo(dest.Metadata())
+
+	dps := src.ExponentialHistogram().DataPoints()
+	if c.ConvertTo == ConvertToSummary {
+		summary := dest.SetEmptySummary()
+		summary.DataPoints().EnsureCapacity(dps.Len())
+		for i := 0; i < dps.Len(); i++ {
+			ToSummaryDataPoint(dps.At(i), c.Quantiles, summary.DataPoints().AppendEmpty())
+		}
+		return
+	}
+	histogram := dest.SetEmptyHistogram()
+	histogram.SetAggregationTemporality(src.ExponentialHistogram().AggregationTemporality())
+	histogram.DataPoints().EnsureCapacity(dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		ToHistogramDataPoint(dps.At(i), c.ExplicitBounds, histogram.DataPoints().AppendEmpty())
+	}
+}
+
+// ToHistogramDataPoint converts the exponential histogram data point to the histogram data point dest with the
+// explicit bounds. The counts of the exponential buckets that straddle a bound are split between the explicit
+// buckets assuming the values are spread evenly on a logarithmic scale inside of the exponential buckets.
+func ToHistogramDataPoint(src pmetric.ExponentialHistogramDataPoint, bounds []float64, dest pmetric.HistogramDataPoint) {
+	src.Attributes().CopyTo(dest.Attributes())
+	dest.SetStartTimestamp(src.StartTimestamp())
+	dest.SetTimestamp(src.Timestamp())
+	dest.SetFlags(src.Flags())
+	src.Exemplars().CopyTo(dest.Exemplars())
+	dest.SetCount(src.Count())
+	if src.HasSum() {
+		dest.SetSum(src.Sum())
+	}
+	if src.HasMin() {
+		dest.SetMin(src.Min())
+	}
+	if src.HasMax() {
+		dest.SetMax(src.Max())
+	}
+
+	dest.ExplicitBounds().FromRaw(bounds)
+	counts := make([]uint64, len(bounds)+1)
+	prev := uint64(0)
+	for i, bound := range bounds {
+		// the estimates are rounded after being accumulated so that the counts add up to the total count
+		cum := min(uint64(math.Round(cumulativeCount(src, bound))), src.Count())
+		counts[i] = cum - prev
+		prev = cum
+	}
+	counts[len(bounds)] = src.Count() - prev
+	dest.BucketCounts().FromRaw(counts)
+}
+
+// ToSummaryDataPoint converts the exponential histogram data point to the summary data point dest with the
+// quantiles. The quantiles are interpolated assuming the values are spread evenly on a logarithmic scale inside
+// of the exponential buckets, and the summary has no quantile if the data point is empty.
+func ToSummaryDataPoint(src pmetric.ExponentialHistogramDataPoint, quantiles []float64, dest pmetric.SummaryDataPoint) {
+	src.Attributes().CopyTo(dest.Attributes())
+	dest.SetStartTimestamp(src.StartTimestamp())
+	dest.SetTimestamp(src.Timestamp())
+	dest.SetFlags(src.Flags())
+	dest.SetCount(src.Count())
+	dest.SetSum(src.Sum())
+	if src.Count() == 0 {
+		return
+	}
+
+	dest.QuantileValues().EnsureCapacity(len(quantiles))
+	for _, q := range quantiles {
+		qv := dest.QuantileValues().AppendEmpty()
+		qv.SetQuantile(q)
+		qv.SetValue(quantile(src, q))
+	}
+}
+
+// cumulativeCount estimates the count of the values of the data point less than or equal to x.
+func cumulativeCount(dp pmetric.ExponentialHistogramDataPoint, x float64) float64 {
+	if dp.HasMin() && x < dp.Min() {
+		return 0
+	}
+	if dp.HasMax() && x >= dp.Max() {
+		return float64(dp.Count())
+	}
+
+	cum := 0.0
+	scale := dp.Scale()
+	// the negative bucket of index i is [-base^(i+1), -base^i)
+	neg := dp.Negative()
+	for i := 0; i < neg.BucketCounts().Len(); i++ {
+		index := float64(neg.Offset() + int32(i))
+		switch {
+		case x >= -Bound(int32(index), scale):
+			cum += float64(neg.BucketCounts().At(i))
+		case x >= -Bound(int32(index)+1, scale):
+			cum += float64(neg.BucketCounts().At(i)) * (index + 1 - logBase(-x, scale))
+		}
+	}
+	// the values of the zero bucket are counted as zeros
+	if x >= 0 {
+		cum += float64(dp.ZeroCount())
+	}
+	// the positive bucket of index i is (base^i, base^(i+1)]
+	pos := dp.Positive()
+	for i := 0; i < pos.BucketCounts().Len(); i++ {
+		index := float64(pos.Offset() + int32(i))
+		switch {
+		case x >= Bound(int32(index)+1, scale):
+			cum += float64(pos.BucketCounts().At(i))
+		case x > Bound(int32(index), scale):
+			cum += float64(pos.BucketCounts().At(i)) * (logBase(x, scale) - index)
+		}
+	}
+	return cum
+}
+
+// quantile estimates the value of the quantile q of the data point, which must not be empty.
+func quantile(dp pmetric.ExponentialHistogramDataPoint, q float64) float64 {
+	value := quantileFromBuckets(dp, q*float64(dp.Count()))
+	if dp.HasMin() {
+		value = max(value, dp.Min())
+	}
+	if dp.HasMax() {
+		value = min(value, dp.Max())
+	}
+	return value
+}
+
+// quantileFromBuckets returns the value of the given rank, walking the buckets from the lowest values.
+func quantileFromBuckets(dp pmetric.ExponentialHistogramDataPoint, rank float64) float64 {
+	scale := dp.Scale()
+	cum := 0.0
+	neg := dp.Negative()
+	for i := neg.BucketCounts().Len() - 1; i >= 0; i-- {
+		count := float64(neg.BucketCounts().At(i))
+		if count > 0 && cum+count >= rank {
+			index := float64(neg.Offset() + int32(i))
+			return -math.Exp2((index + 1 - (rank-cum)/count) * math.Exp2(-float64(scale)))
+		}
+		cum += count
+	}
+	if count := float64(dp.ZeroCount()); count > 0 && cum+count >= rank {
+		return 0
+	}
+	cum += float64(dp.ZeroCount())
+	pos := dp.Positive()
+	for i := 0; i < pos.BucketCounts().Len(); i++ {
+		count := float64(pos.BucketCounts().At(i))
+		if count > 0 && cum+count >= rank {
+			index := float64(pos.Offset() + int32(i))
+			return math.Exp2((index + (rank-cum)/count) * math.Exp2(-float64(scale)))
+		}
+		cum += count
+	}
+	// the rank is only past the buckets when their counts don't add up to the total count
+	if pos.BucketCounts().Len() > 0 {
+		return Bound(pos.Offset()+int32(pos.BucketCounts().Len()), scale)
+	}
+	return 0
+}
+
+// logBase returns the logarithm of x in the base 2^(2^-scale) of the exponential buckets of the scale.
+func logBase(x float64, scale int32) float64 {
+	return math.Log2(x) * math.Exp2(float64(scale))
+}
+
+// Bound returns the bound base^index of the exponential buckets of the scale, with base = 2^(2^-scale).
+func Bound(index int32, scale int32) float64 {
+	return math.Exp2(float64(index) * math.Exp2(-float64(scale)))
+}
+
+// Downscale merges the exponential buckets into the buckets of the scale reduced by the given amount,
+// and returns their offset and counts.
+func Downscale(offset int32, counts []uint64, by int32) (int32, []uint64) {
+	if by == 0 || len(counts) == 0 {
+		return offset, counts
+	}
+	newOffset := offset >> by
+	merged := make([]uint64, ((offset+int32(len(counts))-1)>>by)-newOffset+1)
+	for i, count := range counts {
+		merged[((offset+int32(i))>>by)-newOffset] += count
+	}
+	return newOffset, merged
+}
@@ -0,0 +1,231 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exphistogram
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// newDataPoint returns a data point with the values -1.5, 0, 1.5, 1.5, 3, 3, 3, 3, 6, 6 in the buckets of scale 0.
+func newDataPoint(dp pmetric.ExponentialHistogramDataPoint) {
+	dp.Attributes().PutStr("foo", "bar")
+	dp.SetStartTimestamp(pcommon.Timestamp(1))
+	dp.SetTimestamp(pcommon.Timestamp(2))
+	dp.SetCount(10)
+	dp.SetSum(28.5)
+	dp.SetScale(0)
+	dp.SetZeroCount(1)
+	dp.Negative().BucketCounts().FromRaw([]uint64{1})
+	dp.Positive().BucketCounts().FromRaw([]uint64{2, 4, 2})
+}
+
+func TestToHistogramDataPoint(t *testing.T) {
+	src := pmetric.NewExponentialHistogramDataPoint()
+	newDataPoint(src)
+	src.SetMin(-1.5)
+	src.SetMax(6)
+
+	dest := pmetric.NewHistogramDataPoint()
+	ToHistogramDataPoint(src, []float64{-1, 0, 2, 3, 10}, dest)
+
+	assert.Equal(t, map[string]any{"foo": "bar"}, dest.Attributes().AsRaw())
+	assert.Equal(t, pcommon.Timestamp(1), dest.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(2), dest.Timestamp())
+	assert.Equal(t, uint64(10), dest.Count())
+	assert.Equal(t, 28.5, dest.Sum())
+	assert.Equal(t, -1.5, dest.Min())
+	assert.Equal(t, 6.0, dest.Max())
+	assert.Equal(t, []float64{-1, 0, 2, 3, 10}, dest.ExplicitBounds().AsRaw())
+	// the 4 values of (2, 4] are split at 3 as 4*log2(3/2) ≈ 2.34 and the rest
+	assert.Equal(t, []uint64{1, 1, 2, 2, 4, 0}, dest.BucketCounts().AsRaw())
+}
+
+func TestToHistogramDataPointWithoutBounds(t *testing.T) {
+	src := pmetric.NewExponentialHistogramDataPoint()
+	newDataPoint(src)
+
+	dest := pmetric.NewHistogramDataPoint()
+	ToHistogramDataPoint(src, nil, dest)
+
+	assert.Empty(t, dest.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{10}, dest.BucketCounts().AsRaw())
+	assert.False(t, dest.HasMin())
+	assert.False(t, dest.HasMax())
+}
+
+func TestToSummaryDataPoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		minMax   bool
+		expected map[float64]float64
+	}{
+		{
+			name: "bucket bounds",
+			expected: map[float64]float64{
+				0:    -2,
+				0.15: 0,
+				0.5:  math.Exp2(1.25),
+				1:    8,
+			},
+		},
+		{
+			name:   "clamped to min and max",
+			minMax: true,
+			expected: map[float64]float64{
+				0:    -1.5,
+				0.15: 0,
+				0.5:  math.Exp2(1.25),
+				1:    6,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := pmetric.NewExponentialHistogramDataPoint()
+			newDataPoint(src)
+			if tt.minMax {
+				src.SetMin(-1.5)
+				src.SetMax(6)
+			}
+
+			dest := pmetric.NewSummaryDataPoint()
+			ToSummaryDataPoint(src, []float64{0, 0.15, 0.5, 1}, dest)
+
+			assert.Equal(t, map[string]any{"foo": "bar"}, dest.Attributes().AsRaw())
+			assert.Equal(t, pcommon.Timestamp(1), dest.StartTimestamp())
+			assert.Equal(t, pcommon.Timestamp(2), dest.Timestamp())
+			assert.Equal(t, uint64(10), dest.Count())
+			assert.Equal(t, 28.5, dest.Sum())
+			require.Equal(t, len(tt.expected), dest.QuantileValues().Len())
+			for i := 0; i < dest.QuantileValues().Len(); i++ {
+				qv := dest.QuantileValues().At(i)
+				assert.InDelta(t, tt.expected[qv.Quantile()], qv.Value(), 1e-9, "quantile %v", qv.Quantile())
+			}
+		})
+	}
+}
+
+func TestToSummaryDataPointEmpty(t *testing.T) {
+	dest := pmetric.NewSummaryDataPoint()
+	ToSummaryDataPoint(pmetric.NewExponentialHistogramDataPoint(), []float64{0.5}, dest)
+
+	assert.Equal(t, uint64(0), dest.Count())
+	assert.Equal(t, 0, dest.QuantileValues().Len())
+}
+
+func TestConvertMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty()
+	gauge.SetName("gauge")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	for _, name := range []string{"exp1", "exp2"} {
+		m := ms.AppendEmpty()
+		m.SetName(name)
+		m.SetDescription("description")
+		m.SetUnit("s")
+		eh := m.SetEmptyExponentialHistogram()
+		eh.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		newDataPoint(eh.DataPoints().AppendEmpty())
+	}
+
+	t.Run("histogram", func(t *testing.T) {
+		converted := pmetric.NewMetrics()
+		md.CopyTo(converted)
+		Config{ConvertTo: ConvertToHistogram, ExplicitBounds: []float64{0, 5}}.ConvertMetrics(converted)
+
+		ms := converted.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 3, ms.Len())
+		assert.Equal(t, pmetric.MetricTypeGauge, ms.At(0).Type())
+		for i, name := range []string{"exp1", "exp2"} {
+			m := ms.At(i + 1)
+			assert.Equal(t, name, m.Name())
+			assert.Equal(t, "description", m.Description())
+			assert.Equal(t, "s", m.Unit())
+			require.Equal(t, pmetric.MetricTypeHistogram, m.Type())
+			assert.Equal(t, pmetric.AggregationTemporalityDelta, m.Histogram().AggregationTemporality())
+			require.Equal(t, 1, m.Histogram().DataPoints().Len())
+			assert.Equal(t, []uint64{2, 7, 1}, m.Histogram().DataPoints().At(0).BucketCounts().AsRaw())
+		}
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		converted := pmetric.NewMetrics()
+		md.CopyTo(converted)
+		Config{ConvertTo: ConvertToSummary, Quantiles: []float64{0.5}}.ConvertMetrics(converted)
+
+		ms := converted.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 3, ms.Len())
+		for i := 1; i < ms.Len(); i++ {
+			require.Equal(t, pmetric.MetricTypeSummary, ms.At(i).Type())
+			require.Equal(t, 1, ms.At(i).Summary().DataPoints().Len())
+			assert.Equal(t, 1, ms.At(i).Summary().DataPoints().At(0).QuantileValues().Len())
+		}
+	})
+
+	t.Run("none", func(t *testing.T) {
+		converted := pmetric.NewMetrics()
+		md.CopyTo(converted)
+		Config{ConvertTo: ConvertToNone}.ConvertMetrics(converted)
+		assert.Equal(t, md, converted)
+
+		dest := pmetric.NewMetric()
+		Config{ConvertTo: ConvertToNone}.ConvertMetric(ms.At(1), dest)
+		assert.Equal(t, pmetric.MetricTypeEmpty, dest.Type())
+	})
+}
+
+func TestDownscale(t *testing.T) {
+	tests := []struct {
+		name           string
+		offset         int32
+		counts         []uint64
+		by             int32
+		expectedOffset int32
+		expectedCounts []uint64
+	}{
+		{
+			name:           "no change",
+			offset:         3,
+			counts:         []uint64{1, 2},
+			expectedOffset: 3,
+			expectedCounts: []uint64{1, 2},
+		},
+		{
+			name:           "positive offset",
+			offset:         3,
+			counts:         []uint64{1, 2, 3, 4},
+			by:             1,
+			expectedOffset: 1,
+			expectedCounts: []uint64{1, 5, 4},
+		},
+		{
+			name:           "negative offset",
+			offset:         -3,
+			counts:         []uint64{1, 2, 3, 4},
+			by:             2,
+			expectedOffset: -1,
+			expectedCounts: []uint64{6, 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset, counts := Downscale(tt.offset, tt.counts, tt.by)
+			assert.Equal(t, tt.expectedOffset, offset)
+			assert.Equal(t, tt.expectedCounts, counts)
+		})
+	}
+}
+
+func TestBound(t *testing.T) {
+	assert.Equal(t, 8.0, Bound(3, 0))
+	assert.Equal(t, 2.0, Bound(2, 1))
+	assert.Equal(t, 0.0625, Bound(-1, -2))
+}
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exphistogram // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
+
+import (
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// minScale is the lowest scale of the exponential histograms, at which all the float64 values fit in 3 buckets.
+const minScale = -10
+
+// DefaultMaxSize is the maximum number of buckets of each of the positive and negative ranges of a merged data
+// point when no maximum is given, which is the default of the OpenTelemetry SDKs.
+const DefaultMaxSize = 160
+
+// Merge adds the data point src to dest. Both are brought to the lowest of their scales, further downscaled
+// until each of the merged positive and negative ranges has at most maxSize buckets, or DefaultMaxSize if
+// maxSize is 0, and the buckets below the largest of their zero thresholds are moved to the zero bucket. The
+// timestamps of dest then span both data points, and the exemplars of src are appended to the ones of dest.
+func Merge(dest, src pmetric.ExponentialHistogramDataPoint, maxSize int) {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	scale := min(dest.Scale(), src.Scale())
+	// the merged ranges are computed from the offsets and lengths, so that the buckets are only allocated once
+	// they fit
+	for scale > minScale && (mergedLen(dest.Positive(), dest.Scale()-scale, src.Positive(), src.Scale()-scale) > maxSize ||
+		mergedLen(dest.Negative(), dest.Scale()-scale, src.Negative(), src.Scale()-scale) > maxSize) {
+		scale--
+	}
+	zeroThreshold := max(dest.ZeroThreshold(), src.ZeroThreshold())
+	zeroCount := dest.ZeroCount() + src.ZeroCount()
+
+	mergeSide := func(destBuckets, srcBuckets pmetric.ExponentialHistogramDataPointBuckets) {
+		destOffset, destCounts := Downscale(destBuckets.Offset(), destBuckets.BucketCounts().AsRaw(), dest.Scale()-scale)
+		srcOffset, srcCounts := Downscale(srcBuckets.Offset(), srcBuckets.BucketCounts().AsRaw(), src.Scale()-scale)
+		offset, counts := mergeBuckets(destOffset, destCounts, srcOffset, srcCounts)
+		// the buckets whose values are all within the zero threshold belong to the zero bucket
+		for len(counts) > 0 && Bound(offset+1, scale) <= zeroThreshold {
+			zeroCount += counts[0]
+			counts = counts[1:]
+			offset++
+		}
+		destBuckets.SetOffset(offset)
+		destBuckets.BucketCounts().FromRaw(counts)
+	}
+	mergeSide(dest.Positive(), src.Positive())
+	mergeSide(dest.Negative(), src.Negative())
+
+	dest.SetScale(scale)
+	dest.SetZeroThreshold(zeroThreshold)
+	dest.SetZeroCount(zeroCount)
+	dest.SetCount(dest.Count() + src.Count())
+	if dest.HasSum() && src.HasSum() {
+		dest.SetSum(dest.Sum() + src.Sum())
+	} else {
+		dest.RemoveSum()
+	}
+	if dest.HasMin() && src.HasMin() {
+		dest.SetMin(math.Min(dest.Min(), src.Min()))
+	} else {
+		dest.RemoveMin()
+	}
+	if dest.HasMax() && src.HasMax() {
+		dest.SetMax(math.Max(dest.Max(), src.Max()))
+	} else {
+		dest.RemoveMax()
+	}
+	if src.StartTimestamp() != 0 && (dest.StartTimestamp() == 0 || src.StartTimestamp() < dest.StartTimestamp()) {
+		dest.SetStartTimestamp(src.StartTimestamp())
+	}
+	if src.Timestamp() > dest.Timestamp() {
+		dest.SetTimestamp(src.Timestamp())
+	}
+	for i := 0; i < src.Exemplars().Len(); i++ {
+		src.Exemplars().At(i).CopyTo(dest.Exemplars().AppendEmpty())
+	}
+}
+
+// Limit downscales the data point until its scale is at most maxScale and each of its positive and negative
+// ranges has at most maxSize buckets. A maxSize of 0 doesn't limit the number of buckets, and the ranges
+// spanning the buckets of indices -1 and 0 can't have less than 2 buckets.
+func Limit(dp pmetric.ExponentialHistogramDataPoint, maxScale int32, maxSize int) {
+	by := max(0, dp.Scale()-maxScale)
+	for maxSize > 0 && by < 32 && (downscaledLen(dp.Positive(), by) > maxSize || downscaledLen(dp.Negative(), by) > maxSize) {
+		by++
+	}
+	if by == 0 {
+		return
+	}
+
+	for _, buckets := range []pmetric.ExponentialHistogramDataPointBuckets{dp.Positive(), dp.Negative()} {
+		offset, counts := Downscale(buckets.Offset(), buckets.BucketCounts().AsRaw(), by)
+		buckets.SetOffset(offset)
+		buckets.BucketCounts().FromRaw(counts)
+	}
+	dp.SetScale(dp.Scale() - by)
+}
+
+// mergedLen returns the number of buckets of a and b once downscaled by the given amounts and merged.
+func mergedLen(a pmetric.ExponentialHistogramDataPointBuckets, aBy int32, b pmetric.ExponentialHistogramDataPointBuckets, bBy int32) int {
+	aLen, bLen := downscaledLen(a, aBy), downscaledLen(b, bBy)
+	switch {
+	case aLen == 0:
+		return bLen
+	case bLen == 0:
+		return aLen
+	}
+	aOffset, bOffset := a.Offset()>>aBy, b.Offset()>>bBy
+	return int(max(aOffset+int32(aLen), bOffset+int32(bLen)) - min(aOffset, bOffset))
+}
+
+// downscaledLen returns the number of buckets once downscaled by the given amount.
+func downscaledLen(buckets pmetric.ExponentialHistogramDataPointBuckets, by int32) int {
+	n := int32(buckets.BucketCounts().Len())
+	if n == 0 {
+		return 0
+	}
+	return int(((buckets.Offset()+n-1)>>by)-(buckets.Offset()>>by)) + 1
+}
+
+// mergeBuckets adds the bucket counts of the same scale, and returns their offset and counts.
+func mergeBuckets(aOffset int32, a []uint64, bOffset int32, b []uint64) (int32, []uint64) {
+	switch {
+	case len(a) == 0:
+		return bOffset, append([]uint64(nil), b...)
+	case len(b) == 0:
+		return aOffset, append([]uint64(nil), a...)
+	}
+	offset := min(aOffset, bOffset)
+	merged := make([]uint64, max(aOffset+int32(len(a)), bOffset+int32(len(b)))-offset)
+	for i, count := range a {
+		merged[aOffset-offset+int32(i)] += count
+	}
+	for i, count := range b {
+		merged[bOffset-offset+int32(i)] += count
+	}
+	return offset, merged
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exphistogram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestMerge(t *testing.T) {
+	dest := pmetric.NewExponentialHistogramDataPoint()
+	dest.SetStartTimestamp(pcommon.Timestamp(10))
+	dest.SetTimestamp(pcommon.Timestamp(20))
+	dest.SetScale(1)
+	dest.SetCount(6)
+	dest.SetSum(10)
+	dest.SetMin(1.1)
+	dest.SetMax(3)
+	dest.SetZeroCount(1)
+	dest.Positive().SetOffset(1)
+	dest.Positive().BucketCounts().FromRaw([]uint64{1, 2, 1})
+	dest.Negative().SetOffset(0)
+	dest.Negative().BucketCounts().FromRaw([]uint64{1})
+	dest.Exemplars().AppendEmpty().SetDoubleValue(2)
+
+	src := pmetric.NewExponentialHistogramDataPoint()
+	src.SetStartTimestamp(pcommon.Timestamp(5))
+	src.SetTimestamp(pcommon.Timestamp(30))
+	src.SetScale(0)
+	src.SetCount(4)
+	src.SetSum(20)
+	src.SetMin(0.6)
+	src.SetMax(7)
+	src.SetZeroThreshold(1)
+	src.SetZeroCount(1)
+	src.Positive().SetOffset(-1)
+	src.Positive().BucketCounts().FromRaw([]uint64{1, 0, 0, 1})
+	src.Exemplars().AppendEmpty().SetDoubleValue(7)
+
+	Merge(dest, src, 0)
+
+	assert.Equal(t, int32(0), dest.Scale())
+	assert.Equal(t, pcommon.Timestamp(5), dest.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(30), dest.Timestamp())
+	assert.Equal(t, uint64(10), dest.Count())
+	assert.Equal(t, 30.0, dest.Sum())
+	assert.Equal(t, 0.6, dest.Min())
+	assert.Equal(t, 7.0, dest.Max())
+	assert.Equal(t, 1.0, dest.ZeroThreshold())
+	// the bucket (0.5, 1] of src is moved to the zero bucket
+	assert.Equal(t, uint64(3), dest.ZeroCount())
+	// the buckets of dest at scale 1 are merged to (1, 2] and (2, 4]
+	assert.Equal(t, int32(0), dest.Positive().Offset())
+	assert.Equal(t, []uint64{1, 3, 1}, dest.Positive().BucketCounts().AsRaw())
+	assert.Equal(t, int32(0), dest.Negative().Offset())
+	assert.Equal(t, []uint64{1}, dest.Negative().BucketCounts().AsRaw())
+	assert.Equal(t, 2, dest.Exemplars().Len())
+}
+
+func TestMergeWithoutSum(t *testing.T) {
+	dest := pmetric.NewExponentialHistogramDataPoint()
+	dest.SetCount(1)
+	dest.SetSum(1)
+	dest.SetMin(1)
+	dest.Positive().BucketCounts().FromRaw([]uint64{1})
+
+	src := pmetric.NewExponentialHistogramDataPoint()
+	src.SetCount(1)
+	src.Positive().SetOffset(2)
+	src.Positive().BucketCounts().FromRaw([]uint64{1})
+
+	Merge(dest, src, 0)
+
+	assert.Equal(t, uint64(2), dest.Count())
+	assert.False(t, dest.HasSum())
+	assert.False(t, dest.HasMin())
+	assert.False(t, dest.HasMax())
+	assert.Equal(t, []uint64{1, 0, 1}, dest.Positive().BucketCounts().AsRaw())
+}
+
+func TestMergeMaxSize(t *testing.T) {
+	newDataPoint := func(offset int32) pmetric.ExponentialHistogramDataPoint {
+		dp := pmetric.NewExponentialHistogramDataPoint()
+		dp.SetScale(20)
+		dp.SetCount(1)
+		dp.Positive().SetOffset(offset)
+		dp.Positive().BucketCounts().FromRaw([]uint64{1})
+		return dp
+	}
+
+	tests := []struct {
+		name           string
+		maxSize        int
+		expectedScale  int32
+		expectedCounts int
+	}{
+		{name: "default", maxSize: 0, expectedScale: 1, expectedCounts: 81},
+		{name: "max_size", maxSize: 10, expectedScale: -3, expectedCounts: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the values 1 and 2^40 are 40*2^20 buckets apart at scale 20
+			dest := newDataPoint(0)
+			Merge(dest, newDataPoint(40<<20), tt.maxSize)
+
+			assert.Equal(t, tt.expectedScale, dest.Scale())
+			assert.Equal(t, uint64(2), dest.Count())
+			counts := dest.Positive().BucketCounts().AsRaw()
+			assert.Len(t, counts, tt.expectedCounts)
+			assert.Equal(t, uint64(1), counts[0])
+			assert.Equal(t, uint64(1), counts[len(counts)-1])
+		})
+	}
+}
+
+func TestLimit(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxScale       int32
+		maxSize        int
+		expectedScale  int32
+		expectedOffset int32
+		expectedCounts []uint64
+	}{
+		{
+			name:           "within limits",
+			maxScale:       20,
+			maxSize:        160,
+			expectedScale:  3,
+			expectedOffset: 5,
+			expectedCounts: []uint64{1, 2, 3, 4, 5},
+		},
+		{
+			name:           "max scale",
+			maxScale:       2,
+			expectedScale:  2,
+			expectedOffset: 2,
+			expectedCounts: []uint64{1, 5, 9},
+		},
+		{
+			name:           "max size",
+			maxScale:       20,
+			maxSize:        2,
+			expectedScale:  1,
+			expectedOffset: 1,
+			expectedCounts: []uint64{6, 9},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dp := pmetric.NewExponentialHistogramDataPoint()
+			dp.SetScale(3)
+			dp.Positive().SetOffset(5)
+			dp.Positive().BucketCounts().FromRaw([]uint64{1, 2, 3, 4, 5})
+
+			Limit(dp, tt.maxScale, tt.maxSize)
+
+			assert.Equal(t, tt.expectedScale, dp.Scale())
+			assert.Equal(t, tt.expectedOffset, dp.Positive().Offset())
+			assert.Equal(t, tt.expectedCounts, dp.Positive().BucketCounts().AsRaw())
+			assert.Empty(t, dp.Negative().BucketCounts().AsRaw())
+		})
+	}
+}
@@ -92,7 +92,15 @@ func (p *Parser[K]) newComparisonEvaluator(comparison *comparison) (BoolExpr[K],
 		if rightErr != nil {
 			return false, rightErr
 		}
-		return p.compare(a, b, comparison.Op), nil
+		switch comparison.Op {
+		case in:
+			return p.contains(b, a)
+		case notIn:
+			found, err := p.contains(b, a)
+			return !found, err
+		default:
+			return p.compare(a, b, comparison.Op), nil
+		}
 	}}, nil
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
 )
@@ -182,6 +183,69 @@ func Test_newComparisonEvaluator(t *testing.T) {
 	}
 }
 
+func Test_newComparisonEvaluator_in(t *testing.T) {
+	p, _ := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+
+	slice := pcommon.NewSlice()
+	require.NoError(t, slice.FromRaw([]any{"a", int64(1), true}))
+	m := pcommon.NewMap()
+	m.PutStr("key", "value")
+
+	tests := []struct {
+		name      string
+		condition string
+		item      any
+		want      bool
+	}{
+		{name: "string in list literal", condition: `"b" in ["a", "b"]`, want: true},
+		{name: "string not in list literal", condition: `"c" in ["a", "b"]`},
+		{name: "not in list literal", condition: `"c" not in ["a", "b"]`, want: true},
+		{name: "int in list of floats", condition: `2 in [1.0, 2.0]`, want: true},
+		{name: "enum in list", condition: `TEST_ENUM_TWO in [0, 2]`, want: true},
+		{name: "empty list", condition: `"a" in []`},
+		{name: "path in list literal", condition: `name in ["bear", "cat"]`, item: "bear", want: true},
+		{name: "in slice", condition: `1 in name`, item: slice, want: true},
+		{name: "not in slice", condition: `false not in name`, item: slice, want: true},
+		{name: "in string slice", condition: `"b" in name`, item: []string{"a", "b"}, want: true},
+		{name: "in int slice", condition: `3 in name`, item: []int64{1, 2}},
+		{name: "key in map", condition: `"key" in name`, item: m, want: true},
+		{name: "key not in map", condition: `"value" not in name`, item: m, want: true},
+		{name: "key in raw map", condition: `"key" in name`, item: map[string]any{"key": nil}, want: true},
+		{name: "non-string not a key", condition: `1 in name`, item: m},
+		{name: "nothing in nil", condition: `"a" in name`},
+		{name: "negated", condition: `not "a" in ["a"]`},
+		{name: "combined", condition: `"a" in ["a"] and "b" not in ["a"]`, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := p.ParseCondition(tt.condition)
+			require.NoError(t, err)
+			result, err := condition.Eval(context.Background(), tt.item)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func Test_newComparisonEvaluator_in_invalid(t *testing.T) {
+	p, _ := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+
+	condition, err := p.ParseCondition(`"a" in name`)
+	require.NoError(t, err)
+	_, err = condition.Eval(context.Background(), "abc")
+	assert.ErrorContains(t, err, "must be a list or a map")
+}
+
 func Test_newConditionEvaluator_invalid(t *testing.T) {
 	p, _ := NewParser(
 		defaultFunctionsForTests(),
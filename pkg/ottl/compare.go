@@ -5,9 +5,12 @@ package ottl // import "github.com/open-telemetry/opentelemetry-collector-contri
 
 import (
 	"bytes"
+	"fmt"
 	"time"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
 )
 
 // The functions in this file implement a general-purpose comparison of two
@@ -212,3 +215,50 @@ func (p *Parser[K]) compare(a any, b any, op compareOp) bool {
 		}
 	}
 }
+
+// contains reports whether item is one of the items of the collection, which is either a list,
+// whose items are compared to item for equality, or a map, whose keys are compared to item.
+// A nil collection contains nothing.
+func (p *Parser[K]) contains(collection any, item any) (bool, error) {
+	switch c := collection.(type) {
+	case nil:
+		return false, nil
+	case []any:
+		return slices.ContainsFunc(c, func(v any) bool { return p.compare(item, v, eq) }), nil
+	case []string:
+		return containsItem(p, c, item), nil
+	case []int64:
+		return containsItem(p, c, item), nil
+	case []float64:
+		return containsItem(p, c, item), nil
+	case []bool:
+		return containsItem(p, c, item), nil
+	case pcommon.Slice:
+		for i := 0; i < c.Len(); i++ {
+			if p.compare(item, c.At(i).AsRaw(), eq) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case map[string]any:
+		key, ok := item.(string)
+		if !ok {
+			return false, nil
+		}
+		_, found := c[key]
+		return found, nil
+	case pcommon.Map:
+		key, ok := item.(string)
+		if !ok {
+			return false, nil
+		}
+		_, found := c.Get(key)
+		return found, nil
+	default:
+		return false, fmt.Errorf("the right side of an in comparison must be a list or a map, but got %T", collection)
+	}
+}
+
+func containsItem[K any, T any](p *Parser[K], items []T, item any) bool {
+	return slices.ContainsFunc(items, func(v T) bool { return p.compare(item, v, eq) })
+}
@@ -32,6 +32,12 @@ func Test_NewPriorityContextInferrer_Infer(t *testing.T) {
 			},
 			expected: "spanevent",
 		},
+		{
+			name:       "with conditional and membership",
+			priority:   []string{"spanevent", "span", "resource"},
+			statements: []string{`set(resource.foo, If(span.bar in ["a"], 1, spanevent.value))`},
+			expected:   "spanevent",
+		},
 		{
 			name:       "with no context",
 			priority:   []string{"log", "resource"},
@@ -10,13 +10,21 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
@@ -959,6 +967,113 @@ func Test_e2e_converters(t *testing.T) {
 				m.PutInt("bar", 5)
 			},
 		},
+		{
+			statement: `set(attributes["test"], Keys(attributes["foo"]["nested"]))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutEmptySlice("test").AppendEmpty().SetStr("test")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Values(attributes["foo"]["nested"]))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutEmptySlice("test").AppendEmpty().SetStr("pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Index(Split(attributes["flags"], "|"), "B"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 1)
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where ContainsValue(attributes["foo"]["slice"], "val")`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Max([1, 5, 3]))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 5)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Min([2, 1.5]))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutDouble("test", 1.5)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Sum([1, 2, 3]))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 6)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Avg([1, 2]))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutDouble("test", 1.5)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Reverse(Split(attributes["flags"], "|")))`,
+			want: func(tCtx ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetStr("C")
+				s.AppendEmpty().SetStr("B")
+				s.AppendEmpty().SetStr("A")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Unique(["a", "b", "a"]))`,
+			want: func(tCtx ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetStr("a")
+				s.AppendEmpty().SetStr("b")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Filter(Split(attributes["flags"], "|"), "^[AC]$"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetStr("A")
+				s.AppendEmpty().SetStr("C")
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where IsInCIDR(attributes["foo"]["bar"], ["10.0.0.0/8"])`,
+			want:      func(_ ottllog.TransformContext) {},
+		},
+		{
+			statement: `set(attributes["test"], ParseInt("0x1f"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 31)
+			},
+		},
+		{
+			statement: `set(attributes["test"], ParseInt("755", 8))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 493)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Base64Encode(attributes["foo"]["bar"]))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "cGFzcw==")
+			},
+		},
+		{
+			statement: `set(attributes["test"], URLEncode(attributes["http.url"]))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "http%3A%2F%2Flocalhost%2Fhealth")
+			},
+		},
+		{
+			statement: `set(attributes["test"], URLDecode("http%3A%2F%2Flocalhost%2Fhealth"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "http://localhost/health")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -980,6 +1095,98 @@ func Test_e2e_converters(t *testing.T) {
 	}
 }
 
+// Test_e2e_converters_contexts evaluates converters which don't depend on the telemetry in every context.
+func Test_e2e_converters_contexts(t *testing.T) {
+	condition := `IsInCIDR("10.1.2.3", ["10.0.0.0/8", "192.168.0.0/16"]) and not IsInCIDR("8.8.8.8", ["10.0.0.0/8"])` +
+		` and ParseInt("0x1f") == 31 and ParseInt("755", 8) == 493` +
+		` and Base64Encode("pass") == "cGFzcw==" and Base64Encode("pass", "base64-raw") == "cGFzcw"` +
+		` and URLDecode(URLEncode("a b&c=d")) == "a b&c=d"`
+	settings := componenttest.NewNopTelemetrySettings()
+
+	tests := []struct {
+		name string
+		eval func(t *testing.T) bool
+	}{
+		{
+			name: "log",
+			eval: func(t *testing.T) bool {
+				parser, err := ottllog.NewParser(ottlfuncs.StandardConverters[ottllog.TransformContext](), settings)
+				require.NoError(t, err)
+				return evalCondition(t, parser, condition, constructLogTransformContext())
+			},
+		},
+		{
+			name: "span",
+			eval: func(t *testing.T) bool {
+				parser, err := ottlspan.NewParser(ottlfuncs.StandardConverters[ottlspan.TransformContext](), settings)
+				require.NoError(t, err)
+				return evalCondition(t, parser, condition, constructSpanTransformContext())
+			},
+		},
+		{
+			name: "spanevent",
+			eval: func(t *testing.T) bool {
+				parser, err := ottlspanevent.NewParser(ottlfuncs.StandardConverters[ottlspanevent.TransformContext](), settings)
+				require.NoError(t, err)
+				tCtx := ottlspanevent.NewTransformContext(ptrace.NewSpanEvent(), ptrace.NewSpan(), pcommon.NewInstrumentationScope(),
+					pcommon.NewResource(), ptrace.NewScopeSpans(), ptrace.NewResourceSpans())
+				return evalCondition(t, parser, condition, tCtx)
+			},
+		},
+		{
+			name: "metric",
+			eval: func(t *testing.T) bool {
+				parser, err := ottlmetric.NewParser(ottlfuncs.StandardConverters[ottlmetric.TransformContext](), settings)
+				require.NoError(t, err)
+				tCtx := ottlmetric.NewTransformContext(pmetric.NewMetric(), pmetric.NewMetricSlice(), pcommon.NewInstrumentationScope(),
+					pcommon.NewResource(), pmetric.NewScopeMetrics(), pmetric.NewResourceMetrics())
+				return evalCondition(t, parser, condition, tCtx)
+			},
+		},
+		{
+			name: "datapoint",
+			eval: func(t *testing.T) bool {
+				parser, err := ottldatapoint.NewParser(ottlfuncs.StandardConverters[ottldatapoint.TransformContext](), settings)
+				require.NoError(t, err)
+				tCtx := ottldatapoint.NewTransformContext(pmetric.NewNumberDataPoint(), pmetric.NewMetric(), pmetric.NewMetricSlice(),
+					pcommon.NewInstrumentationScope(), pcommon.NewResource(), pmetric.NewScopeMetrics(), pmetric.NewResourceMetrics())
+				return evalCondition(t, parser, condition, tCtx)
+			},
+		},
+		{
+			name: "resource",
+			eval: func(t *testing.T) bool {
+				parser, err := ottlresource.NewParser(ottlfuncs.StandardConverters[ottlresource.TransformContext](), settings)
+				require.NoError(t, err)
+				return evalCondition(t, parser, condition, ottlresource.NewTransformContext(pcommon.NewResource(), plog.NewResourceLogs()))
+			},
+		},
+		{
+			name: "scope",
+			eval: func(t *testing.T) bool {
+				parser, err := ottlscope.NewParser(ottlfuncs.StandardConverters[ottlscope.TransformContext](), settings)
+				require.NoError(t, err)
+				tCtx := ottlscope.NewTransformContext(pcommon.NewInstrumentationScope(), pcommon.NewResource(), plog.NewScopeLogs())
+				return evalCondition(t, parser, condition, tCtx)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.eval(t))
+		})
+	}
+}
+
+func evalCondition[K any](t *testing.T, parser ottl.Parser[K], condition string, tCtx K) bool {
+	c, err := parser.ParseCondition(condition)
+	require.NoError(t, err)
+	result, err := c.Eval(context.Background(), tCtx)
+	require.NoError(t, err)
+	return result
+}
+
 func Test_e2e_ottl_features(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1071,6 +1278,34 @@ func Test_e2e_ottl_features(t *testing.T) {
 				tCtx.GetLogRecord().Attributes().PutStr("my.environment.2", "ost")
 			},
 		},
+		{
+			name:      "conditional expression",
+			statement: `set(attributes["test"], If(attributes["http.method"] == "get", "read", "write"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "read")
+			},
+		},
+		{
+			name:      "in list literal",
+			statement: `set(attributes["test"], "pass") where attributes["http.method"] in ["get", "head"]`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			name:      "in map and slice paths",
+			statement: `set(attributes["test"], "pass") where "bar" in attributes["foo"] and "val" in attributes["foo"]["slice"] and "baz" not in attributes["foo"]`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			name:      "modulo",
+			statement: `set(attributes["test"], Int(attributes["total.string"]) % 10)`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 9)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -755,15 +755,14 @@ func (p *Parser[K]) newGetter(val value) (Getter[K], error) {
 			return &literal[K]{value: *i}, nil
 		}
 		if eL.Path != nil {
-			np, err := p.newPath(eL.Path)
-			if err != nil {
-				return nil, err
-			}
-			return p.parsePath(np)
+			return p.newGetSetter(eL.Path)
 		}
 		if eL.Converter != nil {
 			return p.newGetterFromConverter(*eL.Converter)
 		}
+		if eL.Conditional != nil {
+			return p.newConditionalGetter(eL.Conditional)
+		}
 	}
 
 	if val.List != nil {
@@ -808,6 +807,44 @@ func (p *Parser[K]) newGetterFromConverter(c converter) (Getter[K], error) {
 	}, nil
 }
 
+func (p *Parser[K]) newConditionalGetter(c *conditionalExpr) (Getter[K], error) {
+	condition, err := p.newBoolExpr(c.Condition)
+	if err != nil {
+		return nil, err
+	}
+	trueGetter, err := p.newGetter(*c.True)
+	if err != nil {
+		return nil, err
+	}
+	falseGetter, err := p.newGetter(*c.False)
+	if err != nil {
+		return nil, err
+	}
+	return &conditionalGetter[K]{
+		condition:   condition,
+		trueGetter:  trueGetter,
+		falseGetter: falseGetter,
+	}, nil
+}
+
+// conditionalGetter evaluates the getter selected by the condition.
+type conditionalGetter[K any] struct {
+	condition   BoolExpr[K]
+	trueGetter  Getter[K]
+	falseGetter Getter[K]
+}
+
+func (g *conditionalGetter[K]) Get(ctx context.Context, tCtx K) (any, error) {
+	result, err := g.condition.Eval(ctx, tCtx)
+	if err != nil {
+		return nil, err
+	}
+	if result {
+		return g.trueGetter.Get(ctx, tCtx)
+	}
+	return g.falseGetter.Get(ctx, tCtx)
+}
+
 // TimeGetter is a Getter that must return a time.Time.
 type TimeGetter[K any] interface {
 	// Get retrieves a time.Time value.
@@ -602,6 +602,55 @@ func Test_newGetter(t *testing.T) {
 	})
 }
 
+func Test_conditionalGetter(t *testing.T) {
+	p, _ := NewParser[any](
+		defaultFunctionsForTests(),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+
+	valueParser := newParser[value]()
+	newGetter := func(expression string) (Getter[any], error) {
+		parsed, err := valueParser.ParseString("", expression)
+		if err != nil {
+			return nil, err
+		}
+		return p.newGetter(*parsed)
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		ctx        any
+		want       any
+	}{
+		{name: "true", expression: `If(name == "a", "yes", "no")`, ctx: "a", want: "yes"},
+		{name: "false", expression: `If(name == "a", "yes", "no")`, ctx: "b", want: "no"},
+		{name: "path value", expression: `If(true, name, nil)`, ctx: "a", want: "a"},
+		{name: "nested", expression: `If(name == "a", 1, If(name == "b", 2, 3))`, ctx: "b", want: int64(2)},
+		{name: "membership", expression: `If(name in ["a", "b"], [name], {})`, ctx: "a", want: []any{"a"}},
+		// the value which isn't selected isn't evaluated
+		{name: "lazy", expression: `If(false, 1 / 0, 0)`, want: int64(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getter, err := newGetter(tt.expression)
+			require.NoError(t, err)
+			val, err := getter.Get(context.Background(), tt.ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, val)
+		})
+	}
+
+	t.Run("condition error", func(t *testing.T) {
+		getter, err := newGetter(`If(1 / 0 == 0, 1, 2)`)
+		require.NoError(t, err)
+		_, err = getter.Get(context.Background(), nil)
+		assert.ErrorContains(t, err, "divide by 0")
+	})
+}
+
 func Test_exprGetter_Get_Invalid(t *testing.T) {
 	tests := []struct {
 		name string
@@ -247,6 +247,18 @@ func (k *baseKey[K]) Int(_ context.Context, _ K) (*int64, error) {
 	return k.i, nil
 }
 
+// newGetSetter returns the GetSetter of the variables or of the context for the path.
+func (p *Parser[K]) newGetSetter(path *path) (GetSetter[K], error) {
+	if isVariablesPath(path) {
+		return p.newVariableGetSetter(path)
+	}
+	np, err := p.newPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return p.parsePath(np)
+}
+
 func (p *Parser[K]) parsePath(ip *basePath[K]) (GetSetter[K], error) {
 	g, err := p.pathParser(ip)
 	if err != nil {
@@ -484,15 +496,7 @@ func (p *Parser[K]) buildArg(argVal value, argType reflect.Type) (any, error) {
 		if argVal.Literal == nil || argVal.Literal.Path == nil {
 			return nil, fmt.Errorf("must be a path")
 		}
-		np, err := p.newPath(argVal.Literal.Path)
-		if err != nil {
-			return nil, err
-		}
-		arg, err := p.parsePath(np)
-		if err != nil {
-			return nil, err
-		}
-		return arg, nil
+		return p.newGetSetter(argVal.Literal.Path)
 	case strings.HasPrefix(name, "Getter"):
 		arg, err := p.newGetter(argVal)
 		if err != nil {
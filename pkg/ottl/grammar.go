@@ -136,23 +136,29 @@ const (
 	lte
 	gte
 	gt
+	in
+	notIn
 )
 
 // a fast way to get from a string to a compareOp
 var compareOpTable = map[string]compareOp{
-	"==": eq,
-	"!=": ne,
-	"<":  lt,
-	"<=": lte,
-	">":  gt,
-	">=": gte,
+	"==":     eq,
+	"!=":     ne,
+	"<":      lt,
+	"<=":     lte,
+	">":      gt,
+	">=":     gte,
+	"in":     in,
+	"not in": notIn,
 }
 
 // Capture is how the parser converts an operator string to a compareOp.
 func (c *compareOp) Capture(values []string) error {
-	op, ok := compareOpTable[values[0]]
+	// "not in" is captured as two tokens, and "in" with its preceding whitespace
+	opStr := strings.Join(strings.Fields(strings.Join(values, " ")), " ")
+	op, ok := compareOpTable[opStr]
 	if !ok {
-		return fmt.Errorf("'%s' is not a valid operator", values[0])
+		return fmt.Errorf("'%s' is not a valid operator", opStr)
 	}
 	*c = op
 	return nil
@@ -173,15 +179,20 @@ func (c *compareOp) String() string {
 		return "gte"
 	case gt:
 		return "gt"
+	case in:
+		return "in"
+	case notIn:
+		return "not in"
 	default:
 		return "UNKNOWN OP!"
 	}
 }
 
 // comparison represents an optional boolean condition.
+// The in and not in operators test whether the left value is an item of the list, or a key of the map, on the right.
 type comparison struct {
 	Left  value     `parser:"@@"`
-	Op    compareOp `parser:"@OpComparison"`
+	Op    compareOp `parser:"@( OpComparison | OpNot? OpIn )"`
 	Right value     `parser:"@@"`
 }
 
@@ -332,11 +343,12 @@ func (n *isNil) Capture(_ []string) error {
 
 type mathExprLiteral struct {
 	// If editor is matched then error
-	Editor    *editor    `parser:"( @@"`
-	Converter *converter `parser:"| @@"`
-	Float     *float64   `parser:"| @Float"`
-	Int       *int64     `parser:"| @Int"`
-	Path      *path      `parser:"| @@ )"`
+	Editor      *editor          `parser:"( @@"`
+	Conditional *conditionalExpr `parser:"| @@"`
+	Converter   *converter       `parser:"| @@"`
+	Float       *float64         `parser:"| @Float"`
+	Int         *int64           `parser:"| @Int"`
+	Path        *path            `parser:"| @@ )"`
 }
 
 func (m *mathExprLiteral) accept(v grammarVisitor) {
@@ -347,11 +359,34 @@ func (m *mathExprLiteral) accept(v grammarVisitor) {
 	if m.Editor != nil {
 		m.Editor.accept(v)
 	}
+	if m.Conditional != nil {
+		m.Conditional.accept(v)
+	}
 	if m.Converter != nil {
 		m.Converter.accept(v)
 	}
 }
 
+// conditionalExpr represents an If(condition, trueValue, falseValue) expression, which resolves to one of
+// the values depending on the condition. Only the selected value is evaluated.
+type conditionalExpr struct {
+	Condition *booleanExpression `parser:"If '(' @@ ','"`
+	True      *value             `parser:"@@ ','"`
+	False     *value             `parser:"@@ ')'"`
+}
+
+func (c *conditionalExpr) accept(v grammarVisitor) {
+	if c.Condition != nil {
+		c.Condition.accept(v)
+	}
+	if c.True != nil {
+		c.True.accept(v)
+	}
+	if c.False != nil {
+		c.False.accept(v)
+	}
+}
+
 type mathValue struct {
 	Literal       *mathExprLiteral `parser:"( @@"`
 	SubExpression *mathExpression  `parser:"| '(' @@ ')' )"`
@@ -429,6 +464,7 @@ const (
 	sub
 	mult
 	div
+	mod
 )
 
 var mathOpTable = map[string]mathOp{
@@ -436,6 +472,7 @@ var mathOpTable = map[string]mathOp{
 	"-": sub,
 	"*": mult,
 	"/": div,
+	"%": mod,
 }
 
 func (m *mathOp) Capture(values []string) error {
@@ -457,6 +494,8 @@ func (m *mathOp) String() string {
 		return "*"
 	case div:
 		return "/"
+	case mod:
+		return "%"
 	default:
 		return "UNKNOWN OP!"
 	}
@@ -474,11 +513,13 @@ func buildLexer() *lexer.StatefulDefinition {
 		{Name: `Int`, Pattern: `[-+]?\d+`},
 		{Name: `String`, Pattern: `"(\\.|[^\\"])*"`},
 		{Name: `OpNot`, Pattern: `\b(not)\b`},
+		// the preceding whitespace is required for converter names such as Min not to be split on "in"
+		{Name: `OpIn`, Pattern: `\s+in\b`},
 		{Name: `OpOr`, Pattern: `\b(or)\b`},
 		{Name: `OpAnd`, Pattern: `\b(and)\b`},
 		{Name: `OpComparison`, Pattern: `==|!=|>=|<=|>|<`},
 		{Name: `OpAddSub`, Pattern: `\+|\-`},
-		{Name: `OpMultDiv`, Pattern: `\/|\*`},
+		{Name: `OpMultDiv`, Pattern: `\/|\*|%`},
 		{Name: `Boolean`, Pattern: `\b(true|false)\b`},
 		{Name: `Equal`, Pattern: `=`},
 		{Name: `LParen`, Pattern: `\(`},
@@ -487,6 +528,7 @@ func buildLexer() *lexer.StatefulDefinition {
 		{Name: `RBrace`, Pattern: `\}`},
 		{Name: `Colon`, Pattern: `\:`},
 		{Name: `Punct`, Pattern: `[,.\[\]]`},
+		{Name: `If`, Pattern: `\bIf\b`},
 		{Name: `Uppercase`, Pattern: `[A-Z][A-Z0-9_]*`},
 		{Name: `Lowercase`, Pattern: `[a-z][a-z0-9_]*`},
 		{Name: "whitespace", Pattern: `\s+`},
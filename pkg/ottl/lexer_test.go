@@ -107,11 +107,12 @@ func Test_lexer(t *testing.T) {
 			{"Lowercase", "d_123"},
 			{"Uppercase", "E_4"},
 		}},
-		{"Math Operations", `+-*/`, false, []result{
+		{"Math Operations", `+-*/%`, false, []result{
 			{"OpAddSub", "+"},
 			{"OpAddSub", "-"},
 			{"OpMultDiv", "*"},
 			{"OpMultDiv", "/"},
+			{"OpMultDiv", "%"},
 		}},
 		{"Math Equations", `1000 - 600`, false, []result{
 			{"Int", "1000"},
@@ -123,6 +124,32 @@ func Test_lexer(t *testing.T) {
 			{"OpMultDiv", "*"},
 			{"Float", "2.9"},
 		}},
+		{"in", "name not in inputs", false, []result{
+			{"Lowercase", "name"},
+			{"OpNot", "not"},
+			{"OpIn", " in"},
+			{"Lowercase", "inputs"}, // should not parse "in" as an operator
+		}},
+		{"in converter name", "Min(Join)", false, []result{
+			{"Uppercase", "M"},
+			{"Lowercase", "in"}, // should not parse "in" as an operator
+			{"LParen", "("},
+			{"Uppercase", "J"},
+			{"Lowercase", "oin"},
+			{"RParen", ")"},
+		}},
+		{"If", "If(IsMatch, Iff)", false, []result{
+			{"If", "If"},
+			{"LParen", "("},
+			{"Uppercase", "I"},
+			{"Lowercase", "s"},
+			{"Uppercase", "M"},
+			{"Lowercase", "atch"},
+			{"Punct", ","},
+			{"Uppercase", "I"}, // should not parse "If" as a keyword
+			{"Lowercase", "ff"},
+			{"RParen", ")"},
+		}},
 		{"Map", `{"foo":"bar"}`, false, []result{
 			{"LBrace", "{"},
 			{"String", `"foo"`},
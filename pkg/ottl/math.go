@@ -6,6 +6,7 @@ package ottl // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -174,6 +175,21 @@ func performOp[N int64 | float64](x N, y N, op mathOp) (N, error) {
 			return 0, fmt.Errorf("attempted to divide by 0")
 		}
 		return x / y, nil
+	case mod:
+		if y == 0 {
+			return 0, fmt.Errorf("attempted to divide by 0")
+		}
+		return remainder(x, y), nil
 	}
 	return 0, fmt.Errorf("invalid operation %v", op)
 }
+
+// remainder returns the remainder of x / y, which has the sign of x.
+func remainder[N int64 | float64](x N, y N) N {
+	switch v := any(x).(type) {
+	case int64:
+		return N(v % int64(y))
+	default:
+		return N(math.Mod(float64(x), float64(y)))
+	}
+}
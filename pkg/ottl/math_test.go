@@ -223,6 +223,31 @@ func Test_evaluateMathExpression(t *testing.T) {
 			input:    "4 / 2.0",
 			expected: 2.0,
 		},
+		{
+			name:     "int modulo",
+			input:    "10 % 3",
+			expected: 1,
+		},
+		{
+			name:     "negative int modulo",
+			input:    "-10 % 3",
+			expected: -1,
+		},
+		{
+			name:     "float modulo",
+			input:    "5.5 % 2",
+			expected: 1.5,
+		},
+		{
+			name:     "modulo precedence",
+			input:    "1 + 10 % 4 * 2",
+			expected: 5,
+		},
+		{
+			name:     "conditional",
+			input:    "If(one < two, 10, 20) * 2",
+			expected: 20,
+		},
 	}
 
 	functions := CreateFactoryMap(
@@ -268,6 +293,10 @@ func Test_evaluateMathExpression_error(t *testing.T) {
 			name:  "divide by 0 is gracefully handled",
 			input: "1 / 0",
 		},
+		{
+			name:  "modulo by 0 is gracefully handled",
+			input: "1 % 0",
+		},
 		{
 			name: "time div time",
 			mathExpr: &mathExpression{
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type AvgArguments[K any] struct {
+	Target ottl.Getter[K]
+}
+
+func NewAvgFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Avg", &AvgArguments[K]{}, createAvgFunction[K])
+}
+
+func createAvgFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*AvgArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("AvgFactory args must be of type *AvgArguments[K]")
+	}
+
+	return avg(args.Target), nil
+}
+
+func avg[K any](target ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		items, err := toList(val)
+		if err != nil {
+			return nil, err
+		}
+		total, err := sumNumbers(items)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return nil, nil
+		}
+		f, _ := toFloat(total)
+		return f / float64(len(items)), nil
+	}
+}
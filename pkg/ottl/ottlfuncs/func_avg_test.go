@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Avg(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   any
+		expected any
+	}{
+		{
+			name:     "ints",
+			target:   []any{int64(1), int64(2)},
+			expected: 1.5,
+		},
+		{
+			name:     "doubles",
+			target:   []float64{1, 2, 6},
+			expected: 3.0,
+		},
+		{
+			name:     "empty list",
+			target:   []any{},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := avg[any](valueGetter(tt.target))
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Avg_error(t *testing.T) {
+	exprFunc := avg[any](valueGetter([]any{"1"}))
+	_, err := exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "expected a list of numbers")
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Base64Encode(t *testing.T) {
+	bytesValue := pcommon.NewValueBytes()
+	bytesValue.Bytes().FromRaw([]byte{0xfb, 0xff})
+
+	tests := []struct {
+		name     string
+		target   any
+		variant  ottl.Optional[string]
+		expected string
+	}{
+		{
+			name:     "string",
+			target:   "test string",
+			expected: "dGVzdCBzdHJpbmc=",
+		},
+		{
+			name:     "bytes",
+			target:   []byte{0xfb, 0xff},
+			expected: "+/8=",
+		},
+		{
+			name:     "bytes value",
+			target:   bytesValue,
+			variant:  ottl.NewTestingOptional("base64-url"),
+			expected: "-_8=",
+		},
+		{
+			name:     "raw",
+			target:   "test string",
+			variant:  ottl.NewTestingOptional("base64-raw"),
+			expected: "dGVzdCBzdHJpbmc",
+		},
+		{
+			name:     "raw url",
+			target:   []byte{0xfb, 0xff},
+			variant:  ottl.NewTestingOptional("base64-raw-url"),
+			expected: "-_8",
+		},
+		{
+			name:     "nil",
+			target:   nil,
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := createBase64EncodeFunction[any](ottl.FunctionContext{}, &Base64EncodeArguments[any]{
+				Target: &ottl.StandardByteSliceLikeGetter[any]{
+					Getter: func(_ context.Context, _ any) (any, error) {
+						return tt.target, nil
+					},
+				},
+				Variant: tt.variant,
+			})
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Base64Encode_error(t *testing.T) {
+	target := &ottl.StandardByteSliceLikeGetter[any]{
+		Getter: func(_ context.Context, _ any) (any, error) {
+			return map[string]any{}, nil
+		},
+	}
+
+	_, err := createBase64EncodeFunction[any](ottl.FunctionContext{}, &Base64EncodeArguments[any]{
+		Target:  target,
+		Variant: ottl.NewTestingOptional("base32"),
+	})
+	assert.ErrorContains(t, err, "invalid variant")
+
+	exprFunc, err := createBase64EncodeFunction[any](ottl.FunctionContext{}, &Base64EncodeArguments[any]{Target: target})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ContainsValueArguments[K any] struct {
+	Target ottl.Getter[K]
+	Item   ottl.Getter[K]
+}
+
+func NewContainsValueFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ContainsValue", &ContainsValueArguments[K]{}, createContainsValueFunction[K])
+}
+
+func createContainsValueFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ContainsValueArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ContainsValueFactory args must be of type *ContainsValueArguments[K]")
+	}
+
+	return containsValue(args.Target, args.Item), nil
+}
+
+func containsValue[K any](target ottl.Getter[K], item ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		t, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		items, err := toList(t)
+		if err != nil {
+			return nil, err
+		}
+		i, err := item.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range items {
+			if valuesEqual(it, i) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
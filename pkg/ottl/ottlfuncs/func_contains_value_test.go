@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_ContainsValue(t *testing.T) {
+	s := pcommon.NewSlice()
+	require.NoError(t, s.FromRaw([]any{"pci", 2, map[string]any{"k": "v"}}))
+
+	tests := []struct {
+		name     string
+		target   any
+		item     any
+		expected bool
+	}{
+		{
+			name:     "string",
+			target:   s,
+			item:     "pci",
+			expected: true,
+		},
+		{
+			name:     "number of another type",
+			target:   s,
+			item:     2.0,
+			expected: true,
+		},
+		{
+			name:     "map",
+			target:   s,
+			item:     map[string]any{"k": "v"},
+			expected: true,
+		},
+		{
+			name:     "missing",
+			target:   s,
+			item:     "hipaa",
+			expected: false,
+		},
+		{
+			name:     "int list",
+			target:   []int64{1, 2},
+			item:     int64(2),
+			expected: true,
+		},
+		{
+			name:     "empty list",
+			target:   []any{},
+			item:     "pci",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := containsValue[any](valueGetter(tt.target), valueGetter(tt.item))
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ContainsValue_error(t *testing.T) {
+	exprFunc := containsValue[any](valueGetter("pci"), valueGetter("pci"))
+	_, err := exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "expected a list")
+}
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type FilterArguments[K any] struct {
+	Target  ottl.Getter[K]
+	Pattern string
+}
+
+func NewFilterFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Filter", &FilterArguments[K]{}, createFilterFunction[K])
+}
+
+func createFilterFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*FilterArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("FilterFactory args must be of type *FilterArguments[K]")
+	}
+
+	return filter(args.Target, args.Pattern)
+}
+
+func filter[K any](target ottl.Getter[K], pattern string) (ottl.ExprFunc[K], error) {
+	compiledPattern, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("the pattern supplied to Filter is not a valid regexp pattern: %w", err)
+	}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		items, err := toList(val)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]any, 0, len(items))
+		for _, item := range items {
+			s, ok := item.(string)
+			if !ok {
+				// other items are matched against their string representation, like in IsMatch
+				v := pcommon.NewValueEmpty()
+				if err = v.FromRaw(item); err != nil {
+					return nil, err
+				}
+				s = v.AsString()
+			}
+			if compiledPattern.MatchString(s) {
+				result = append(result, item)
+			}
+		}
+		return result, nil
+	}, nil
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Filter(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   any
+		pattern  string
+		expected []any
+	}{
+		{
+			name:     "strings",
+			target:   []string{"pci-dss", "hipaa", "pci"},
+			pattern:  "^pci",
+			expected: []any{"pci-dss", "pci"},
+		},
+		{
+			name:     "other types",
+			target:   []any{int64(404), int64(500), 503.5, true},
+			pattern:  "^5",
+			expected: []any{int64(500), 503.5},
+		},
+		{
+			name:     "no match",
+			target:   []any{"a"},
+			pattern:  "b",
+			expected: []any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := filter[any](valueGetter(tt.target), tt.pattern)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Filter_error(t *testing.T) {
+	_, err := filter[any](valueGetter([]any{}), "(")
+	assert.ErrorContains(t, err, "not a valid regexp pattern")
+
+	exprFunc, err := filter[any](valueGetter("pci"), "pci")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "expected a list")
+}
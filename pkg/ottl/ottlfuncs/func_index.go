@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type IndexArguments[K any] struct {
+	Target ottl.Getter[K]
+	Value  ottl.Getter[K]
+}
+
+func NewIndexFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Index", &IndexArguments[K]{}, createIndexFunction[K])
+}
+
+func createIndexFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*IndexArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("IndexFactory args must be of type *IndexArguments[K]")
+	}
+
+	return index(args.Target, args.Value), nil
+}
+
+func index[K any](target ottl.Getter[K], value ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		t, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		v, err := value.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		if s, ok := toRaw(t).(string); ok {
+			substr, ok := toRaw(v).(string)
+			if !ok {
+				return nil, fmt.Errorf("the value searched in a string must be a string, but got %T", v)
+			}
+			return int64(strings.Index(s, substr)), nil
+		}
+
+		items, err := toList(t)
+		if err != nil {
+			return nil, fmt.Errorf("target must be a string or a list: %w", err)
+		}
+		for i, item := range items {
+			if valuesEqual(item, v) {
+				return int64(i), nil
+			}
+		}
+		return int64(-1), nil
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_Index(t *testing.T) {
+	s := pcommon.NewSlice()
+	require.NoError(t, s.FromRaw([]any{"a", 2, 3.5, "a"}))
+
+	tests := []struct {
+		name     string
+		target   any
+		value    any
+		expected int64
+	}{
+		{
+			name:     "first item",
+			target:   s,
+			value:    "a",
+			expected: 0,
+		},
+		{
+			name:     "number of another type",
+			target:   s,
+			value:    2.0,
+			expected: 1,
+		},
+		{
+			name:     "missing item",
+			target:   s,
+			value:    "b",
+			expected: -1,
+		},
+		{
+			name:     "string list",
+			target:   []string{"x", "y"},
+			value:    "y",
+			expected: 1,
+		},
+		{
+			name:     "substring",
+			target:   "hello world",
+			value:    "world",
+			expected: 6,
+		},
+		{
+			name:     "missing substring",
+			target:   "hello world",
+			value:    "planet",
+			expected: -1,
+		},
+		{
+			name:     "string value",
+			target:   pcommon.NewValueStr("hello"),
+			value:    "l",
+			expected: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := index[any](valueGetter(tt.target), valueGetter(tt.value))
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Index_error(t *testing.T) {
+	tests := []struct {
+		name   string
+		target any
+		value  any
+	}{
+		{
+			name:   "non-string value in string",
+			target: "hello",
+			value:  int64(1),
+		},
+		{
+			name:   "invalid target",
+			target: int64(1),
+			value:  int64(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := index[any](valueGetter(tt.target), valueGetter(tt.value))
+			_, err := exprFunc(context.Background(), nil)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type IsInCIDRArguments[K any] struct {
+	Target   ottl.StringGetter[K]
+	Networks []string
+}
+
+func NewIsInCIDRFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("IsInCIDR", &IsInCIDRArguments[K]{}, createIsInCIDRFunction[K])
+}
+
+func createIsInCIDRFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*IsInCIDRArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("IsInCIDRFactory args must be of type *IsInCIDRArguments[K]")
+	}
+
+	return isInCIDR(args.Target, args.Networks)
+}
+
+func isInCIDR[K any](target ottl.StringGetter[K], networks []string) (ottl.ExprFunc[K], error) {
+	if len(networks) == 0 {
+		return nil, errors.New("at least one network must be supplied to IsInCIDR")
+	}
+	prefixes := make([]netip.Prefix, 0, len(networks))
+	for _, network := range networks {
+		prefix, err := netip.ParsePrefix(network)
+		if err != nil {
+			return nil, fmt.Errorf("the network supplied to IsInCIDR is not a valid CIDR: %w", err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := netip.ParseAddr(val)
+		if err != nil {
+			// values which aren't IP addresses, such as host names, aren't in any network
+			return false, nil
+		}
+		// IPv4-mapped IPv6 addresses are matched against the IPv4 networks
+		addr = addr.Unmap()
+		for _, prefix := range prefixes {
+			if prefix.Contains(addr) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}, nil
+}
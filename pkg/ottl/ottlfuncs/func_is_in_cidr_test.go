@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_IsInCIDR(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		networks []string
+		expected bool
+	}{
+		{
+			name:     "in network",
+			target:   "10.1.2.3",
+			networks: []string{"10.0.0.0/8"},
+			expected: true,
+		},
+		{
+			name:     "in second network",
+			target:   "192.168.1.20",
+			networks: []string{"10.0.0.0/8", "192.168.0.0/16"},
+			expected: true,
+		},
+		{
+			name:     "not in network",
+			target:   "8.8.8.8",
+			networks: []string{"10.0.0.0/8", "192.168.0.0/16"},
+			expected: false,
+		},
+		{
+			name:     "network with host bits",
+			target:   "10.1.2.3",
+			networks: []string{"10.1.2.1/24"},
+			expected: true,
+		},
+		{
+			name:     "ipv6",
+			target:   "fd00::1",
+			networks: []string{"fc00::/7"},
+			expected: true,
+		},
+		{
+			name:     "ipv4-mapped ipv6",
+			target:   "::ffff:10.1.2.3",
+			networks: []string{"10.0.0.0/8"},
+			expected: true,
+		},
+		{
+			name:     "ipv4 not in ipv6 network",
+			target:   "10.1.2.3",
+			networks: []string{"::/0"},
+			expected: false,
+		},
+		{
+			name:     "not an ip address",
+			target:   "localhost",
+			networks: []string{"127.0.0.0/8"},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := isInCIDR[any](&ottl.StandardStringGetter[any]{
+				Getter: func(_ context.Context, _ any) (any, error) {
+					return tt.target, nil
+				},
+			}, tt.networks)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_IsInCIDR_error(t *testing.T) {
+	target := &ottl.StandardStringGetter[any]{
+		Getter: func(_ context.Context, _ any) (any, error) {
+			return int64(1), nil
+		},
+	}
+
+	_, err := isInCIDR[any](target, nil)
+	assert.ErrorContains(t, err, "at least one network")
+
+	_, err = isInCIDR[any](target, []string{"10.0.0.0"})
+	assert.ErrorContains(t, err, "not a valid CIDR")
+
+	exprFunc, err := isInCIDR[any](target, []string{"10.0.0.0/8"})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Keys(t *testing.T) {
+	m := pcommon.NewMap()
+	m.PutStr("b", "value")
+	m.PutInt("a", 1)
+
+	tests := []struct {
+		name     string
+		target   any
+		expected []string
+	}{
+		{
+			name:     "map",
+			target:   m,
+			expected: []string{"b", "a"},
+		},
+		{
+			name:     "raw map",
+			target:   map[string]any{"key": "value"},
+			expected: []string{"key"},
+		},
+		{
+			name:     "empty map",
+			target:   pcommon.NewMap(),
+			expected: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardPMapGetter[any]{Getter: valueGetter(tt.target).Getter}
+			exprFunc := keys[any](target)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Keys_error(t *testing.T) {
+	target := &ottl.StandardPMapGetter[any]{Getter: valueGetter("not a map").Getter}
+	exprFunc := keys[any](target)
+	_, err := exprFunc(context.Background(), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type MaxArguments[K any] struct {
+	Target ottl.Getter[K]
+}
+
+func NewMaxFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Max", &MaxArguments[K]{}, createMaxFunction[K])
+}
+
+func createMaxFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*MaxArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("MaxFactory args must be of type *MaxArguments[K]")
+	}
+
+	return extremum(args.Target, func(a, b float64) bool { return a > b }), nil
+}
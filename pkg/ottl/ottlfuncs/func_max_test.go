@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Max(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   any
+		expected any
+	}{
+		{
+			name:     "ints",
+			target:   []any{int64(3), int64(-1), int64(2)},
+			expected: int64(3),
+		},
+		{
+			name:     "mixed numbers",
+			target:   []any{int64(3), 3.5, int64(2)},
+			expected: 3.5,
+		},
+		{
+			name:     "first of equal numbers",
+			target:   []any{int64(3), 3.0},
+			expected: int64(3),
+		},
+		{
+			name:     "empty list",
+			target:   []int64{},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := createMaxFunction[any](ottl.FunctionContext{}, &MaxArguments[any]{Target: valueGetter(tt.target)})
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Max_error(t *testing.T) {
+	exprFunc, err := createMaxFunction[any](ottl.FunctionContext{}, &MaxArguments[any]{Target: valueGetter("3")})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "expected a list")
+}
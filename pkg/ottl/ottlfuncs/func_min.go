@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type MinArguments[K any] struct {
+	Target ottl.Getter[K]
+}
+
+func NewMinFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Min", &MinArguments[K]{}, createMinFunction[K])
+}
+
+func createMinFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*MinArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("MinFactory args must be of type *MinArguments[K]")
+	}
+
+	return extremum(args.Target, func(a, b float64) bool { return a < b }), nil
+}
+
+// extremum returns the number of the list for which better returns true when compared to every other number,
+// keeping its type. The extremum of an empty list is nil.
+func extremum[K any](target ottl.Getter[K], better func(a, b float64) bool) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		items, err := toList(val)
+		if err != nil {
+			return nil, err
+		}
+		var result any
+		var resultValue float64
+		for _, item := range items {
+			f, ok := toFloat(item)
+			if !ok {
+				return nil, fmt.Errorf("expected a list of numbers but got an item of type %T", item)
+			}
+			if result == nil || better(f, resultValue) {
+				result, resultValue = item, f
+			}
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Min(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   any
+		expected any
+	}{
+		{
+			name:     "ints",
+			target:   []any{int64(3), int64(-1), int64(2)},
+			expected: int64(-1),
+		},
+		{
+			name:     "mixed numbers",
+			target:   []any{int64(3), 2.5, int64(4)},
+			expected: 2.5,
+		},
+		{
+			name:     "double list",
+			target:   []float64{1.5, 0.5},
+			expected: 0.5,
+		},
+		{
+			name:     "empty list",
+			target:   []any{},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := createMinFunction[any](ottl.FunctionContext{}, &MinArguments[any]{Target: valueGetter(tt.target)})
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Min_error(t *testing.T) {
+	exprFunc, err := createMinFunction[any](ottl.FunctionContext{}, &MinArguments[any]{Target: valueGetter([]any{int64(1), "2"})})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "expected a list of numbers")
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ParseIntArguments[K any] struct {
+	Target ottl.StringGetter[K]
+	Base   ottl.Optional[int64]
+}
+
+func NewParseIntFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseInt", &ParseIntArguments[K]{}, createParseIntFunction[K])
+}
+
+func createParseIntFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseIntArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ParseIntFactory args must be of type *ParseIntArguments[K]")
+	}
+
+	// the base is inferred from the prefix of the value by default
+	var base int64
+	if !args.Base.IsEmpty() {
+		base = args.Base.Get()
+		if base != 0 && (base < 2 || base > 36) {
+			return nil, fmt.Errorf("invalid base: %d, must be 0 or between 2 and 36", base)
+		}
+	}
+
+	return parseInt(args.Target, int(base)), nil
+}
+
+func parseInt[K any](target ottl.StringGetter[K], base int) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		result, err := strconv.ParseInt(val, base, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %q as an int: %w", val, err)
+		}
+		return result, nil
+	}
+}
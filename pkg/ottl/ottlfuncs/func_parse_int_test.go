@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		base     ottl.Optional[int64]
+		expected int64
+	}{
+		{
+			name:     "decimal",
+			target:   "-42",
+			expected: -42,
+		},
+		{
+			name:     "hexadecimal prefix",
+			target:   "0x1f",
+			expected: 31,
+		},
+		{
+			name:     "octal prefix",
+			target:   "0o17",
+			expected: 15,
+		},
+		{
+			name:     "leading zero octal",
+			target:   "017",
+			expected: 15,
+		},
+		{
+			name:     "binary prefix",
+			target:   "0b101",
+			expected: 5,
+		},
+		{
+			name:     "hexadecimal base",
+			target:   "ff",
+			base:     ottl.NewTestingOptional[int64](16),
+			expected: 255,
+		},
+		{
+			name:     "octal base",
+			target:   "755",
+			base:     ottl.NewTestingOptional[int64](8),
+			expected: 493,
+		},
+		{
+			name:     "decimal base with leading zero",
+			target:   "010",
+			base:     ottl.NewTestingOptional[int64](10),
+			expected: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := createParseIntFunction[any](ottl.FunctionContext{}, &ParseIntArguments[any]{
+				Target: &ottl.StandardStringGetter[any]{
+					Getter: func(_ context.Context, _ any) (any, error) {
+						return tt.target, nil
+					},
+				},
+				Base: tt.base,
+			})
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ParseInt_error(t *testing.T) {
+	target := &ottl.StandardStringGetter[any]{
+		Getter: func(_ context.Context, _ any) (any, error) {
+			return "0x1g", nil
+		},
+	}
+
+	_, err := createParseIntFunction[any](ottl.FunctionContext{}, &ParseIntArguments[any]{
+		Target: target,
+		Base:   ottl.NewTestingOptional[int64](1),
+	})
+	assert.ErrorContains(t, err, "invalid base")
+
+	exprFunc, err := createParseIntFunction[any](ottl.FunctionContext{}, &ParseIntArguments[any]{Target: target})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "could not parse")
+}
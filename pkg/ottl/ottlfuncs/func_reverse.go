@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ReverseArguments[K any] struct {
+	Target ottl.Getter[K]
+}
+
+func NewReverseFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Reverse", &ReverseArguments[K]{}, createReverseFunction[K])
+}
+
+func createReverseFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ReverseArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ReverseFactory args must be of type *ReverseArguments[K]")
+	}
+
+	return reverse(args.Target), nil
+}
+
+func reverse[K any](target ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		items, err := toList(val)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]any, len(items))
+		for i, item := range items {
+			result[len(items)-1-i] = item
+		}
+		return result, nil
+	}
+}
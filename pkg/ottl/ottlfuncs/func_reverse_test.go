@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_Reverse(t *testing.T) {
+	s := pcommon.NewSlice()
+	require.NoError(t, s.FromRaw([]any{"a", 1, true}))
+
+	tests := []struct {
+		name     string
+		target   any
+		expected []any
+	}{
+		{
+			name:     "slice",
+			target:   s,
+			expected: []any{true, int64(1), "a"},
+		},
+		{
+			name:     "string list",
+			target:   []string{"a", "b"},
+			expected: []any{"b", "a"},
+		},
+		{
+			name:     "empty list",
+			target:   []any{},
+			expected: []any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := reverse[any](valueGetter(tt.target))
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Reverse_not_modified(t *testing.T) {
+	target := []any{"a", "b"}
+	exprFunc := reverse[any](valueGetter(target))
+	_, err := exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a", "b"}, target)
+}
+
+func Test_Reverse_error(t *testing.T) {
+	exprFunc := reverse[any](valueGetter("ab"))
+	_, err := exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "expected a list")
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type SumArguments[K any] struct {
+	Target ottl.Getter[K]
+}
+
+func NewSumFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Sum", &SumArguments[K]{}, createSumFunction[K])
+}
+
+func createSumFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*SumArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("SumFactory args must be of type *SumArguments[K]")
+	}
+
+	return sum(args.Target), nil
+}
+
+func sum[K any](target ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		items, err := toList(val)
+		if err != nil {
+			return nil, err
+		}
+		return sumNumbers(items)
+	}
+}
+
+// sumNumbers returns the sum of the items as an int64 when they are all integers, and as a float64 otherwise.
+func sumNumbers(items []any) (any, error) {
+	var intSum int64
+	var floatSum float64
+	isFloat := false
+	for _, item := range items {
+		switch v := item.(type) {
+		case int64:
+			intSum += v
+		case float64:
+			floatSum += v
+			isFloat = true
+		default:
+			return nil, fmt.Errorf("expected a list of numbers but got an item of type %T", item)
+		}
+	}
+	if isFloat {
+		return floatSum + float64(intSum), nil
+	}
+	return intSum, nil
+}
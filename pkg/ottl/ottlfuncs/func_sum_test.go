@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_Sum(t *testing.T) {
+	s := pcommon.NewSlice()
+	require.NoError(t, s.FromRaw([]any{1, 2, 3}))
+
+	tests := []struct {
+		name     string
+		target   any
+		expected any
+	}{
+		{
+			name:     "ints",
+			target:   s,
+			expected: int64(6),
+		},
+		{
+			name:     "mixed numbers",
+			target:   []any{int64(1), 0.5},
+			expected: 1.5,
+		},
+		{
+			name:     "empty list",
+			target:   []any{},
+			expected: int64(0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := sum[any](valueGetter(tt.target))
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Sum_error(t *testing.T) {
+	exprFunc := sum[any](valueGetter([]any{int64(1), true}))
+	_, err := exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "expected a list of numbers")
+}
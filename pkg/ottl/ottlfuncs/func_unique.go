@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type UniqueArguments[K any] struct {
+	Target ottl.Getter[K]
+}
+
+func NewUniqueFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Unique", &UniqueArguments[K]{}, createUniqueFunction[K])
+}
+
+func createUniqueFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*UniqueArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("UniqueFactory args must be of type *UniqueArguments[K]")
+	}
+
+	return unique(args.Target), nil
+}
+
+func unique[K any](target ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		items, err := toList(val)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]any, 0, len(items))
+	items:
+		for _, item := range items {
+			for _, kept := range result {
+				if valuesEqual(item, kept) {
+					continue items
+				}
+			}
+			result = append(result, item)
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Unique(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   any
+		expected []any
+	}{
+		{
+			name:     "strings",
+			target:   []string{"b", "a", "b", "c", "a"},
+			expected: []any{"b", "a", "c"},
+		},
+		{
+			name:     "numbers of different types",
+			target:   []any{int64(1), 1.0, 2.5},
+			expected: []any{int64(1), 2.5},
+		},
+		{
+			name:     "maps",
+			target:   []any{map[string]any{"k": "v"}, map[string]any{"k": "v"}},
+			expected: []any{map[string]any{"k": "v"}},
+		},
+		{
+			name:     "empty list",
+			target:   []any{},
+			expected: []any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := unique[any](valueGetter(tt.target))
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Unique_error(t *testing.T) {
+	exprFunc := unique[any](valueGetter(map[string]any{}))
+	_, err := exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "expected a list")
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_URLDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected string
+	}{
+		{
+			name:     "query value",
+			target:   "a+b%26c%3Dd%2F%C3%A9",
+			expected: "a b&c=d/é",
+		},
+		{
+			name:     "query string",
+			target:   "user=j%C3%B6rg&q=a%20b",
+			expected: "user=jörg&q=a b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := urlDecode[any](&ottl.StandardStringGetter[any]{
+				Getter: func(_ context.Context, _ any) (any, error) {
+					return tt.target, nil
+				},
+			})
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_URLDecode_error(t *testing.T) {
+	exprFunc := urlDecode[any](&ottl.StandardStringGetter[any]{
+		Getter: func(_ context.Context, _ any) (any, error) {
+			return "%zz", nil
+		},
+	})
+	_, err := exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "could not decode")
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Values(t *testing.T) {
+	m := pcommon.NewMap()
+	m.PutStr("b", "value")
+	m.PutInt("a", 1)
+	m.PutEmptySlice("c").AppendEmpty().SetBool(true)
+
+	tests := []struct {
+		name     string
+		target   any
+		expected []any
+	}{
+		{
+			name:     "map",
+			target:   m,
+			expected: []any{"value", int64(1), []any{true}},
+		},
+		{
+			name:     "empty map",
+			target:   pcommon.NewMap(),
+			expected: []any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardPMapGetter[any]{Getter: valueGetter(tt.target).Getter}
+			exprFunc := values[any](target)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Values_error(t *testing.T) {
+	target := &ottl.StandardPMapGetter[any]{Getter: valueGetter(int64(1)).Getter}
+	exprFunc := values[any](target)
+	_, err := exprFunc(context.Background(), nil)
+	assert.Error(t, err)
+}
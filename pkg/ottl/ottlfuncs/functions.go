@@ -102,5 +102,21 @@ func converters[K any]() []ottl.Factory[K] {
 		NewYearFactory[K](),
 		NewHexFactory[K](),
 		NewSliceToMapFactory[K](),
+		NewKeysFactory[K](),
+		NewValuesFactory[K](),
+		NewIndexFactory[K](),
+		NewContainsValueFactory[K](),
+		NewMinFactory[K](),
+		NewMaxFactory[K](),
+		NewSumFactory[K](),
+		NewAvgFactory[K](),
+		NewReverseFactory[K](),
+		NewUniqueFactory[K](),
+		NewFilterFactory[K](),
+		NewIsInCIDRFactory[K](),
+		NewParseIntFactory[K](),
+		NewBase64EncodeFactory[K](),
+		NewURLEncodeFactory[K](),
+		NewURLDecodeFactory[K](),
 	}
 }
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// The functions in this file are shared by the Converters operating on the items of lists.
+
+// toList returns the items of the list as raw values, or an error if the value isn't a list.
+// The returned slice must not be modified, as it may be the value itself.
+func toList(val any) ([]any, error) {
+	switch v := val.(type) {
+	case pcommon.Slice:
+		return v.AsRaw(), nil
+	case pcommon.Value:
+		if v.Type() == pcommon.ValueTypeSlice {
+			return v.Slice().AsRaw(), nil
+		}
+		return nil, fmt.Errorf("expected a list but got %v", v.Type())
+	case []any:
+		return v, nil
+	case []string:
+		return appendMultiple(nil, v), nil
+	case []int64:
+		return appendMultiple(nil, v), nil
+	case []float64:
+		return appendMultiple(nil, v), nil
+	case []bool:
+		return appendMultiple(nil, v), nil
+	case [][]byte:
+		return appendMultiple(nil, v), nil
+	default:
+		return nil, fmt.Errorf("expected a list but got %T", val)
+	}
+}
+
+// toRaw returns the raw value of pdata values, and the value itself otherwise.
+func toRaw(val any) any {
+	switch v := val.(type) {
+	case pcommon.Map:
+		return v.AsRaw()
+	case pcommon.Slice:
+		return v.AsRaw()
+	case pcommon.Value:
+		return v.AsRaw()
+	default:
+		return val
+	}
+}
+
+// valuesEqual reports whether the items are equal, comparing numbers by value regardless of their type,
+// like the OTTL == operator.
+func valuesEqual(a any, b any) bool {
+	a, b = toRaw(a), toRaw(b)
+	switch x := a.(type) {
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return x == y
+		case float64:
+			return float64(x) == y
+		}
+		return false
+	case float64:
+		switch y := b.(type) {
+		case int64:
+			return x == float64(y)
+		case float64:
+			return x == y
+		}
+		return false
+	case []byte:
+		y, ok := b.([]byte)
+		return ok && bytes.Equal(x, y)
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// toFloat returns the value of a number as a float64, and reports whether the item is a number.
+func toFloat(item any) (float64, bool) {
+	switch v := item.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// valueGetter returns a getter of the value.
+func valueGetter(val any) ottl.StandardGetSetter[any] {
+	return ottl.StandardGetSetter[any]{
+		Getter: func(_ context.Context, _ any) (any, error) {
+			return val, nil
+		},
+	}
+}
+
+func Test_toList(t *testing.T) {
+	s := pcommon.NewSlice()
+	require.NoError(t, s.FromRaw([]any{"a", 1}))
+	v := pcommon.NewValueSlice()
+	s.CopyTo(v.Slice())
+
+	for _, val := range []any{s, v, []any{"a", int64(1)}} {
+		items, err := toList(val)
+		require.NoError(t, err)
+		assert.Equal(t, []any{"a", int64(1)}, items)
+	}
+
+	items, err := toList([]string{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a", "b"}, items)
+
+	for _, val := range []any{"a", pcommon.NewValueStr("a"), pcommon.NewMap(), nil} {
+		_, err = toList(val)
+		assert.Error(t, err)
+	}
+}
+
+func Test_valuesEqual(t *testing.T) {
+	m := pcommon.NewMap()
+	m.PutStr("k", "v")
+
+	assert.True(t, valuesEqual(int64(1), 1.0))
+	assert.True(t, valuesEqual(1.0, int64(1)))
+	assert.True(t, valuesEqual("a", pcommon.NewValueStr("a")))
+	assert.True(t, valuesEqual(map[string]any{"k": "v"}, m))
+	assert.True(t, valuesEqual([]byte{1}, []byte{1}))
+	assert.True(t, valuesEqual(nil, nil))
+	assert.False(t, valuesEqual(int64(1), "1"))
+	assert.False(t, valuesEqual(1.5, int64(1)))
+	assert.False(t, valuesEqual([]byte{1}, "1"))
+	assert.False(t, valuesEqual("a", nil))
+}
@@ -21,6 +21,8 @@ type Statement[K any] struct {
 	function  Expr[K]
 	condition BoolExpr[K]
 	origText  string
+	// usesVariables is whether the statement accesses the variables with the `vars` path.
+	usesVariables bool
 }
 
 // Execute is a function that will execute the statement's function if the statement's condition is met.
@@ -150,9 +152,10 @@ func (p *Parser[K]) ParseStatement(statement string) (*Statement[K], error) {
 		return nil, err
 	}
 	return &Statement[K]{
-		function:  function,
-		condition: expression,
-		origText:  statement,
+		function:      function,
+		condition:     expression,
+		origText:      statement,
+		usesVariables: parsedStatementUsesVariables(parsed),
 	}, nil
 }
 
@@ -301,6 +304,8 @@ type StatementSequence[K any] struct {
 	statements        []*Statement[K]
 	errorMode         ErrorMode
 	telemetrySettings component.TelemetrySettings
+	// usesVariables is whether any of the statements accesses the variables, which are only created for these sequences.
+	usesVariables bool
 }
 
 type StatementSequenceOption[K any] func(*StatementSequence[K])
@@ -324,6 +329,9 @@ func NewStatementSequence[K any](statements []*Statement[K], telemetrySettings c
 	for _, op := range options {
 		op(&s)
 	}
+	for _, statement := range statements {
+		s.usesVariables = s.usesVariables || statement.usesVariables
+	}
 	return s
 }
 
@@ -331,7 +339,11 @@ func NewStatementSequence[K any](statements []*Statement[K], telemetrySettings c
 // When the ErrorMode of the StatementSequence is `propagate`, errors cause the execution to halt and the error is returned.
 // When the ErrorMode of the StatementSequence is `ignore`, errors are logged and execution continues to the next statement.
 // When the ErrorMode of the StatementSequence is `silent`, errors are not logged and execution continues to the next statement.
+// When any of the statements accesses the `vars` path, the statements share a new set of variables.
 func (s *StatementSequence[K]) Execute(ctx context.Context, tCtx K) error {
+	if s.usesVariables {
+		ctx = contextWithVariables(ctx)
+	}
 	s.telemetrySettings.Logger.Debug("initial TransformContext", zap.Any("TransformContext", tCtx))
 	for _, statement := range s.statements {
 		_, condition, err := statement.Execute(ctx, tCtx)
@@ -2099,6 +2099,136 @@ func Test_parseWhere(t *testing.T) {
 				},
 			}),
 		},
+		{
+			statement: `name not in ["a", "b"]`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						Comparison: &comparison{
+							Left: value{
+								Literal: &mathExprLiteral{
+									Path: &path{
+										Pos: lexer.Position{
+											Offset: 24,
+											Line:   1,
+											Column: 25,
+										},
+										Fields: []field{
+											{
+												Name: "name",
+											},
+										},
+									},
+								},
+							},
+							Op: notIn,
+							Right: value{
+								List: &list{
+									Values: []value{
+										{
+											String: ottltest.Strp("a"),
+										},
+										{
+											String: ottltest.Strp("b"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			statement: `If(name in attributes, 1, 2) % 2 == 0`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						Comparison: &comparison{
+							Left: value{
+								MathExpression: &mathExpression{
+									Left: &addSubTerm{
+										Left: &mathValue{
+											Literal: &mathExprLiteral{
+												Conditional: &conditionalExpr{
+													Condition: &booleanExpression{
+														Left: &term{
+															Left: &booleanValue{
+																Comparison: &comparison{
+																	Left: value{
+																		Literal: &mathExprLiteral{
+																			Path: &path{
+																				Pos: lexer.Position{
+																					Offset: 27,
+																					Line:   1,
+																					Column: 28,
+																				},
+																				Fields: []field{
+																					{
+																						Name: "name",
+																					},
+																				},
+																			},
+																		},
+																	},
+																	Op: in,
+																	Right: value{
+																		Literal: &mathExprLiteral{
+																			Path: &path{
+																				Pos: lexer.Position{
+																					Offset: 35,
+																					Line:   1,
+																					Column: 36,
+																				},
+																				Fields: []field{
+																					{
+																						Name: "attributes",
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+													True: &value{
+														Literal: &mathExprLiteral{
+															Int: ottltest.Intp(1),
+														},
+													},
+													False: &value{
+														Literal: &mathExprLiteral{
+															Int: ottltest.Intp(2),
+														},
+													},
+												},
+											},
+										},
+										Right: []*opMultDivValue{
+											{
+												Operator: mod,
+												Value: &mathValue{
+													Literal: &mathExprLiteral{
+														Int: ottltest.Intp(2),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Op: eq,
+							Right: value{
+								Literal: &mathExprLiteral{
+									Int: ottltest.Intp(0),
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
 	}
 
 	// create a test name that doesn't confuse vscode so we can rerun tests with one click
@@ -2232,6 +2362,20 @@ func Test_parseStatement(t *testing.T) {
 		{statement: `set() where 1 == int()`, wantErrContaining: converterNameErrorPrefix},
 		{statement: `set() where true and 1 == int() `, wantErrContaining: converterNameErrorPrefix},
 		{statement: `set() where false or 1 == int() `, wantErrContaining: converterNameErrorPrefix},
+		{statement: `set(name, If(int() == 1, "a", "b"))`, wantErrContaining: converterNameErrorPrefix},
+		{statement: `set(name, If(true, "a", int()))`, wantErrContaining: converterNameErrorPrefix},
+		{statement: `set(name, If(true, "a"))`, wantErr: true},
+		{statement: `set(name, If(true, "a", "b", "c"))`, wantErr: true},
+		{statement: `set(name, If("a", "b"), "c")`, wantErr: true},
+		{statement: `set(name, "a") where name in`, wantErr: true},
+		{statement: `set(name, "a") where name not`, wantErr: true},
+		{statement: `set(name, "a") where name in not ["a"]`, wantErr: true},
+		{statement: `set(name, "a") where name % 2`, wantErr: true},
+		{statement: `set(name, If(animal == "cat", "meow", If(animal == "dog", "woof", nil)))`},
+		{statement: `set(name, If(animal in ["cat", "dog"] and not IsPet(), 1 + 2, foo.attributes["bar"]))`},
+		{statement: `set(name, If(true, "a", "b")) where animal not in attributes`},
+		{statement: `set(name, count % 10)`},
+		{statement: `set(name, Min(origin)) where login in Domain()`},
 		{statement: `set(foo.attributes["bar"].cat)["key"]`, wantErrContaining: editorWithIndexErrorPrefix},
 		{statement: `set(foo.attributes["bar"].cat, "dog")`},
 		{statement: `set(set = foo.attributes["animal"], val = "dog") where animal == "cat"`},
@@ -2318,6 +2462,14 @@ func Test_parseCondition(t *testing.T) {
 		{condition: `==`, wantErr: true},
 		{condition: `== animal`, wantErr: true},
 		{condition: `attributes["path"] == "/healthcheck"`},
+		{condition: `animal in ["cat", "dog"]`},
+		{condition: `animal not in attributes["pets"]`},
+		{condition: `not animal in ["cat"]`},
+		{condition: `animal in`, wantErr: true},
+		{condition: `animal not ["cat"]`, wantErr: true},
+		{condition: `If(animal == "cat", 1, 2) == 1`},
+		{condition: `count % 2 == 0`},
+		{condition: `If(animal == "cat", 1) == 1`, wantErr: true},
 		{condition: `One() == 1`},
 		{condition: `test(fail())`, wantErr: true},
 		{condition: `Test()`},
@@ -2830,6 +2982,13 @@ func Test_prependContextToStatementPaths_Success(t *testing.T) {
 			pathContextNames: []string{"log", "resource"},
 			expected:         `set(log.attributes["test"], "pass") where IsMatch(resource.name, "operation[AC]")`,
 		},
+		{
+			name:             "conditional and membership paths without context",
+			statement:        `set(value, If(name in attributes["names"], id % 2, resource.id)) where id not in [1, 2]`,
+			context:          "span",
+			pathContextNames: []string{"span", "resource"},
+			expected:         `set(span.value, If(span.name in span.attributes["names"], span.id % 2, resource.id)) where span.id not in [1, 2]`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -12,7 +12,11 @@ func (v *grammarPathVisitor) visitEditor(_ *editor)                   {}
 func (v *grammarPathVisitor) visitValue(_ *value)                     {}
 func (v *grammarPathVisitor) visitMathExprLiteral(_ *mathExprLiteral) {}
 
+// visitPath ignores the variables paths, as they don't belong to any context.
 func (v *grammarPathVisitor) visitPath(value *path) {
+	if isVariablesPath(value) {
+		return
+	}
 	v.paths = append(v.paths, *value)
 }
 
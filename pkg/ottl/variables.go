@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlcommon"
+)
+
+// variablesPathName is the name of the path giving access to the variables, such as `vars["name"]`.
+// The variables are available in every context, and are scoped to a single execution of a StatementSequence:
+// a variable set by a statement can be read by the following statements of the sequence for the same TransformContext.
+const variablesPathName = "vars"
+
+type variablesKey struct{}
+
+// contextWithVariables returns a context carrying a new empty set of variables.
+func contextWithVariables(ctx context.Context) context.Context {
+	return context.WithValue(ctx, variablesKey{}, map[string]any{})
+}
+
+// variablesFromContext returns the variables carried by the context, or nil if there are none.
+func variablesFromContext(ctx context.Context) map[string]any {
+	vars, _ := ctx.Value(variablesKey{}).(map[string]any)
+	return vars
+}
+
+// grammarVariablesVisitor is used to find whether a parsedStatement accesses the variables.
+type grammarVariablesVisitor struct {
+	found bool
+}
+
+func (v *grammarVariablesVisitor) visitEditor(_ *editor)                   {}
+func (v *grammarVariablesVisitor) visitValue(_ *value)                     {}
+func (v *grammarVariablesVisitor) visitMathExprLiteral(_ *mathExprLiteral) {}
+
+func (v *grammarVariablesVisitor) visitPath(value *path) {
+	v.found = v.found || isVariablesPath(value)
+}
+
+// parsedStatementUsesVariables reports whether any of the paths of the statement accesses the variables.
+func parsedStatementUsesVariables(ps *parsedStatement) bool {
+	visitor := &grammarVariablesVisitor{}
+	ps.Editor.accept(visitor)
+	if ps.WhereClause != nil {
+		ps.WhereClause.accept(visitor)
+	}
+	return visitor.found
+}
+
+// isVariablesPath reports whether the path accesses the variables rather than the context.
+func isVariablesPath(path *path) bool {
+	if path.Context != "" {
+		// such as vars.name, which is invalid
+		return path.Context == variablesPathName
+	}
+	return len(path.Fields) > 0 && path.Fields[0].Name == variablesPathName
+}
+
+func (p *Parser[K]) newVariableGetSetter(path *path) (GetSetter[K], error) {
+	f := path.Fields[0]
+	if path.Context != "" || len(path.Fields) > 1 || len(f.Keys) == 0 || f.Keys[0].String == nil {
+		return nil, fmt.Errorf(`variables must be accessed by name, such as %s["name"], but got %q`, variablesPathName, buildOriginalText(path))
+	}
+	name := *f.Keys[0].String
+	keys := f.Keys[1:]
+
+	return &StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			// unset variables are nil, as are the variables of statements not executed by a StatementSequence
+			return indexVariable(variablesFromContext(ctx)[name], keys)
+		},
+		Setter: func(ctx context.Context, _ K, val any) error {
+			if len(keys) > 0 {
+				return fmt.Errorf("the variable %q can't be set by key, only as a whole", name)
+			}
+			vars := variablesFromContext(ctx)
+			if vars == nil {
+				return errors.New("variables can only be set by the statements of a StatementSequence")
+			}
+			// the variable must not be changed by the changes of the telemetry it was set from
+			switch v := val.(type) {
+			case pcommon.Map:
+				m := pcommon.NewMap()
+				v.CopyTo(m)
+				val = m
+			case pcommon.Slice:
+				s := pcommon.NewSlice()
+				v.CopyTo(s)
+				val = s
+			}
+			vars[name] = val
+			return nil
+		},
+	}, nil
+}
+
+// indexVariable returns the item of the value of a variable at the keys. Like for the maps of the
+// contexts, the item of a missing key is nil.
+func indexVariable(val any, keys []key) (any, error) {
+	for _, k := range keys {
+		if val == nil {
+			return nil, nil
+		}
+		if k.String != nil {
+			switch v := val.(type) {
+			case pcommon.Map:
+				item, ok := v.Get(*k.String)
+				if !ok {
+					return nil, nil
+				}
+				val = ottlcommon.GetValue(item)
+			case map[string]any:
+				val = v[*k.String]
+			default:
+				return nil, fmt.Errorf("type, %T, does not support string indexing", val)
+			}
+			continue
+		}
+		switch v := val.(type) {
+		case pcommon.Slice:
+			if int(*k.Int) >= v.Len() || int(*k.Int) < 0 {
+				return nil, fmt.Errorf("index %v out of bounds", *k.Int)
+			}
+			val = ottlcommon.GetValue(v.At(int(*k.Int)))
+		case []any:
+			item, err := getElementByIndex(v, k.Int)
+			if err != nil {
+				return nil, err
+			}
+			val = item
+		case []string:
+			item, err := getElementByIndex(v, k.Int)
+			if err != nil {
+				return nil, err
+			}
+			val = item
+		default:
+			return nil, fmt.Errorf("type, %T, does not support int indexing", val)
+		}
+	}
+	return val, nil
+}
@@ -0,0 +1,224 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+type variablesSetArguments struct {
+	Target Setter[pcommon.Map]
+	Value  Getter[pcommon.Map]
+}
+
+func variablesTestFunctions() map[string]Factory[pcommon.Map] {
+	return CreateFactoryMap(NewFactory("set", &variablesSetArguments{}, func(_ FunctionContext, args Arguments) (ExprFunc[pcommon.Map], error) {
+		setArgs := args.(*variablesSetArguments)
+		return func(ctx context.Context, tCtx pcommon.Map) (any, error) {
+			val, err := setArgs.Value.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			return nil, setArgs.Target.Set(ctx, tCtx, val)
+		}, nil
+	}))
+}
+
+// variablesTestParsePath gives access to the attributes of the pcommon.Map transform context.
+func variablesTestParsePath(p Path[pcommon.Map]) (GetSetter[pcommon.Map], error) {
+	if p.Name() != "attributes" {
+		return nil, fmt.Errorf("bad path %v", p)
+	}
+	keys := p.Keys()
+	if keys == nil {
+		return &StandardGetSetter[pcommon.Map]{
+			Getter: func(_ context.Context, tCtx pcommon.Map) (any, error) {
+				return tCtx, nil
+			},
+		}, nil
+	}
+	key, _ := keys[0].String(context.Background(), pcommon.NewMap())
+	return &StandardGetSetter[pcommon.Map]{
+		Getter: func(_ context.Context, tCtx pcommon.Map) (any, error) {
+			val, ok := tCtx.Get(*key)
+			if !ok {
+				return nil, nil
+			}
+			return val.AsRaw(), nil
+		},
+		Setter: func(_ context.Context, tCtx pcommon.Map, val any) error {
+			switch v := val.(type) {
+			case pcommon.Map:
+				v.CopyTo(tCtx.PutEmptyMap(*key))
+				return nil
+			case nil:
+				tCtx.Remove(*key)
+				return nil
+			default:
+				return tCtx.PutEmpty(*key).FromRaw(v)
+			}
+		},
+	}, nil
+}
+
+func newVariablesTestParser(t *testing.T, options ...Option[pcommon.Map]) Parser[pcommon.Map] {
+	p, err := NewParser(variablesTestFunctions(), variablesTestParsePath, componenttest.NewNopTelemetrySettings(), options...)
+	require.NoError(t, err)
+	return p
+}
+
+func Test_StatementSequence_Variables(t *testing.T) {
+	p := newVariablesTestParser(t)
+	statements, err := p.ParseStatements([]string{
+		// the variables of previous executions aren't kept
+		`set(attributes["previous"], vars["name"])`,
+		`set(vars["name"], attributes["name"])`,
+		`set(vars["all"], attributes)`,
+		`set(attributes["name"], "changed")`,
+		`set(attributes["saved"], vars["name"]) where vars["name"] != nil`,
+		`set(attributes["saved_all"], vars["all"]["name"])`,
+		`set(attributes["list"], vars["list"])`,
+		`set(vars["list"], ["a", "b"])`,
+		`set(attributes["second"], vars["list"][1])`,
+		`set(attributes["missing"], vars["all"]["missing"]["key"])`,
+	})
+	require.NoError(t, err)
+	sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+
+	for _, name := range []string{"first", "second"} {
+		tCtx := pcommon.NewMap()
+		tCtx.PutStr("name", name)
+		require.NoError(t, sequence.Execute(context.Background(), tCtx))
+
+		assert.Equal(t, map[string]any{
+			"name":      "changed",
+			"saved":     name,
+			"saved_all": name,
+			"second":    "b",
+		}, tCtx.AsRaw())
+	}
+}
+
+func Test_StatementSequence_Variables_Errors(t *testing.T) {
+	p := newVariablesTestParser(t)
+
+	statement, err := p.ParseStatement(`set(vars["map"]["key"], "value")`)
+	require.NoError(t, err)
+	sequence := NewStatementSequence([]*Statement[pcommon.Map]{statement}, componenttest.NewNopTelemetrySettings())
+	assert.ErrorContains(t, sequence.Execute(context.Background(), pcommon.NewMap()), "can't be set by key")
+
+	statement, err = p.ParseStatement(`set(attributes["name"], vars["name"][0])`)
+	require.NoError(t, err)
+	ctx := contextWithVariables(context.Background())
+	variablesFromContext(ctx)["name"] = "value"
+	_, _, err = statement.Execute(ctx, pcommon.NewMap())
+	assert.ErrorContains(t, err, "does not support int indexing")
+
+	// the variables are scoped to a statement sequence
+	statement, err = p.ParseStatement(`set(vars["name"], "value")`)
+	require.NoError(t, err)
+	_, _, err = statement.Execute(context.Background(), pcommon.NewMap())
+	assert.ErrorContains(t, err, "only be set by the statements of a StatementSequence")
+
+	for _, s := range []string{
+		`set(attributes["a"], vars)`,
+		`set(attributes["a"], vars[0])`,
+		`set(attributes["a"], vars.name)`,
+		`set(attributes["a"], vars["name"].field)`,
+	} {
+		_, err = p.ParseStatement(s)
+		assert.ErrorContains(t, err, "variables must be accessed by name", s)
+	}
+}
+
+func Test_StatementSequence_UsesVariables(t *testing.T) {
+	p := newVariablesTestParser(t)
+
+	tests := []struct {
+		statement string
+		expected  bool
+	}{
+		{statement: `set(attributes["name"], "value")`, expected: false},
+		{statement: `set(vars["name"], "value")`, expected: true},
+		{statement: `set(attributes["name"], "value") where vars["name"] != nil`, expected: true},
+		{statement: `set(attributes["name"], ["value", vars["name"]])`, expected: true},
+		{statement: `set(attributes["name"], {"key": vars["name"]})`, expected: true},
+		{statement: `set(attributes["name"], 1 + vars["count"])`, expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			statement, err := p.ParseStatement(tt.statement)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, statement.usesVariables)
+
+			other, err := p.ParseStatement(`set(attributes["other"], "value")`)
+			require.NoError(t, err)
+			sequence := NewStatementSequence([]*Statement[pcommon.Map]{other, statement}, componenttest.NewNopTelemetrySettings())
+			assert.Equal(t, tt.expected, sequence.usesVariables)
+		})
+	}
+}
+
+func Benchmark_StatementSequence_Execute(b *testing.B) {
+	tests := []struct {
+		name       string
+		statements []string
+	}{
+		{
+			name: "without_variables",
+			statements: []string{
+				`set(attributes["saved"], attributes["name"])`,
+				`set(attributes["name"], "changed")`,
+			},
+		},
+		{
+			name: "with_variables",
+			statements: []string{
+				`set(vars["name"], attributes["name"])`,
+				`set(attributes["name"], "changed")`,
+				`set(attributes["saved"], vars["name"])`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		b.Run(tt.name, func(b *testing.B) {
+			p, err := NewParser(variablesTestFunctions(), variablesTestParsePath, componenttest.NewNopTelemetrySettings())
+			require.NoError(b, err)
+			statements, err := p.ParseStatements(tt.statements)
+			require.NoError(b, err)
+			sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+			tCtx := pcommon.NewMap()
+			tCtx.PutStr("name", "value")
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = sequence.Execute(context.Background(), tCtx)
+			}
+		})
+	}
+}
+
+func Test_Variables_PathContextNames(t *testing.T) {
+	p := newVariablesTestParser(t, WithPathContextNames[pcommon.Map]([]string{"log"}))
+
+	// variables don't have a context
+	_, err := p.ParseStatement(`set(log.attributes["name"], vars["name"]) where vars["name"] != nil`)
+	require.NoError(t, err)
+
+	result, err := p.prependContextToStatementPaths("log", `set(attributes["name"], vars["name"]) where vars["name"] != nil`)
+	require.NoError(t, err)
+	assert.Equal(t, `set(log.attributes["name"], vars["name"]) where vars["name"] != nil`, result)
+
+	inferred, err := defaultPriorityContextInferrer().infer([]string{`set(vars["name"], 1)`})
+	require.NoError(t, err)
+	assert.Empty(t, inferred)
+}
@@ -157,14 +157,14 @@ func convertFromLogs(workerItem fromConverterWorkerItem) []*entry.Entry {
 
 		entry.ScopeName = workerItem.Scope.Scope().Name()
 		entry.Resource = workerItem.Resource.Attributes().AsRaw()
-		convertFrom(record, &entry)
+		ConvertFrom(record, &entry)
 		result = append(result, &entry)
 	}
 	return result
 }
 
-// convertFrom converts plog.LogRecord into provided entry.Entry.
-func convertFrom(src plog.LogRecord, ent *entry.Entry) {
+// ConvertFrom converts plog.LogRecord into provided entry.Entry.
+func ConvertFrom(src plog.LogRecord, ent *entry.Entry) {
 	// if src.Timestamp == 0, then leave ent.Timestamp as nil
 	if src.Timestamp() != 0 {
 		ent.Timestamp = src.Timestamp().AsTime()
@@ -106,7 +106,7 @@ func TestConvertFromSeverity(t *testing.T) {
 			entry := entry.New()
 			logRecord := plog.NewLogRecord()
 			logRecord.SetSeverityNumber(tc.severityNumber)
-			convertFrom(logRecord, entry)
+			ConvertFrom(logRecord, entry)
 			require.Equal(t, tc.expectedSeverity, entry.Severity)
 		})
 	}
@@ -51,7 +51,7 @@ func (r *receiver) Start(ctx context.Context, host component.Host) error {
 	return nil
 }
 
-func (r *receiver) consumeEntries(ctx context.Context, entries []*entry.Entry) {
+func (r *receiver) consumeEntries(ctx context.Context, entries []*entry.Entry) error {
 	obsrecvCtx := r.obsrecv.StartLogsOp(ctx)
 	pLogs := ConvertEntries(entries)
 	logRecordCount := pLogs.LogRecordCount()
@@ -61,6 +61,7 @@ func (r *receiver) consumeEntries(ctx context.Context, entries []*entry.Entry) {
 		r.set.Logger.Error("ConsumeLogs() failed", zap.Error(cErr))
 	}
 	r.obsrecv.EndLogsOp(obsrecvCtx, "stanza", logRecordCount, cErr)
+	return cErr
 }
 
 // Shutdown is invoked during service shutdown
@@ -36,6 +36,12 @@ const (
 	defaultMaxConcurrentFiles = 1024
 	defaultEncoding           = "utf-8"
 	defaultPollInterval       = 200 * time.Millisecond
+	defaultResyncInterval     = time.Minute
+)
+
+const (
+	watchModePoll    = "poll"
+	watchModeInotify = "inotify"
 )
 
 var allowFileDeletion = featuregate.GlobalRegistry().MustRegister(
@@ -56,6 +62,8 @@ var AllowHeaderMetadataParsing = featuregate.GlobalRegistry().MustRegister(
 func NewConfig() *Config {
 	return &Config{
 		PollInterval:       defaultPollInterval,
+		WatchMode:          watchModePoll,
+		ResyncInterval:     defaultResyncInterval,
 		MaxConcurrentFiles: defaultMaxConcurrentFiles,
 		StartAt:            "end",
 		FingerprintSize:    fingerprint.DefaultSize,
@@ -73,6 +81,8 @@ type Config struct {
 	matcher.Criteria        `mapstructure:",squash"`
 	attrs.Resolver          `mapstructure:",squash"`
 	PollInterval            time.Duration   `mapstructure:"poll_interval,omitempty"`
+	WatchMode               string          `mapstructure:"watch_mode,omitempty"`
+	ResyncInterval          time.Duration   `mapstructure:"resync_interval,omitempty"`
 	MaxConcurrentFiles      int             `mapstructure:"max_concurrent_files,omitempty"`
 	MaxBatches              int             `mapstructure:"max_batches,omitempty"`
 	StartAt                 string          `mapstructure:"start_at,omitempty"`
@@ -84,9 +94,10 @@ type Config struct {
 	FlushPeriod             time.Duration   `mapstructure:"force_flush_period,omitempty"`
 	Header                  *HeaderConfig   `mapstructure:"header,omitempty"`
 	DeleteAfterRead         bool            `mapstructure:"delete_after_read,omitempty"`
+	PostRead                *PostReadConfig `mapstructure:"post_read,omitempty"`
 	IncludeFileRecordNumber bool            `mapstructure:"include_file_record_number,omitempty"`
 	Compression             string          `mapstructure:"compression,omitempty"`
-	PollsToArchive          int             `mapstructure:"-"` // TODO: activate this config once archiving is set up
+	PollsToArchive          int             `mapstructure:"polls_to_archive,omitempty"`
 	AcquireFSLock           bool            `mapstructure:"acquire_fs_lock,omitempty"`
 }
 
@@ -169,6 +180,7 @@ func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts
 		Attributes:              c.Resolver,
 		HeaderConfig:            hCfg,
 		DeleteAtEOF:             c.DeleteAfterRead,
+		AcknowledgeEOF:          c.PostRead != nil,
 		IncludeFileRecordNumber: c.IncludeFileRecordNumber,
 		Compression:             c.Compression,
 		AcquireFSLock:           c.AcquireFSLock,
@@ -178,13 +190,21 @@ func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts
 	if err != nil {
 		return nil, err
 	}
+	var postRead *postReadActions
+	if c.PostRead != nil {
+		postRead = newPostReadActions(*c.PostRead, set.Logger)
+	}
 	return &Manager{
 		set:              set,
 		readerFactory:    readerFactory,
 		fileMatcher:      fileMatcher,
 		pollInterval:     c.PollInterval,
+		watchMode:        c.WatchMode,
+		resyncInterval:   c.ResyncInterval,
 		maxBatchFiles:    c.MaxConcurrentFiles / 2,
 		maxBatches:       c.MaxBatches,
+		pollsToArchive:   c.PollsToArchive,
+		postRead:         postRead,
 		telemetryBuilder: telemetryBuilder,
 		noTracking:       o.noTracking,
 	}, nil
@@ -203,6 +223,16 @@ func (c Config) validate() error {
 		return fmt.Errorf("'max_log_size' must be positive")
 	}
 
+	switch c.WatchMode {
+	case "", watchModePoll:
+	case watchModeInotify:
+		if c.ResyncInterval <= 0 {
+			return errors.New("'resync_interval' must be positive")
+		}
+	default:
+		return fmt.Errorf("invalid 'watch_mode' %q, must be one of %q or %q", c.WatchMode, watchModePoll, watchModeInotify)
+	}
+
 	if c.MaxConcurrentFiles < 1 {
 		return fmt.Errorf("'max_concurrent_files' must be positive")
 	}
@@ -211,6 +241,14 @@ func (c Config) validate() error {
 		return errors.New("'max_batches' must not be negative")
 	}
 
+	if c.PollsToArchive < 0 {
+		return errors.New("'polls_to_archive' must not be negative")
+	}
+
+	if err := reader.ValidateCompression(c.Compression); err != nil {
+		return fmt.Errorf("invalid 'compression': %w", err)
+	}
+
 	enc, err := decode.LookupEncoding(c.Encoding)
 	if err != nil {
 		return err
@@ -225,6 +263,18 @@ func (c Config) validate() error {
 		}
 	}
 
+	if c.PostRead != nil {
+		if c.DeleteAfterRead {
+			return errors.New("'post_read' cannot be used with 'delete_after_read'")
+		}
+		if c.StartAt == "end" {
+			return errors.New("'post_read' cannot be used with 'start_at: end'")
+		}
+		if err := c.PostRead.validate(); err != nil {
+			return fmt.Errorf("invalid config for 'post_read': %w", err)
+		}
+	}
+
 	if c.Header != nil {
 		if !AllowHeaderMetadataParsing.IsEnabled() {
 			return fmt.Errorf("'header' requires feature gate '%s'", AllowHeaderMetadataParsing.ID())
@@ -403,6 +403,36 @@ func TestUnmarshal(t *testing.T) {
 					return newMockOperatorConfig(cfg)
 				}(),
 			},
+			{
+				Name: "polls_to_archive_10",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.PollsToArchive = 10
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "watch_mode_inotify",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.WatchMode = "inotify"
+					cfg.ResyncInterval = 30 * time.Second
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "post_read_move",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.StartAt = "beginning"
+					cfg.PostRead = &PostReadConfig{
+						Action:    "move",
+						MoveTo:    "/var/log/archive",
+						Retention: time.Hour,
+					}
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
 			{
 				Name: "header_config",
 				Expect: func() *mockOperatorConfig {
@@ -565,6 +595,139 @@ func TestBuild(t *testing.T) {
 				require.Equal(t, 6, m.maxBatches)
 			},
 		},
+		{
+			"InvalidCompression",
+			func(cfg *Config) {
+				cfg.Compression = "lz4"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"AutoCompression",
+			func(cfg *Config) {
+				cfg.Compression = "auto"
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, "auto", m.readerFactory.Compression)
+			},
+		},
+		{
+			"InvalidPollsToArchive",
+			func(cfg *Config) {
+				cfg.PollsToArchive = -1
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ValidPollsToArchive",
+			func(cfg *Config) {
+				cfg.PollsToArchive = 10
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, 10, m.pollsToArchive)
+			},
+		},
+		{
+			"InvalidWatchMode",
+			func(cfg *Config) {
+				cfg.WatchMode = "invalid"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"InvalidResyncInterval",
+			func(cfg *Config) {
+				cfg.WatchMode = "inotify"
+				cfg.ResyncInterval = 0
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ValidWatchMode",
+			func(cfg *Config) {
+				cfg.WatchMode = "inotify"
+				cfg.ResyncInterval = time.Second
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, "inotify", m.watchMode)
+				require.Equal(t, time.Second, m.resyncInterval)
+			},
+		},
+		{
+			"InvalidPostReadAction",
+			func(cfg *Config) {
+				cfg.StartAt = "beginning"
+				cfg.PostRead = &PostReadConfig{Action: "archive"}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"PostReadMoveWithoutMoveTo",
+			func(cfg *Config) {
+				cfg.StartAt = "beginning"
+				cfg.PostRead = &PostReadConfig{Action: "move"}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"PostReadRenameWithoutSuffix",
+			func(cfg *Config) {
+				cfg.StartAt = "beginning"
+				cfg.PostRead = &PostReadConfig{Action: "rename"}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"PostReadNegativeRetention",
+			func(cfg *Config) {
+				cfg.StartAt = "beginning"
+				cfg.PostRead = &PostReadConfig{Action: "rename", Suffix: ".done", Retention: -time.Second}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"PostReadStartAtEnd",
+			func(cfg *Config) {
+				cfg.StartAt = "end"
+				cfg.PostRead = &PostReadConfig{Action: "rename", Suffix: ".done"}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"PostReadWithDeleteAfterRead",
+			func(cfg *Config) {
+				cfg.StartAt = "beginning"
+				cfg.DeleteAfterRead = true
+				cfg.PostRead = &PostReadConfig{Action: "rename", Suffix: ".done"}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ValidPostRead",
+			func(cfg *Config) {
+				cfg.StartAt = "beginning"
+				cfg.PostRead = &PostReadConfig{Action: "move", MoveTo: "/var/log/archive", Retention: time.Minute}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.NotNil(t, m.postRead)
+				require.Equal(t, time.Minute, m.postRead.cfg.Retention)
+				require.True(t, m.readerFactory.AcknowledgeEOF)
+			},
+		},
 		{
 			"HeaderConfigNoFlag",
 			func(cfg *Config) {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"sync"
 	"time"
 
@@ -33,10 +34,13 @@ type Manager struct {
 	noTracking    bool
 
 	pollInterval   time.Duration
+	watchMode      string
+	resyncInterval time.Duration
 	persister      operator.Persister
 	maxBatches     int
 	maxBatchFiles  int
 	pollsToArchive int
+	postRead       *postReadActions
 
 	telemetryBuilder *metadata.TelemetryBuilder
 }
@@ -67,6 +71,14 @@ func (m *Manager) Start(persister operator.Persister) error {
 		m.set.Logger.Error("archiving is not supported in memory, please use a storage extension")
 	}
 
+	if m.watchMode == watchModeInotify {
+		err := m.startWatcher(ctx)
+		if err == nil {
+			return nil
+		}
+		m.set.Logger.Warn("Cannot watch file system events, falling back to polling", zap.Error(err))
+	}
+
 	// Start polling goroutine
 	m.startPoller(ctx)
 
@@ -97,19 +109,24 @@ func (m *Manager) startPoller(ctx context.Context) {
 	m.wg.Add(1)
 	go func() {
 		defer m.wg.Done()
-		globTicker := time.NewTicker(m.pollInterval)
-		defer globTicker.Stop()
+		m.runPoller(ctx)
+	}()
+}
 
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-globTicker.C:
-			}
+// runPoller polls the filesystem periodically until the context is cancelled
+func (m *Manager) runPoller(ctx context.Context) {
+	globTicker := time.NewTicker(m.pollInterval)
+	defer globTicker.Stop()
 
-			m.poll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-globTicker.C:
 		}
-	}()
+
+		m.poll(ctx)
+	}
 }
 
 // poll checks all the watched paths for new entries
@@ -141,18 +158,24 @@ func (m *Manager) poll(ctx context.Context) {
 
 	// Any new files that appear should be consumed entirely
 	m.readerFactory.FromBeginning = true
-	if m.persister != nil {
-		metadata := m.tracker.GetMetadata()
-		if metadata != nil {
-			if err := checkpoint.Save(context.Background(), m.persister, metadata); err != nil {
-				m.set.Logger.Error("save offsets", zap.Error(err))
-			}
-		}
-	}
+	m.saveCheckpoint()
 	// rotate at end of every poll()
 	m.tracker.EndPoll()
 }
 
+// saveCheckpoint persists the offsets of all the known files
+func (m *Manager) saveCheckpoint() {
+	if m.persister == nil {
+		return
+	}
+	metadata := m.tracker.GetMetadata()
+	if metadata != nil {
+		if err := checkpoint.Save(context.Background(), m.persister, metadata); err != nil {
+			m.set.Logger.Error("save offsets", zap.Error(err))
+		}
+	}
+}
+
 func (m *Manager) consume(ctx context.Context, paths []string) {
 	m.set.Logger.Debug("Consuming files", zap.Strings("paths", paths))
 	m.makeReaders(ctx, paths)
@@ -172,6 +195,15 @@ func (m *Manager) consume(ctx context.Context, paths []string) {
 	}
 	wg.Wait()
 
+	if m.postRead != nil {
+		for _, r := range m.tracker.CurrentPollFiles() {
+			if ack := r.EOFAcknowledgement(); ack != nil {
+				m.postRead.add(r.GetFileName(), ack)
+			}
+		}
+		m.postRead.process(time.Now())
+	}
+
 	m.telemetryBuilder.FileconsumerOpenFiles.Add(ctx, int64(0-m.tracker.EndConsume()))
 }
 
@@ -204,6 +236,9 @@ func (m *Manager) makeFingerprint(path string) (*fingerprint.Fingerprint, *os.Fi
 // discarding any that have a duplicate fingerprint to other files that have already
 // been read this polling interval
 func (m *Manager) makeReaders(ctx context.Context, paths []string) {
+	// files that don't match any file known by the tracker, which may still be found in the archive
+	var unknownFiles []*os.File
+	var unknownFps []*fingerprint.Fingerprint
 	for _, path := range paths {
 		fp, file := m.makeFingerprint(path)
 		if fp == nil {
@@ -221,17 +256,41 @@ func (m *Manager) makeReaders(ctx context.Context, paths []string) {
 			}
 			continue
 		}
+		if slices.ContainsFunc(unknownFps, fp.Equal) {
+			m.set.Logger.Debug("Skipping duplicate file", zap.String("path", file.Name()))
+			if err := file.Close(); err != nil {
+				m.set.Logger.Debug("problem closing file", zap.Error(err))
+			}
+			continue
+		}
 
 		r, err := m.newReader(ctx, file, fp)
 		if err != nil {
 			m.set.Logger.Error("Failed to create reader", zap.Error(err))
 			continue
 		}
+		if r == nil {
+			unknownFiles = append(unknownFiles, file)
+			unknownFps = append(unknownFps, fp)
+			continue
+		}
 
 		m.tracker.Add(r)
 	}
+
+	// Look for the unknown files in the archive all at once, as it's stored on disk
+	archivedMetadata := m.tracker.FindFiles(unknownFps)
+	for i, file := range unknownFiles {
+		r, err := m.newUnknownReader(ctx, file, unknownFps[i], archivedMetadata[i])
+		if err != nil {
+			m.set.Logger.Error("Failed to create reader", zap.Error(err))
+			continue
+		}
+		m.tracker.Add(r)
+	}
 }
 
+// newReader creates a reader for a file known by the tracker. It returns nil if the file is unknown.
 func (m *Manager) newReader(ctx context.Context, file *os.File, fp *fingerprint.Fingerprint) (*reader.Reader, error) {
 	// Check previous poll cycle for match
 	if oldReader := m.tracker.GetOpenFile(fp); oldReader != nil {
@@ -260,10 +319,22 @@ func (m *Manager) newReader(ctx context.Context, file *os.File, fp *fingerprint.
 		m.telemetryBuilder.FileconsumerOpenFiles.Add(ctx, 1)
 		return r, nil
 	}
+	return nil, nil
+}
 
-	// If we don't match any previously known files, create a new reader from scratch
-	m.set.Logger.Info("Started watching file", zap.String("path", file.Name()))
-	r, err := m.readerFactory.NewReader(file, fp)
+// newUnknownReader creates a reader for a file that isn't known by the tracker, resuming from the
+// archived metadata if the file was found in the archive.
+func (m *Manager) newUnknownReader(ctx context.Context, file *os.File, fp *fingerprint.Fingerprint, archived *reader.Metadata) (*reader.Reader, error) {
+	var r *reader.Reader
+	var err error
+	if archived != nil {
+		m.set.Logger.Debug("Resuming file from the archive", zap.String("path", file.Name()))
+		r, err = m.readerFactory.NewReaderFromMetadata(file, archived)
+	} else {
+		// If we don't match any previously known files, create a new reader from scratch
+		m.set.Logger.Info("Started watching file", zap.String("path", file.Name()))
+		r, err = m.readerFactory.NewReader(file, fp)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1545,6 +1545,51 @@ func TestReadGzipCompressedLogsFromBeginning(t *testing.T) {
 	sink.ExpectToken(t, []byte("testlog2"))
 }
 
+// TestReadMixedCompressedLogs tests that, with the compression detected automatically, plain files and files
+// compressed with each supported codec are all read
+func TestReadMixedCompressedLogs(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.Compression = "auto"
+	cfg.StartAt = "beginning"
+	operator, sink := testManager(t, cfg)
+
+	plain := filetest.OpenFile(t, filepath.Join(tempDir, "logs.log"))
+	filetest.WriteString(t, plain, "plain1\nplain2\n")
+	for _, ext := range []string{"gz", "zst", "bz2", "xz"} {
+		content, err := os.ReadFile(filepath.Join("internal", "reader", "testdata", "logs1."+ext))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "logs."+ext), content, 0o600))
+	}
+
+	operator.poll(context.Background())
+	sink.ExpectTokens(t,
+		[]byte("plain1"), []byte("plain2"),
+		[]byte("testlog1"), []byte("testlog2"),
+		[]byte("testlog1"), []byte("testlog2"),
+		[]byte("testlog1"), []byte("testlog2"),
+		[]byte("testlog1"), []byte("testlog2"),
+	)
+
+	// only the appended content is read
+	filetest.WriteString(t, plain, "plain3\n")
+	for _, ext := range []string{"gz", "zst", "bz2", "xz"} {
+		content, err := os.ReadFile(filepath.Join("internal", "reader", "testdata", "logs2."+ext))
+		require.NoError(t, err)
+		file := filetest.OpenFile(t, filepath.Join(tempDir, "logs."+ext))
+		_, err = file.Seek(0, 2)
+		require.NoError(t, err)
+		_, err = file.Write(content)
+		require.NoError(t, err)
+	}
+
+	operator.poll(context.Background())
+	sink.ExpectTokens(t, []byte("plain3"), []byte("testlog3"), []byte("testlog3"), []byte("testlog3"), []byte("testlog3"))
+	sink.ExpectNoCalls(t)
+}
+
 // TestReadGzipCompressedLogsFromEnd tests that, when starting at the end of a gzip compressed file, we
 // read all the lines that are added afterward
 func TestReadGzipCompressedLogsFromEnd(t *testing.T) {
@@ -1656,3 +1701,58 @@ func TestIncludeFileRecordNumberWithHeaderConfiguredButMissing(t *testing.T) {
 		attrs.LogFileRecordNumber: int64(1),
 	})
 }
+
+func TestArchive(t *testing.T) {
+	testCases := []struct {
+		testName       string
+		pollsToArchive int
+		expectReplay   bool
+	}{
+		{"archive_disabled", 0, true},
+		{"archive_enabled", 10, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			tempDir := t.TempDir()
+			cfg := NewConfig().includeDir(tempDir)
+			cfg.StartAt = "beginning"
+			cfg.PollInterval = 1000 * time.Hour // We control the polling within the test.
+			cfg.PollsToArchive = tc.pollsToArchive
+			operator, sink := testManager(t, cfg)
+
+			path := filepath.Join(tempDir, "file.log")
+			temp := filetest.OpenFile(t, path)
+			filetest.WriteString(t, temp, "testlog1\n")
+			require.NoError(t, temp.Close())
+
+			require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+			defer func() {
+				require.NoError(t, operator.Stop())
+			}()
+
+			operator.poll(context.Background())
+			sink.ExpectToken(t, []byte("testlog1"))
+
+			// The file goes away for long enough to be forgotten by the tracker
+			require.NoError(t, os.Remove(path))
+			for i := 0; i < 5; i++ {
+				operator.poll(context.Background())
+			}
+			require.Zero(t, operator.tracker.TotalReaders())
+
+			// The file appears again with the same fingerprint
+			temp = filetest.OpenFile(t, path)
+			filetest.WriteString(t, temp, "testlog1\ntestlog2\n")
+			require.NoError(t, temp.Close())
+
+			operator.poll(context.Background())
+			if tc.expectReplay {
+				sink.ExpectTokens(t, []byte("testlog1"), []byte("testlog2"))
+			} else {
+				sink.ExpectToken(t, []byte("testlog2"))
+			}
+			sink.ExpectNoCalls(t)
+		})
+	}
+}
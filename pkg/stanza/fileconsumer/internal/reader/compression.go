@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+const (
+	CompressionGzip  = "gzip"
+	CompressionZstd  = "zstd"
+	CompressionBzip2 = "bzip2"
+	CompressionXz    = "xz"
+	CompressionAuto  = "auto"
+)
+
+// magicBytes are the bytes at the beginning of the files compressed with each codec.
+var magicBytes = []struct {
+	compression string
+	magic       []byte
+}{
+	{CompressionGzip, []byte{0x1f, 0x8b}},
+	{CompressionZstd, []byte{0x28, 0xb5, 0x2f, 0xfd}},
+	{CompressionBzip2, []byte("BZh")},
+	{CompressionXz, []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}},
+}
+
+// maxMagicLen is the number of bytes needed to detect the compression of a file.
+const maxMagicLen = 6
+
+var errUnknownCompression = errors.New("unknown compression")
+
+// ValidateCompression returns an error if the compression isn't supported.
+func ValidateCompression(compression string) error {
+	switch compression {
+	case "", CompressionGzip, CompressionZstd, CompressionBzip2, CompressionXz, CompressionAuto:
+		return nil
+	default:
+		return fmt.Errorf("%w %q", errUnknownCompression, compression)
+	}
+}
+
+// detectCompression detects the compression of the file from its first bytes. It returns an empty
+// string if the file isn't compressed, and reports false if the file is too short to tell yet.
+func detectCompression(file *os.File) (string, bool, error) {
+	buf := make([]byte, maxMagicLen)
+	n, err := file.ReadAt(buf, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", false, err
+	}
+	buf = buf[:n]
+
+	for _, m := range magicBytes {
+		if bytes.HasPrefix(buf, m.magic) {
+			return m.compression, true, nil
+		}
+		// the file may still be written
+		if len(buf) < len(m.magic) && bytes.HasPrefix(m.magic, buf) {
+			return "", false, nil
+		}
+	}
+	return "", true, nil
+}
+
+// newDecompressor returns a reader of the decompressed content of the compressed content read from r.
+// The content may consist of multiple concatenated compressed streams, as written when compressed
+// content is appended to the file. Closing the returned reader doesn't close r.
+func newDecompressor(compression string, r io.Reader) (io.ReadCloser, error) {
+	switch compression {
+	case CompressionGzip:
+		return gzip.NewReader(r)
+	case CompressionZstd:
+		decoder, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return decoder.IOReadCloser(), nil
+	case CompressionBzip2:
+		return io.NopCloser(bzip2.NewReader(r)), nil
+	case CompressionXz:
+		xzReader, err := xz.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return io.NopCloser(xzReader), nil
+	default:
+		return nil, fmt.Errorf("%w %q", errUnknownCompression, compression)
+	}
+}
+
+// decompressedReader reads the decompressed content of a file, and records how the decompression ended.
+type decompressedReader struct {
+	io.ReadCloser
+
+	// number of decompressed bytes read
+	size int64
+	// whether the end of the content was reached
+	eof bool
+	// the error that prevented the content from being decompressed, if any
+	err error
+}
+
+// Read reports the end of the content both when the decompression completes and when it's cut short or
+// fails, so that the tokens decompressed so far are still read.
+func (d *decompressedReader) Read(p []byte) (int, error) {
+	n, err := d.ReadCloser.Read(p)
+	d.size += int64(n)
+	switch {
+	case err == nil:
+		return n, nil
+	case errors.Is(err, io.EOF):
+		d.eof = true
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		// the last stream is still being written
+	default:
+		d.err = err
+	}
+	return n, io.EOF
+}
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/filetest"
+)
+
+var compressionExtensions = map[string]string{
+	CompressionGzip:  "gz",
+	CompressionZstd:  "zst",
+	CompressionBzip2: "bz2",
+	CompressionXz:    "xz",
+}
+
+func TestReadCompressed(t *testing.T) {
+	for compression, ext := range compressionExtensions {
+		logs1, err := os.ReadFile(filepath.Join("testdata", "logs1."+ext))
+		require.NoError(t, err)
+		logs2, err := os.ReadFile(filepath.Join("testdata", "logs2."+ext))
+		require.NoError(t, err)
+
+		for _, mode := range []string{compression, CompressionAuto} {
+			t.Run(compression+"/"+mode, func(t *testing.T) {
+				t.Parallel()
+
+				temp := filetest.OpenTemp(t, t.TempDir())
+				_, err := temp.Write(logs1)
+				require.NoError(t, err)
+
+				f, sink := testFactory(t, withCompression(mode))
+				fp, err := f.NewFingerprint(temp)
+				require.NoError(t, err)
+				reader, err := f.NewReader(filetest.OpenFile(t, temp.Name()), fp)
+				require.NoError(t, err)
+				defer reader.Close()
+
+				reader.ReadToEnd(context.Background())
+				sink.ExpectTokens(t, []byte("testlog1"), []byte("testlog2"))
+				assert.Equal(t, int64(len(logs1)), reader.Offset)
+				assert.Zero(t, reader.DecompressedOffset)
+
+				// the stream which is still being written is read once it's complete
+				half := len(logs2) / 2
+				_, err = temp.Write(logs2[:half])
+				require.NoError(t, err)
+				reader.ReadToEnd(context.Background())
+				sink.ExpectNoCalls(t)
+				assert.Equal(t, int64(len(logs1)), reader.Offset)
+
+				_, err = temp.Write(logs2[half:])
+				require.NoError(t, err)
+				reader.ReadToEnd(context.Background())
+				sink.ExpectToken(t, []byte("testlog3"))
+				sink.ExpectNoCalls(t)
+				assert.Equal(t, int64(len(logs1)+len(logs2)), reader.Offset)
+				assert.Zero(t, reader.DecompressedOffset)
+			})
+		}
+	}
+}
+
+func TestReadCompressedIncompleteToken(t *testing.T) {
+	t.Parallel()
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	appendStream := func(content string) {
+		writer := gzip.NewWriter(temp)
+		_, err := writer.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+	}
+	appendStream("testlog1\ntestlog2")
+
+	f, sink := testFactory(t, withCompression(CompressionGzip))
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	reader, err := f.NewReader(filetest.OpenFile(t, temp.Name()), fp)
+	require.NoError(t, err)
+
+	// the stream is kept until its last token is complete
+	reader.ReadToEnd(context.Background())
+	sink.ExpectToken(t, []byte("testlog1"))
+	sink.ExpectNoCalls(t)
+	assert.Zero(t, reader.Offset)
+	assert.Equal(t, int64(len("testlog1\n")), reader.DecompressedOffset)
+
+	// the offsets are resumed from the metadata
+	reader, err = f.NewReaderFromMetadata(filetest.OpenFile(t, temp.Name()), reader.Close())
+	require.NoError(t, err)
+	defer reader.Close()
+
+	appendStream(" continued\n")
+	info, err := temp.Stat()
+	require.NoError(t, err)
+	reader.ReadToEnd(context.Background())
+	sink.ExpectToken(t, []byte("testlog2 continued"))
+	sink.ExpectNoCalls(t)
+	assert.Equal(t, info.Size(), reader.Offset)
+	assert.Zero(t, reader.DecompressedOffset)
+}
+
+func TestReadCorrupted(t *testing.T) {
+	t.Parallel()
+
+	logs1, err := os.ReadFile(filepath.Join("testdata", "logs1.gz"))
+	require.NoError(t, err)
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	_, err = temp.Write(logs1)
+	require.NoError(t, err)
+
+	f, sink := testFactory(t, withCompression(CompressionGzip))
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	reader, err := f.NewReader(filetest.OpenFile(t, temp.Name()), fp)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	reader.ReadToEnd(context.Background())
+	sink.ExpectTokens(t, []byte("testlog1"), []byte("testlog2"))
+
+	// corrupted content is skipped
+	_, err = temp.Write(append(logs1[:10:10], bytes.Repeat([]byte{0xff}, 20)...))
+	require.NoError(t, err)
+	info, err := temp.Stat()
+	require.NoError(t, err)
+	reader.ReadToEnd(context.Background())
+	sink.ExpectNoCalls(t)
+	assert.Equal(t, info.Size(), reader.Offset)
+}
+
+func TestDetectCompression(t *testing.T) {
+	cases := []struct {
+		name        string
+		content     []byte
+		compression string
+		ok          bool
+	}{
+		{"plain", []byte("testlog1\n"), "", true},
+		{"gzip", []byte{0x1f, 0x8b, 0x08, 0x00}, CompressionGzip, true},
+		{"zstd", []byte{0x28, 0xb5, 0x2f, 0xfd, 0x00}, CompressionZstd, true},
+		{"bzip2", []byte("BZh91AY"), CompressionBzip2, true},
+		{"xz", []byte{0xfd, '7', 'z', 'X', 'Z', 0x00, 0x00}, CompressionXz, true},
+		{"partial magic", []byte{0xfd, '7', 'z'}, "", false},
+		{"short plain", []byte("t"), "", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			temp := filetest.OpenTemp(t, t.TempDir())
+			_, err := temp.Write(tc.content)
+			require.NoError(t, err)
+
+			compression, ok, err := detectCompression(temp)
+			require.NoError(t, err)
+			assert.Equal(t, tc.compression, compression)
+			assert.Equal(t, tc.ok, ok)
+		})
+	}
+}
+
+func TestValidateCompression(t *testing.T) {
+	for _, compression := range []string{"", CompressionGzip, CompressionZstd, CompressionBzip2, CompressionXz, CompressionAuto} {
+		assert.NoError(t, ValidateCompression(compression))
+	}
+	assert.ErrorIs(t, ValidateCompression("lz4"), errUnknownCompression)
+}
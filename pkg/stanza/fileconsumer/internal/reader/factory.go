@@ -42,6 +42,7 @@ type Factory struct {
 	EmitFunc                emit.Callback
 	Attributes              attrs.Resolver
 	DeleteAtEOF             bool
+	AcknowledgeEOF          bool
 	IncludeFileRecordNumber bool
 	Compression             string
 	AcquireFSLock           bool
@@ -74,6 +75,7 @@ func (f *Factory) NewReaderFromMetadata(file *os.File, m *Metadata) (r *Reader,
 		maxLogSize:           f.MaxLogSize,
 		decoder:              decode.New(f.Encoding),
 		deleteAtEOF:          f.DeleteAtEOF,
+		acknowledgeEOF:       f.AcknowledgeEOF,
 		includeFileRecordNum: f.IncludeFileRecordNumber,
 		compression:          f.Compression,
 		acquireFSLock:        f.AcquireFSLock,
@@ -61,6 +61,7 @@ func testFactory(t *testing.T, opts ...testFactoryOpt) (*Factory, *emittest.Sink
 		FlushTimeout:      cfg.flushPeriod,
 		EmitFunc:          sink.Callback,
 		Attributes:        cfg.attributes,
+		Compression:       cfg.compression,
 	}, sink
 }
 
@@ -77,6 +78,7 @@ type testFactoryCfg struct {
 	flushPeriod       time.Duration
 	sinkChanSize      int
 	attributes        attrs.Resolver
+	compression       string
 }
 
 func withFingerprintSize(size int) testFactoryOpt {
@@ -115,6 +117,12 @@ func withSinkChanSize(n int) testFactoryOpt {
 	}
 }
 
+func withCompression(compression string) testFactoryOpt {
+	return func(c *testFactoryCfg) {
+		c.compression = compression
+	}
+}
+
 func fromEnd() testFactoryOpt {
 	return func(c *testFactoryCfg) {
 		c.fromBeginning = false
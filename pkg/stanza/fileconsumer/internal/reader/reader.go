@@ -5,7 +5,6 @@ package reader // import "github.com/open-telemetry/opentelemetry-collector-cont
 
 import (
 	"bufio"
-	"compress/gzip"
 	"context"
 	"errors"
 	"io"
@@ -21,6 +20,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/header"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/scanner"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/flush"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 )
 
 type Metadata struct {
@@ -30,6 +30,8 @@ type Metadata struct {
 	FileAttributes  map[string]any
 	HeaderFinalized bool
 	FlushState      *flush.State
+	// DecompressedOffset is the position in the decompressed content of the compressed streams starting at Offset
+	DecompressedOffset int64 `json:",omitempty"`
 }
 
 // Reader manages a single file
@@ -48,12 +50,80 @@ type Reader struct {
 	headerReader           *header.Reader
 	emitFunc               emit.Callback
 	deleteAtEOF            bool
+	acknowledgeEOF         bool
+	eofAck                 *helper.Acknowledgement
 	needsUpdateFingerprint bool
 	includeFileRecordNum   bool
 	compression            string
 	acquireFSLock          bool
 }
 
+// openCompressed sets up the reader to read the decompressed content of the file from the offset. It reports
+// false if there's nothing to read. The returned function must be called once the content is read, to update
+// the offset.
+//
+// The offset of a compressed file is the position of the first compressed stream that wasn't completely
+// read, and DecompressedOffset is the position of the next token in the decompressed content of the streams
+// starting at the offset. The offset is only moved past the streams once all of their content is emitted, so
+// that a stream which is still being written, or which ends with an incomplete token, is read again from its
+// beginning on the next read, skipping the content that was already emitted.
+func (r *Reader) openCompressed(compression string) (func(), bool) {
+	// We need to create a decompressor each time ReadToEnd is called because the underlying
+	// SectionReader can only read a fixed window (from previous offset to EOF).
+	info, err := r.file.Stat()
+	if err != nil {
+		r.set.Logger.Error("failed to stat", zap.Error(err))
+		return nil, false
+	}
+	currentEOF := info.Size()
+	if r.Offset >= currentEOF {
+		return nil, false
+	}
+
+	decompressor, err := newDecompressor(compression, io.NewSectionReader(r.file, r.Offset, currentEOF-r.Offset))
+	if err != nil {
+		// the header of the stream may still be written
+		if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			r.set.Logger.Error("failed to create decompressor", zap.String("compression", compression), zap.Error(err))
+		}
+		return nil, false
+	}
+	decompressed := &decompressedReader{ReadCloser: decompressor}
+
+	// Skip the content that was already emitted
+	if _, err = io.CopyN(io.Discard, decompressed, r.DecompressedOffset); err != nil {
+		r.closeDecompressor(decompressed)
+		return nil, false
+	}
+	r.reader = decompressed
+
+	// Tokens are tracked by their position in the decompressed content while reading
+	compressedOffset := r.Offset
+	r.Offset = r.DecompressedOffset
+	return func() {
+		r.closeDecompressor(decompressed)
+		switch {
+		case decompressed.err != nil:
+			r.set.Logger.Error("failed to decompress, skipping to the end of the file",
+				zap.String("compression", compression), zap.Error(decompressed.err))
+			r.Offset = currentEOF
+			r.DecompressedOffset = 0
+		case decompressed.eof && r.Offset == decompressed.size:
+			r.Offset = currentEOF
+			r.DecompressedOffset = 0
+		default:
+			r.DecompressedOffset = r.Offset
+			r.Offset = compressedOffset
+		}
+	}, true
+}
+
+func (r *Reader) closeDecompressor(decompressed *decompressedReader) {
+	if err := decompressed.Close(); err != nil {
+		r.set.Logger.Debug("problem closing decompressor", zap.Error(err))
+	}
+}
+
 // ReadToEnd will read until the end of the file
 func (r *Reader) ReadToEnd(ctx context.Context) {
 	if r.acquireFSLock {
@@ -63,40 +133,32 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 		defer r.unlockFile()
 	}
 
-	switch r.compression {
-	case "gzip":
-		// We need to create a gzip reader each time ReadToEnd is called because the underlying
-		// SectionReader can only read a fixed window (from previous offset to EOF).
-		info, err := r.file.Stat()
+	compression := r.compression
+	if compression == CompressionAuto {
+		detected, ok, err := detectCompression(r.file)
 		if err != nil {
-			r.set.Logger.Error("failed to stat", zap.Error(err))
+			r.set.Logger.Error("failed to detect compression", zap.Error(err))
 			return
 		}
-		currentEOF := info.Size()
-
-		// use a gzip Reader with an underlying SectionReader to pick up at the last
-		// offset of a gzip compressed file
-		gzipReader, err := gzip.NewReader(io.NewSectionReader(r.file, r.Offset, currentEOF))
-		if err != nil {
-			if !errors.Is(err, io.EOF) {
-				r.set.Logger.Error("failed to create gzip reader", zap.Error(err))
-			}
+		if !ok {
+			// Too few bytes were written to tell whether the file is compressed
 			return
-		} else {
-			r.reader = gzipReader
 		}
-		// Offset tracking in an uncompressed file is based on the length of emitted tokens, but in this case
-		// we need to set the offset to the end of the file.
-		defer func() {
-			r.Offset = currentEOF
-		}()
-	default:
-		r.reader = r.file
+		compression = detected
 	}
 
-	if _, err := r.file.Seek(r.Offset, 0); err != nil {
-		r.set.Logger.Error("failed to seek", zap.Error(err))
-		return
+	if compression == "" {
+		r.reader = r.file
+		if _, err := r.file.Seek(r.Offset, 0); err != nil {
+			r.set.Logger.Error("failed to seek", zap.Error(err))
+			return
+		}
+	} else {
+		done, ok := r.openCompressed(compression)
+		if !ok {
+			return
+		}
+		defer done()
 	}
 
 	defer func() {
@@ -105,13 +167,30 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 		}
 	}()
 
+	// Track the tokens emitted until the end of the file is reached
+	r.eofAck = nil
+	var ack *helper.Acknowledgement
+	if r.acknowledgeEOF {
+		ack = helper.NewAcknowledgement()
+		ctx = helper.ContextWithAcknowledgement(ctx, ack)
+	}
+
 	if r.headerReader != nil {
 		if r.readHeader(ctx) {
 			return
 		}
 	}
 
-	r.readContents(ctx)
+	if r.readContents(ctx) && ack != nil {
+		ack.Seal()
+		r.eofAck = ack
+	}
+}
+
+// EOFAcknowledgement returns the acknowledgement of the tokens emitted by the last call to ReadToEnd if the end of
+// the file was reached, or nil otherwise.
+func (r *Reader) EOFAcknowledgement() *helper.Acknowledgement {
+	return r.eofAck
 }
 
 func (r *Reader) readHeader(ctx context.Context) (doneReadingFile bool) {
@@ -175,7 +254,8 @@ func (r *Reader) readHeader(ctx context.Context) (doneReadingFile bool) {
 	return false
 }
 
-func (r *Reader) readContents(ctx context.Context) {
+// readContents reads the tokens of the file, reporting whether the end of the file was reached.
+func (r *Reader) readContents(ctx context.Context) bool {
 	// Create the scanner to read the contents of the file.
 	s := scanner.New(r, r.maxLogSize, r.initialBufferSize, r.Offset, r.contentSplitFunc)
 
@@ -183,7 +263,7 @@ func (r *Reader) readContents(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return false
 		default:
 		}
 
@@ -191,10 +271,12 @@ func (r *Reader) readContents(ctx context.Context) {
 		if !ok {
 			if err := s.Error(); err != nil {
 				r.set.Logger.Error("failed during scan", zap.Error(err))
-			} else if r.deleteAtEOF {
+				return false
+			}
+			if r.deleteAtEOF {
 				r.delete()
 			}
-			return
+			return true
 		}
 
 		token, err := r.decoder.Decode(s.Bytes())
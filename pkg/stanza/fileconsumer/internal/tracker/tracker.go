@@ -6,6 +6,7 @@ package tracker // import "github.com/open-telemetry/opentelemetry-collector-con
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/zap"
@@ -23,6 +24,7 @@ type Tracker interface {
 	GetCurrentFile(fp *fingerprint.Fingerprint) *reader.Reader
 	GetOpenFile(fp *fingerprint.Fingerprint) *reader.Reader
 	GetClosedFile(fp *fingerprint.Fingerprint) *reader.Metadata
+	FindFiles(fps []*fingerprint.Fingerprint) []*reader.Metadata
 	GetMetadata() []*reader.Metadata
 	LoadMetadata(metadata []*reader.Metadata)
 	CurrentPollFiles() []*reader.Reader
@@ -33,6 +35,12 @@ type Tracker interface {
 	TotalReaders() int
 }
 
+const (
+	archiveKeyPrefix         = "knownFiles"
+	archiveIndexKey          = "knownFilesArchiveIndex"
+	archivePollsToArchiveKey = "knownFilesPollsToArchive"
+)
+
 // fileTracker tracks known offsets for files that are being consumed by the manager.
 type fileTracker struct {
 	set component.TelemetrySettings
@@ -57,7 +65,7 @@ func NewFileTracker(set component.TelemetrySettings, maxBatchFiles int, pollsToA
 		knownFiles[i] = fileset.New[*reader.Metadata](maxBatchFiles)
 	}
 	set.Logger = set.Logger.With(zap.String("tracker", "fileTracker"))
-	return &fileTracker{
+	t := &fileTracker{
 		set:               set,
 		maxBatchFiles:     maxBatchFiles,
 		currentPollFiles:  fileset.New[*reader.Reader](maxBatchFiles),
@@ -67,6 +75,10 @@ func NewFileTracker(set component.TelemetrySettings, maxBatchFiles int, pollsToA
 		persister:         persister,
 		archiveIndex:      0,
 	}
+	if t.archiveEnabled() {
+		t.restoreArchiveIndex(context.Background())
+	}
+	return t
 }
 
 func (t *fileTracker) Add(reader *reader.Reader) {
@@ -91,6 +103,52 @@ func (t *fileTracker) GetClosedFile(fp *fingerprint.Fingerprint) *reader.Metadat
 	return nil
 }
 
+// FindFiles looks up the fingerprints in the archive, from the most recently archived poll to the
+// oldest one. The returned slice has the same length as fps, with the metadata of the archived file
+// matching each fingerprint, or nil if there's none. Matched files are removed from the archive,
+// as they are tracked again once their reader is created.
+func (t *fileTracker) FindFiles(fps []*fingerprint.Fingerprint) []*reader.Metadata {
+	matchedMetadata := make([]*reader.Metadata, len(fps))
+	if !t.archiveEnabled() || len(fps) == 0 {
+		return matchedMetadata
+	}
+
+	// each archived poll is read and written at most once, and we stop as soon as all fingerprints are matched
+	numMatched := 0
+	index := t.archiveIndex
+	for i := 0; i < t.pollsToArchive && numMatched < len(fps); i++ {
+		index = (index - 1 + t.pollsToArchive) % t.pollsToArchive
+
+		archived, err := t.readArchive(index)
+		if err != nil {
+			t.set.Logger.Error("error faced while reading the archive", zap.Int("index", index), zap.Error(err))
+			continue
+		}
+		if archived.Len() == 0 {
+			continue
+		}
+
+		modified := false
+		for j, fp := range fps {
+			if matchedMetadata[j] != nil {
+				continue
+			}
+			if md := archived.Match(fp, fileset.StartsWith); md != nil {
+				matchedMetadata[j] = md
+				modified = true
+				numMatched++
+			}
+		}
+		if !modified {
+			continue
+		}
+		if err = t.writeArchive(index, archived); err != nil {
+			t.set.Logger.Error("error faced while saving to the archive", zap.Int("index", index), zap.Error(err))
+		}
+	}
+	return matchedMetadata
+}
+
 func (t *fileTracker) GetMetadata() []*reader.Metadata {
 	// return all known metadata for checkpoining
 	allCheckpoints := make([]*reader.Metadata, 0, t.TotalReaders())
@@ -130,7 +188,9 @@ func (t *fileTracker) EndPoll() {
 	// t.knownFiles[0] -> t.knownFiles[1] -> t.knownFiles[2]
 
 	// Instead of throwing it away, archive it.
-	t.archive(t.knownFiles[2])
+	if t.archiveEnabled() {
+		t.archive(t.knownFiles[2])
+	}
 	copy(t.knownFiles[1:], t.knownFiles)
 	t.knownFiles[0] = fileset.New[*reader.Metadata](t.maxBatchFiles)
 }
@@ -161,14 +221,86 @@ func (t *fileTracker) archive(metadata *fileset.Fileset[*reader.Metadata]) {
 	//                   start
 	//                   index
 
-	if t.pollsToArchive <= 0 || t.persister == nil {
-		return
-	}
-	key := fmt.Sprintf("knownFiles%d", t.archiveIndex)
-	if err := checkpoint.SaveKey(context.Background(), t.persister, metadata.Get(), key); err != nil {
+	if err := t.writeArchive(t.archiveIndex, metadata); err != nil {
 		t.set.Logger.Error("error faced while saving to the archive", zap.Error(err))
 	}
 	t.archiveIndex = (t.archiveIndex + 1) % t.pollsToArchive // increment the index
+	if err := t.persister.Set(context.Background(), archiveIndexKey, encodeInt(t.archiveIndex)); err != nil {
+		t.set.Logger.Error("error faced while saving the archive index", zap.Error(err))
+	}
+}
+
+func (t *fileTracker) archiveEnabled() bool {
+	return t.pollsToArchive > 0 && t.persister != nil
+}
+
+// restoreArchiveIndex restores the position of the ring buffer from the storage, so that the archive
+// written before a restart is looked up and overwritten in the right order.
+func (t *fileTracker) restoreArchiveIndex(ctx context.Context) {
+	index, err := t.readInt(ctx, archiveIndexKey)
+	if err != nil {
+		t.set.Logger.Error("error faced while reading the archive index, starting from 0", zap.Error(err))
+		index = 0
+	}
+	previousPollsToArchive, err := t.readInt(ctx, archivePollsToArchiveKey)
+	if err != nil {
+		t.set.Logger.Error("error faced while reading the archive size", zap.Error(err))
+		previousPollsToArchive = 0
+	}
+
+	if previousPollsToArchive > t.pollsToArchive {
+		// the archive has shrunk: the polls that don't fit anymore are discarded
+		t.set.Logger.Warn("polls_to_archive has decreased, discarding the oldest archived polls",
+			zap.Int("previous", previousPollsToArchive), zap.Int("current", t.pollsToArchive))
+		for i := t.pollsToArchive; i < previousPollsToArchive; i++ {
+			if err = t.persister.Delete(ctx, archiveKey(i)); err != nil {
+				t.set.Logger.Error("error faced while deleting from the archive", zap.Int("index", i), zap.Error(err))
+			}
+		}
+	}
+	if index < 0 || index >= t.pollsToArchive {
+		index = 0
+	}
+	t.archiveIndex = index
+
+	if err = t.persister.Set(ctx, archivePollsToArchiveKey, encodeInt(t.pollsToArchive)); err != nil {
+		t.set.Logger.Error("error faced while saving the archive size", zap.Error(err))
+	}
+}
+
+func (t *fileTracker) readArchive(index int) (*fileset.Fileset[*reader.Metadata], error) {
+	metadata, err := checkpoint.LoadKey(context.Background(), t.persister, archiveKey(index))
+	if err != nil {
+		return nil, err
+	}
+	archived := fileset.New[*reader.Metadata](len(metadata))
+	archived.Add(metadata...)
+	return archived, nil
+}
+
+func (t *fileTracker) writeArchive(index int, metadata *fileset.Fileset[*reader.Metadata]) error {
+	return checkpoint.SaveKey(context.Background(), t.persister, metadata.Get(), archiveKey(index))
+}
+
+// readInt reads an integer from the storage, returning 0 if the key isn't set.
+func (t *fileTracker) readInt(ctx context.Context, key string) (int, error) {
+	encoded, err := t.persister.Get(ctx, key)
+	if err != nil || encoded == nil {
+		return 0, err
+	}
+	val, err := strconv.Atoi(string(encoded))
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+	return val, nil
+}
+
+func archiveKey(index int) string {
+	return fmt.Sprintf("%s%d", archiveKeyPrefix, index)
+}
+
+func encodeInt(val int) []byte {
+	return []byte(strconv.Itoa(val))
 }
 
 // noStateTracker only tracks the current polled files. Once the poll is
@@ -214,6 +346,10 @@ func (t *noStateTracker) GetOpenFile(_ *fingerprint.Fingerprint) *reader.Reader
 
 func (t *noStateTracker) GetClosedFile(_ *fingerprint.Fingerprint) *reader.Metadata { return nil }
 
+func (t *noStateTracker) FindFiles(fps []*fingerprint.Fingerprint) []*reader.Metadata {
+	return make([]*reader.Metadata, len(fps))
+}
+
 func (t *noStateTracker) GetMetadata() []*reader.Metadata { return nil }
 
 func (t *noStateTracker) LoadMetadata(_ []*reader.Metadata) {}
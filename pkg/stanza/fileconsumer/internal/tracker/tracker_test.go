@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fileset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newMetadata(content string, offset int64) *reader.Metadata {
+	return &reader.Metadata{
+		Fingerprint:    fingerprint.New([]byte(content)),
+		Offset:         offset,
+		FileAttributes: map[string]any{},
+	}
+}
+
+func archivePoll(t *fileTracker, metadata ...*reader.Metadata) {
+	fs := fileset.New[*reader.Metadata](len(metadata))
+	fs.Add(metadata...)
+	t.archive(fs)
+}
+
+func fingerprints(contents ...string) []*fingerprint.Fingerprint {
+	fps := make([]*fingerprint.Fingerprint, 0, len(contents))
+	for _, content := range contents {
+		fps = append(fps, fingerprint.New([]byte(content)))
+	}
+	return fps
+}
+
+func offsets(metadata []*reader.Metadata) []int64 {
+	result := make([]int64, 0, len(metadata))
+	for _, md := range metadata {
+		if md == nil {
+			result = append(result, -1)
+		} else {
+			result = append(result, md.Offset)
+		}
+	}
+	return result
+}
+
+func newTestFileTracker(pollsToArchive int, persister operator.Persister) *fileTracker {
+	return NewFileTracker(componenttest.NewNopTelemetrySettings(), 10, pollsToArchive, persister).(*fileTracker)
+}
+
+func TestFindFiles(t *testing.T) {
+	tracker := newTestFileTracker(3, testutil.NewUnscopedMockPersister())
+
+	archivePoll(tracker, newMetadata("file-a", 1), newMetadata("file-b", 2))
+	archivePoll(tracker, newMetadata("file-a", 3))
+	archivePoll(tracker, newMetadata("file-c", 4))
+
+	// the most recently archived metadata is found first, and files are found by the beginning of their content
+	found := tracker.FindFiles(fingerprints("file-a with more content", "file-b", "file-d"))
+	assert.Equal(t, []int64{3, 2, -1}, offsets(found))
+
+	// found files are removed from the archive
+	found = tracker.FindFiles(fingerprints("file-a", "file-b", "file-c"))
+	assert.Equal(t, []int64{1, -1, 4}, offsets(found))
+
+	// the oldest poll is overwritten when the archive is full
+	archivePoll(tracker, newMetadata("file-e", 5))
+	found = tracker.FindFiles(fingerprints("file-a", "file-e"))
+	assert.Equal(t, []int64{-1, 5}, offsets(found))
+}
+
+func TestFindFilesDisabled(t *testing.T) {
+	for name, tracker := range map[string]Tracker{
+		"no polls to archive": newTestFileTracker(0, testutil.NewUnscopedMockPersister()),
+		"no persister":        newTestFileTracker(3, nil),
+		"no state":            NewNoStateTracker(componenttest.NewNopTelemetrySettings(), 10),
+	} {
+		t.Run(name, func(t *testing.T) {
+			tracker.EndPoll()
+			assert.Equal(t, []int64{-1, -1}, offsets(tracker.FindFiles(fingerprints("file-a", "file-b"))))
+		})
+	}
+}
+
+func TestArchiveRestored(t *testing.T) {
+	persister := testutil.NewUnscopedMockPersister()
+	tracker := newTestFileTracker(5, persister)
+	archivePoll(tracker, newMetadata("file-a", 1))
+	archivePoll(tracker, newMetadata("file-b", 2))
+
+	// the archive is found after a restart, and new polls don't overwrite it
+	tracker = newTestFileTracker(5, persister)
+	assert.Equal(t, 2, tracker.archiveIndex)
+	archivePoll(tracker, newMetadata("file-c", 3))
+	found := tracker.FindFiles(fingerprints("file-a", "file-b", "file-c"))
+	assert.Equal(t, []int64{1, 2, 3}, offsets(found))
+}
+
+func TestArchiveShrunk(t *testing.T) {
+	persister := testutil.NewUnscopedMockPersister()
+	tracker := newTestFileTracker(5, persister)
+	for i := 0; i < 4; i++ {
+		archivePoll(tracker, newMetadata(string(rune('a'+i))+"-file", int64(i)))
+	}
+
+	// the polls that don't fit in the archive anymore are discarded
+	tracker = newTestFileTracker(2, persister)
+	assert.Equal(t, 0, tracker.archiveIndex)
+	for i := 2; i < 5; i++ {
+		data, err := persister.Get(context.Background(), archiveKey(i))
+		require.NoError(t, err)
+		assert.Nil(t, data)
+	}
+	found := tracker.FindFiles(fingerprints("a-file", "b-file", "c-file", "d-file"))
+	assert.Equal(t, []int64{0, 1, -1, -1}, offsets(found))
+}
@@ -6,7 +6,10 @@ package finder // import "github.com/open-telemetry/opentelemetry-collector-cont
 import (
 	"errors"
 	"fmt"
+	"io/fs"
+	"path/filepath"
 	"slices"
+	"strings"
 
 	"github.com/bmatcuk/doublestar/v4"
 	"golang.org/x/exp/maps"
@@ -51,3 +54,62 @@ func FindFiles(includes []string, excludes []string) ([]string, error) {
 	slices.Sort(keys)
 	return keys, errs
 }
+
+// FindDirs gets the list of existing directories in which files matching the glob patterns may be
+// created, including the directories in which such directories may be created.
+func FindDirs(includes []string) ([]string, error) {
+	var errs error
+
+	allSet := make(map[string]struct{})
+	for _, include := range includes {
+		base, pattern := doublestar.SplitPattern(filepath.ToSlash(include))
+		segments := strings.Split(pattern, "/")
+		root := filepath.FromSlash(base)
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if path == root {
+					return err
+				}
+				// keep looking for directories in the rest of the tree
+				return nil
+			}
+			if !d.IsDir() {
+				return nil
+			}
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			if rel != "." && !dirMatches(segments, strings.Split(filepath.ToSlash(rel), "/")) {
+				return filepath.SkipDir
+			}
+			allSet[path] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("find directories with '%s' pattern: %w", include, err))
+		}
+	}
+
+	keys := maps.Keys(allSet)
+	slices.Sort(keys)
+	return keys, errs
+}
+
+// dirMatches reports whether the directory, given as the segments of its path relative to the base
+// of the pattern, may contain files matching the pattern segments.
+func dirMatches(patternSegments, dirSegments []string) bool {
+	for i, dirSegment := range dirSegments {
+		// the last segment of the pattern only matches files
+		if i >= len(patternSegments)-1 {
+			return false
+		}
+		if patternSegments[i] == "**" {
+			return true
+		}
+		if ok, _ := doublestar.Match(patternSegments[i], dirSegment); !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -194,6 +194,69 @@ func TestFindFiles(t *testing.T) {
 	}
 }
 
+func TestFindDirs(t *testing.T) {
+	cases := []struct {
+		name     string
+		dirs     []string
+		include  []string
+		expected []string
+	}{
+		{
+			name:     "BaseOnly",
+			dirs:     []string{"a", "b"},
+			include:  []string{"*.log"},
+			expected: []string{"."},
+		},
+		{
+			name:     "SingleLevel",
+			dirs:     []string{"a", "b", filepath.Join("a", "c")},
+			include:  []string{filepath.Join("a*", "*.log")},
+			expected: []string{".", "a"},
+		},
+		{
+			name:     "FixedBase",
+			dirs:     []string{"a", "b", filepath.Join("b", "c"), filepath.Join("b", "d")},
+			include:  []string{filepath.Join("b", "c", "*.log")},
+			expected: []string{filepath.Join("b", "c")},
+		},
+		{
+			name:     "DoubleStar",
+			dirs:     []string{"a", "b", filepath.Join("a", "c"), filepath.Join("a", "c", "d")},
+			include:  []string{filepath.Join("a", "**", "*.log")},
+			expected: []string{"a", filepath.Join("a", "c"), filepath.Join("a", "c", "d")},
+		},
+		{
+			name:     "MultipleIncludes",
+			dirs:     []string{"a", "b", "c"},
+			include:  []string{filepath.Join("a", "*.log"), filepath.Join("b", "*.log"), filepath.Join("a", "*.txt")},
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cwd, err := os.Getwd()
+			require.NoError(t, err)
+			require.NoError(t, os.Chdir(t.TempDir()))
+			defer func() {
+				require.NoError(t, os.Chdir(cwd))
+			}()
+			for _, d := range tc.dirs {
+				require.NoError(t, os.MkdirAll(d, 0o700))
+			}
+			dirs, err := FindDirs(tc.include)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, dirs)
+		})
+	}
+}
+
+func TestFindDirsMissingBase(t *testing.T) {
+	dirs, err := FindDirs([]string{filepath.Join(t.TempDir(), "missing", "*.log")})
+	assert.Error(t, err)
+	assert.Empty(t, dirs)
+}
+
 func TestFindFilesWithIOErrors(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("permissions test not valid on windows")
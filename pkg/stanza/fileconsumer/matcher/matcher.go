@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"go.opentelemetry.io/collector/featuregate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher/internal/filter"
@@ -180,3 +181,36 @@ func (m Matcher) MatchFiles() ([]string, error) {
 	}
 	return result, errs
 }
+
+// MatchPath reports whether the path matches the include and exclude patterns. The other criteria,
+// which are evaluated over all the matched files, aren't taken into account.
+func (m Matcher) MatchPath(path string) bool {
+	included := false
+	for _, include := range m.include {
+		if ok, _ := doublestar.PathMatch(include, path); ok {
+			included = true
+			break
+		}
+	}
+	if !included {
+		return false
+	}
+	for _, exclude := range m.exclude {
+		if ok, _ := doublestar.PathMatch(exclude, path); ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Filtered reports whether the matched files are filtered by criteria that are evaluated over all
+// the matched files, such as the ordering criteria, or their modification time.
+func (m Matcher) Filtered() bool {
+	return len(m.filterOpts) > 0
+}
+
+// MatchDirs gets the list of existing directories in which files matching the include patterns
+// may be created, directly or in subdirectories that may be created.
+func (m Matcher) MatchDirs() ([]string, error) {
+	return finder.FindDirs(m.include)
+}
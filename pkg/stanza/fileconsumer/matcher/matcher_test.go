@@ -791,6 +791,33 @@ func TestMatcher(t *testing.T) {
 	}
 }
 
+func TestMatchPath(t *testing.T) {
+	t.Parallel()
+	matcher, err := New(Criteria{
+		Include: []string{filepath.Join("a", "*.log"), filepath.Join("b", "**", "*.log")},
+		Exclude: []string{filepath.Join("**", "*.1.log")},
+	})
+	require.NoError(t, err)
+	assert.False(t, matcher.Filtered())
+
+	assert.True(t, matcher.MatchPath(filepath.Join("a", "a.log")))
+	assert.True(t, matcher.MatchPath(filepath.Join("b", "c", "d", "b.log")))
+	assert.False(t, matcher.MatchPath(filepath.Join("a", "a.1.log")))
+	assert.False(t, matcher.MatchPath(filepath.Join("a", "c", "a.log")))
+	assert.False(t, matcher.MatchPath(filepath.Join("c", "c.log")))
+	assert.False(t, matcher.MatchPath(filepath.Join("a", "a.txt")))
+
+	filtered, err := New(Criteria{
+		Include: []string{"*.log"},
+		OrderingCriteria: OrderingCriteria{
+			Regex:  `(?P<value>\d+)\.log`,
+			SortBy: []Sort{{SortType: sortTypeNumeric, RegexKey: "value"}},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, filtered.Filtered())
+}
+
 func enableSortByMTimeFeature(t *testing.T) {
 	if !mtimeSortTypeFeatureGate.IsEnabled() {
 		require.NoError(t, featuregate.GlobalRegistry().Set(mtimeSortTypeFeatureGate.ID(), true))
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	postReadActionMove   = "move"
+	postReadActionRename = "rename"
+	postReadActionDelete = "delete"
+)
+
+// PostReadConfig configures the action taken on the files once they are read to the end, and all the entries
+// read from them were acknowledged downstream.
+type PostReadConfig struct {
+	// Action is one of "move", "rename" or "delete".
+	Action string `mapstructure:"action"`
+	// MoveTo is the directory the files are moved to by the "move" action.
+	MoveTo string `mapstructure:"move_to"`
+	// Suffix is appended to the name of the files by the "rename" action.
+	Suffix string `mapstructure:"suffix"`
+	// Retention is how long the files are kept once their entries are acknowledged, before the action is taken.
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+func (c PostReadConfig) validate() error {
+	switch c.Action {
+	case postReadActionMove:
+		if c.MoveTo == "" {
+			return errors.New("'move_to' must be specified for the 'move' action")
+		}
+	case postReadActionRename:
+		if c.Suffix == "" {
+			return errors.New("'suffix' must be specified for the 'rename' action")
+		}
+	case postReadActionDelete:
+		if !allowFileDeletion.IsEnabled() {
+			return fmt.Errorf("the 'delete' action requires feature gate '%s'", allowFileDeletion.ID())
+		}
+	default:
+		return fmt.Errorf("invalid 'action' %q, must be one of %q, %q or %q",
+			c.Action, postReadActionMove, postReadActionRename, postReadActionDelete)
+	}
+	if c.Retention < 0 {
+		return errors.New("'retention' must not be negative")
+	}
+	return nil
+}
+
+// pendingAction tracks the acknowledgements of the entries read from a file until the action is taken.
+type pendingAction struct {
+	acks []*helper.Acknowledgement
+	// time at which all the entries were acknowledged, zero while some entries are pending
+	ackedAt time.Time
+	// whether the action must not be taken, as entries failed to be consumed or the action failed
+	failed bool
+}
+
+// postReadActions takes the configured action on the files which were read to the end, once the entries
+// read from them were acknowledged and the retention period is over.
+type postReadActions struct {
+	cfg     PostReadConfig
+	logger  *zap.Logger
+	pending map[string]*pendingAction
+	// absolute path of the directory the files are moved to
+	moveTo string
+}
+
+func newPostReadActions(cfg PostReadConfig, logger *zap.Logger) *postReadActions {
+	p := &postReadActions{
+		cfg:     cfg,
+		logger:  logger,
+		pending: make(map[string]*pendingAction),
+	}
+	if cfg.Action == postReadActionMove {
+		p.moveTo = absPath(cfg.MoveTo)
+	}
+	return p
+}
+
+// add records that the file was read to the end, with the acknowledgement of the entries emitted by the read.
+func (p *postReadActions) add(path string, ack *helper.Acknowledgement) {
+	if p.isActedUpon(path) {
+		return
+	}
+	action, ok := p.pending[path]
+	if !ok {
+		p.pending[path] = &pendingAction{acks: []*helper.Acknowledgement{ack}}
+		return
+	}
+	if action.failed {
+		return
+	}
+	select {
+	case <-ack.Done():
+		if err := ack.Err(); err != nil {
+			action.acks = append(action.acks, ack)
+		}
+		// nothing was read since the previous read, which is already tracked
+	default:
+		action.acks = append(action.acks, ack)
+		action.ackedAt = time.Time{}
+	}
+}
+
+// process takes the action on the files whose entries were acknowledged for the retention period.
+func (p *postReadActions) process(now time.Time) {
+	for path, action := range p.pending {
+		if action.failed {
+			// forget the file once it's removed, so that a new file with the same path is handled
+			if _, err := os.Lstat(path); errors.Is(err, os.ErrNotExist) {
+				delete(p.pending, path)
+			}
+			continue
+		}
+
+		acks := action.acks[:0]
+		for _, ack := range action.acks {
+			select {
+			case <-ack.Done():
+				if err := ack.Err(); err != nil {
+					p.logger.Error("Entries of the file were not consumed, keeping the file",
+						zap.String("path", path), zap.String("action", p.cfg.Action), zap.Error(err))
+					action.failed = true
+				}
+			default:
+				acks = append(acks, ack)
+			}
+		}
+		action.acks = acks
+		if action.failed || len(action.acks) > 0 {
+			continue
+		}
+
+		if action.ackedAt.IsZero() {
+			action.ackedAt = now
+		}
+		if now.Sub(action.ackedAt) < p.cfg.Retention {
+			continue
+		}
+
+		if _, err := os.Lstat(path); errors.Is(err, os.ErrNotExist) {
+			p.logger.Debug("File was removed before the post-read action", zap.String("path", path))
+			delete(p.pending, path)
+			continue
+		}
+		if err := p.takeAction(path); err != nil {
+			p.logger.Error("Failed to take the post-read action", zap.String("path", path),
+				zap.String("action", p.cfg.Action), zap.Error(err))
+			action.failed = true
+			continue
+		}
+		delete(p.pending, path)
+	}
+}
+
+// isActedUpon returns whether the file is already the result of the action, when it's matched by the include
+// patterns as well, so that it's not moved or renamed over and over again.
+func (p *postReadActions) isActedUpon(path string) bool {
+	switch p.cfg.Action {
+	case postReadActionMove:
+		rel, err := filepath.Rel(p.moveTo, absPath(path))
+		return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+	case postReadActionRename:
+		return strings.HasSuffix(path, p.cfg.Suffix)
+	}
+	return false
+}
+
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+func (p *postReadActions) takeAction(path string) error {
+	var target string
+	switch p.cfg.Action {
+	case postReadActionMove:
+		target = filepath.Join(p.cfg.MoveTo, filepath.Base(path))
+	case postReadActionRename:
+		target = path + p.cfg.Suffix
+	default:
+		p.logger.Debug("Deleting file", zap.String("path", path))
+		return os.Remove(path)
+	}
+
+	// don't overwrite a file which was previously moved or renamed
+	if _, err := os.Lstat(target); err == nil {
+		return fmt.Errorf("%s already exists", target)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	p.logger.Debug("Moving file", zap.String("path", path), zap.String("target", target))
+	return os.Rename(path, target)
+}
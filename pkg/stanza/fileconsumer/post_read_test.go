@@ -0,0 +1,251 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/emit"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/filetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/tracker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func sealedAck() *helper.Acknowledgement {
+	ack := helper.NewAcknowledgement()
+	ack.Seal()
+	return ack
+}
+
+// failedAck returns an acknowledgement of an entry which failed to be consumed.
+func failedAck(t *testing.T) *helper.Acknowledgement {
+	emitter := helper.NewLogEmitterWithError(componenttest.NewNopTelemetrySettings(),
+		func(context.Context, []*entry.Entry) error {
+			return errors.New("consume failed")
+		}, helper.WithMaxBatchSize(1))
+	ack := helper.NewAcknowledgement()
+	require.NoError(t, emitter.Process(helper.ContextWithAcknowledgement(context.Background(), ack), entry.New()))
+	ack.Seal()
+	return ack
+}
+
+func writeFile(t *testing.T, path string) {
+	require.NoError(t, os.WriteFile(path, []byte("testlog\n"), 0o600))
+}
+
+func TestPostReadMove(t *testing.T) {
+	tempDir := t.TempDir()
+	archiveDir := t.TempDir()
+	path := filepath.Join(tempDir, "app.log")
+	writeFile(t, path)
+
+	p := newPostReadActions(PostReadConfig{Action: postReadActionMove, MoveTo: archiveDir}, zap.NewNop())
+
+	// the file is kept while its entries are pending
+	pending := helper.NewAcknowledgement()
+	p.add(path, pending)
+	p.process(time.Now())
+	assert.FileExists(t, path)
+
+	pending.Seal()
+	p.process(time.Now())
+	assert.NoFileExists(t, path)
+	assert.FileExists(t, filepath.Join(archiveDir, "app.log"))
+	assert.Empty(t, p.pending)
+
+	// a file which was previously moved isn't overwritten
+	writeFile(t, path)
+	p.add(path, sealedAck())
+	p.process(time.Now())
+	assert.FileExists(t, path)
+	assert.True(t, p.pending[path].failed)
+
+	// the file is forgotten once removed
+	require.NoError(t, os.Remove(path))
+	p.process(time.Now())
+	assert.Empty(t, p.pending)
+}
+
+func TestPostReadRenameRetention(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	writeFile(t, path)
+
+	p := newPostReadActions(PostReadConfig{Action: postReadActionRename, Suffix: ".done", Retention: time.Minute}, zap.NewNop())
+	now := time.Now()
+	p.add(path, sealedAck())
+	p.process(now)
+	p.process(now.Add(30 * time.Second))
+	assert.FileExists(t, path)
+
+	// the retention starts over when new entries are read
+	p.add(path, helper.NewAcknowledgement())
+	p.process(now.Add(time.Minute))
+	assert.FileExists(t, path)
+	assert.True(t, p.pending[path].ackedAt.IsZero())
+
+	p.pending[path].acks[0].Seal()
+	p.process(now.Add(90 * time.Second))
+	// reads which found nothing new don't reset the retention
+	p.add(path, sealedAck())
+	p.process(now.Add(2 * time.Minute))
+	assert.FileExists(t, path)
+
+	p.process(now.Add(150 * time.Second))
+	assert.NoFileExists(t, path)
+	assert.FileExists(t, path+".done")
+}
+
+func TestPostReadFailedAck(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	writeFile(t, path)
+
+	p := newPostReadActions(PostReadConfig{Action: postReadActionRename, Suffix: ".done"}, zap.NewNop())
+	p.add(path, sealedAck())
+	p.add(path, failedAck(t))
+	p.process(time.Now())
+	assert.FileExists(t, path)
+	assert.NoFileExists(t, path+".done")
+
+	// the file is kept although the following entries are consumed
+	p.add(path, sealedAck())
+	p.process(time.Now())
+	assert.FileExists(t, path)
+}
+
+func TestPostReadDelete(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	writeFile(t, path)
+
+	p := newPostReadActions(PostReadConfig{Action: postReadActionDelete}, zap.NewNop())
+	p.add(path, sealedAck())
+	p.process(time.Now())
+	assert.NoFileExists(t, path)
+	assert.Empty(t, p.pending)
+}
+
+func TestPostReadAfterAcknowledgement(t *testing.T) {
+	tempDir := t.TempDir()
+	archiveDir := t.TempDir()
+
+	consume := make(chan struct{})
+	emitter := helper.NewLogEmitterWithError(componenttest.NewNopTelemetrySettings(),
+		func(context.Context, []*entry.Entry) error {
+			<-consume
+			return nil
+		}, helper.WithFlushInterval(10*time.Millisecond))
+	require.NoError(t, emitter.Start(nil))
+	defer func() {
+		require.NoError(t, emitter.Stop())
+	}()
+	emitFunc := func(ctx context.Context, token emit.Token) error {
+		ent := entry.New()
+		ent.Body = string(token.Body)
+		return emitter.Process(ctx, ent)
+	}
+
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.PostRead = &PostReadConfig{Action: postReadActionMove, MoveTo: archiveDir}
+	set := componenttest.NewNopTelemetrySettings()
+	operator, err := cfg.Build(set, emitFunc)
+	require.NoError(t, err)
+	operator.persister = testutil.NewUnscopedMockPersister()
+	operator.tracker = tracker.NewFileTracker(set, cfg.MaxBatches, cfg.PollsToArchive, operator.persister)
+	defer operator.tracker.ClosePreviousFiles()
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\ntestlog2\n")
+
+	// the file is kept while the entries are being consumed
+	operator.poll(context.Background())
+	operator.poll(context.Background())
+	assert.FileExists(t, temp.Name())
+
+	close(consume)
+	archived := filepath.Join(archiveDir, filepath.Base(temp.Name()))
+	require.Eventually(t, func() bool {
+		operator.poll(context.Background())
+		_, err := os.Stat(archived)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NoFileExists(t, temp.Name())
+}
+
+func TestPostReadSkipsActedUponFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	archiveDir := filepath.Join(tempDir, "archive")
+	require.NoError(t, os.Mkdir(archiveDir, 0o700))
+	moved := filepath.Join(archiveDir, "app.log")
+	writeFile(t, moved)
+
+	p := newPostReadActions(PostReadConfig{Action: postReadActionMove, MoveTo: archiveDir}, zap.NewNop())
+	p.add(moved, sealedAck())
+	p.process(time.Now())
+	assert.FileExists(t, moved)
+	assert.Empty(t, p.pending)
+
+	renamed := filepath.Join(tempDir, "app.log.done")
+	writeFile(t, renamed)
+	p = newPostReadActions(PostReadConfig{Action: postReadActionRename, Suffix: ".done"}, zap.NewNop())
+	p.add(renamed, sealedAck())
+	p.process(time.Now())
+	assert.FileExists(t, renamed)
+	assert.Empty(t, p.pending)
+}
+
+func TestPostReadRenameMatchedByInclude(t *testing.T) {
+	tempDir := t.TempDir()
+
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.PostRead = &PostReadConfig{Action: postReadActionRename, Suffix: ".done"}
+	operator, sink := testManager(t, cfg)
+	operator.persister = testutil.NewUnscopedMockPersister()
+	operator.tracker = tracker.NewFileTracker(componenttest.NewNopTelemetrySettings(), cfg.MaxBatches, cfg.PollsToArchive, operator.persister)
+	defer operator.tracker.ClosePreviousFiles()
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog\n")
+	require.NoError(t, temp.Close())
+
+	renamed := temp.Name() + ".done"
+	require.Eventually(t, func() bool {
+		operator.poll(context.Background())
+		_, err := os.Stat(renamed)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the renamed file is matched by the include pattern, but isn't renamed again
+	for i := 0; i < 3; i++ {
+		operator.poll(context.Background())
+	}
+	assert.FileExists(t, renamed)
+	assert.NoFileExists(t, renamed+".done")
+	sink.ExpectToken(t, []byte("testlog"))
+	sink.ExpectNoCalls(t)
+}
+
+func TestPostReadDeleteFeatureGate(t *testing.T) {
+	cfg := PostReadConfig{Action: postReadActionDelete}
+	require.Error(t, cfg.validate())
+
+	require.NoError(t, featuregate.GlobalRegistry().Set(allowFileDeletion.ID(), true))
+	defer func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(allowFileDeletion.ID(), false))
+	}()
+	require.NoError(t, cfg.validate())
+}
@@ -0,0 +1,258 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+var errWatcherClosed = errors.New("file system watcher closed")
+
+// fileWatcher consumes the matching files when file system events are received for them, instead
+// of polling the filesystem periodically. The directories in which matching files may be created
+// are watched, and the set of watched directories is synchronized on each full poll.
+//
+// Files are read with the same tracker as when polling, so that the fingerprints and offsets of the
+// files are handled in the same way. Only the files for which events were received are consumed,
+// and a full poll is done when the events can't be mapped to specific files, such as when a directory
+// is created, a file is renamed or events are lost. A full poll is also done every resync interval,
+// to catch up with any change that wasn't notified.
+type fileWatcher struct {
+	m   *Manager
+	fsw *fsnotify.Watcher
+
+	// watched directories
+	dirs map[string]struct{}
+
+	// files for which events were received since the last read
+	pending map[string]struct{}
+	// whether a full poll is needed instead of reading the pending files
+	pollPending bool
+	// files which were read, and must be read again once the flush period expires in case they end
+	// with an incomplete token, as no event may be received for them until then
+	unflushed map[string]struct{}
+}
+
+// startWatcher starts watching the file system events in a goroutine. It returns an error if the
+// events can't be watched, in which case the caller is expected to fall back to polling.
+func (m *Manager) startWatcher(ctx context.Context) error {
+	fsw, err := newFSWatcher()
+	if err != nil {
+		return err
+	}
+	w := &fileWatcher{
+		m:         m,
+		fsw:       fsw,
+		dirs:      make(map[string]struct{}),
+		pending:   make(map[string]struct{}),
+		unflushed: make(map[string]struct{}),
+	}
+	if err = w.syncWatches(); err != nil {
+		_ = fsw.Close()
+		return err
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		if err := w.run(ctx); err != nil {
+			m.set.Logger.Warn("Stopped watching file system events, falling back to polling", zap.Error(err))
+			m.runPoller(ctx)
+		}
+	}()
+	return nil
+}
+
+// run handles the file system events until the context is cancelled. It returns an error if the
+// events can no longer be watched.
+func (w *fileWatcher) run(ctx context.Context) error {
+	defer func() {
+		if err := w.fsw.Close(); err != nil {
+			w.m.set.Logger.Debug("problem closing file system watcher", zap.Error(err))
+		}
+	}()
+
+	resyncTicker := time.NewTicker(w.m.resyncInterval)
+	defer resyncTicker.Stop()
+
+	// reads are delayed by the poll interval, so that the events received meanwhile are batched
+	var readC, flushC <-chan time.Time
+	schedule := func() {
+		if readC == nil {
+			readC = time.After(w.m.pollInterval)
+		}
+	}
+
+	// the files which already exist are consumed as when polling
+	w.pollPending = true
+	schedule()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.fsw.Events:
+			if !ok {
+				return errWatcherClosed
+			}
+			if w.handleEvent(event) {
+				schedule()
+			}
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				return errWatcherClosed
+			}
+			if errors.Is(err, fsnotify.ErrEventOverflow) {
+				w.m.set.Logger.Debug("File system events were lost, doing a full poll", zap.Error(err))
+			} else {
+				w.m.set.Logger.Error("Watching file system events", zap.Error(err))
+			}
+			w.pollPending = true
+			schedule()
+		case <-readC:
+			readC = nil
+			if err := w.read(ctx); err != nil {
+				return err
+			}
+			if len(w.unflushed) > 0 && w.m.readerFactory.FlushTimeout > 0 {
+				flushC = time.After(w.m.readerFactory.FlushTimeout)
+			}
+		case <-flushC:
+			flushC = nil
+			w.flush(ctx)
+		case <-resyncTicker.C:
+			w.pollPending = true
+			schedule()
+		}
+	}
+}
+
+// handleEvent records the files to read for the event. It reports whether there's anything to read.
+func (w *fileWatcher) handleEvent(event fsnotify.Event) bool {
+	if _, ok := w.dirs[event.Name]; ok {
+		// a watched directory was removed or renamed, the files it contained may still be matched elsewhere
+		if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+			w.pollPending = true
+			return true
+		}
+		return false
+	}
+
+	if !w.m.fileMatcher.MatchPath(event.Name) {
+		// a directory in which matching files may be created may have been created
+		if event.Has(fsnotify.Create) && isDir(event.Name) {
+			w.pollPending = true
+			return true
+		}
+		return false
+	}
+
+	switch {
+	case event.Has(fsnotify.Rename), w.m.fileMatcher.Filtered():
+		// the file may have been moved to another matching path, or the matched files must be filtered together
+		w.pollPending = true
+	case event.Has(fsnotify.Create), event.Has(fsnotify.Write):
+		w.pending[event.Name] = struct{}{}
+	default:
+		// files which were removed are read to the end as lost files on the next read
+		return false
+	}
+	return true
+}
+
+// read consumes the files for which events were received, or does a full poll if needed.
+func (w *fileWatcher) read(ctx context.Context) error {
+	if w.pollPending {
+		if err := w.syncWatches(); err != nil {
+			return err
+		}
+		w.pollPending = false
+		clear(w.pending)
+		w.m.poll(ctx)
+		w.markUnflushed()
+		return nil
+	}
+
+	paths := maps.Keys(w.pending)
+	slices.Sort(paths)
+	clear(w.pending)
+	w.consume(ctx, paths)
+	return nil
+}
+
+// flush reads the files which were last read once their flush period expired.
+func (w *fileWatcher) flush(ctx context.Context) {
+	paths := maps.Keys(w.unflushed)
+	slices.Sort(paths)
+	w.consume(ctx, paths)
+	// the files were read after their flush period expired, there's nothing left to flush
+	clear(w.unflushed)
+}
+
+// consume consumes the given files in batches. Unlike a full poll, the files known by the tracker
+// aren't aged, as only a subset of the matching files are consumed.
+func (w *fileWatcher) consume(ctx context.Context, paths []string) {
+	for len(paths) > w.m.maxBatchFiles {
+		w.m.consume(ctx, paths[:w.m.maxBatchFiles])
+		paths = paths[w.m.maxBatchFiles:]
+	}
+	w.m.consume(ctx, paths)
+	w.markUnflushed()
+	w.m.saveCheckpoint()
+}
+
+// markUnflushed records the files that were last read, which may end with an incomplete token.
+func (w *fileWatcher) markUnflushed() {
+	for _, r := range w.m.tracker.PreviousPollFiles() {
+		w.unflushed[r.GetFileName()] = struct{}{}
+	}
+}
+
+// syncWatches watches the directories in which matching files may be created, and stops watching
+// the ones that no longer exist or can no longer contain matching files.
+func (w *fileWatcher) syncWatches() error {
+	dirs, err := w.m.fileMatcher.MatchDirs()
+	if err != nil {
+		w.m.set.Logger.Debug("finding directories", zap.Error(err))
+	}
+
+	current := make(map[string]struct{}, len(dirs))
+	for _, dir := range dirs {
+		current[dir] = struct{}{}
+		if _, ok := w.dirs[dir]; ok {
+			continue
+		}
+		if err = checkWatchable(dir); err != nil {
+			return err
+		}
+		if err = w.fsw.Add(dir); err != nil {
+			return fmt.Errorf("watch directory %s: %w", dir, err)
+		}
+		w.dirs[dir] = struct{}{}
+	}
+
+	for dir := range w.dirs {
+		if _, ok := current[dir]; ok {
+			continue
+		}
+		// the watch is removed automatically when the directory is removed
+		_ = w.fsw.Remove(dir)
+		delete(w.dirs, dir)
+	}
+	return nil
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
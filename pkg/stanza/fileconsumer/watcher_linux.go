@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"fmt"
+
+	"github.com/fsnotify/fsnotify"
+	"golang.org/x/sys/unix"
+)
+
+// Magic numbers of the filesystems on which inotify doesn't report the changes made by other hosts.
+var unwatchableFilesystems = map[int64]string{
+	0x6969:     "nfs",
+	0x517b:     "smb",
+	0xfe534d42: "smb2",
+	0xff534d42: "cifs",
+	0x65735546: "fuse",
+	0x564c:     "ncp",
+	0x5346414f: "afs",
+	0x6b414653: "afs",
+	0x47504653: "gpfs",
+	0x00c36400: "ceph",
+	0x01021997: "9p",
+	0x0bd00bd0: "lustre",
+}
+
+func newFSWatcher() (*fsnotify.Watcher, error) {
+	return fsnotify.NewBufferedWatcher(1024)
+}
+
+// checkWatchable returns an error if the directory is on a filesystem that doesn't support inotify.
+func checkWatchable(dir string) error {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(dir, &stat); err != nil {
+		return fmt.Errorf("stat filesystem of %s: %w", dir, err)
+	}
+	if fs, ok := unwatchableFilesystems[int64(stat.Type)]; ok { //nolint:unconvert // the type of Statfs_t.Type depends on the architecture
+		return fmt.Errorf("directory %s is on a %s filesystem, which doesn't support inotify", dir, fs)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package fileconsumer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/filetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func watchConfig(tempDir string) *Config {
+	cfg := NewConfig()
+	cfg.Include = []string{filepath.Join(tempDir, "**", "*.log")}
+	cfg.StartAt = "beginning"
+	cfg.WatchMode = watchModeInotify
+	cfg.PollInterval = 10 * time.Millisecond
+	// only rely on the events
+	cfg.ResyncInterval = time.Hour
+	return cfg
+}
+
+func TestWatchLogs(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	operator, sink := testManager(t, watchConfig(tempDir))
+
+	existing := filetest.OpenFile(t, filepath.Join(tempDir, "existing.log"))
+	filetest.WriteString(t, existing, "existing1\n")
+
+	require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	// the files that exist are read by the initial poll
+	sink.ExpectToken(t, []byte("existing1"))
+
+	// written files are read from their offset
+	filetest.WriteString(t, existing, "existing2\n")
+	sink.ExpectToken(t, []byte("existing2"))
+
+	// created files are read from the beginning
+	created := filetest.OpenFile(t, filepath.Join(tempDir, "created.log"))
+	filetest.WriteString(t, created, "created1\n")
+	sink.ExpectToken(t, []byte("created1"))
+
+	// files created in new directories are read once the directory is watched
+	require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "a", "b"), 0o700))
+	nested := filetest.OpenFile(t, filepath.Join(tempDir, "a", "b", "nested.log"))
+	filetest.WriteString(t, nested, "nested1\n")
+	sink.ExpectToken(t, []byte("nested1"))
+	filetest.WriteString(t, nested, "nested2\n")
+	sink.ExpectToken(t, []byte("nested2"))
+
+	// files which aren't matched are ignored
+	ignored := filetest.OpenFile(t, filepath.Join(tempDir, "ignored.txt"))
+	filetest.WriteString(t, ignored, "ignored\n")
+	sink.ExpectNoCalls(t)
+}
+
+func TestWatchRotation(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := watchConfig(tempDir)
+	cfg.Include = []string{filepath.Join(tempDir, "*.log")}
+	operator, sink := testManager(t, cfg)
+
+	path := filepath.Join(tempDir, "app.log")
+	file := filetest.OpenFile(t, path)
+	filetest.WriteString(t, file, "before rotation 1\n")
+
+	require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+	sink.ExpectToken(t, []byte("before rotation 1"))
+
+	// the rotated file is moved out of the matched paths, and is read to the end as a lost file
+	filetest.WriteString(t, file, "before rotation 2\n")
+	require.NoError(t, os.Rename(path, filepath.Join(tempDir, "app.log.1")))
+	rotated := filetest.OpenFile(t, path)
+	filetest.WriteString(t, rotated, "after rotation\n")
+
+	sink.ExpectTokens(t, []byte("before rotation 2"), []byte("after rotation"))
+	sink.ExpectNoCalls(t)
+}
+
+func TestWatchFlush(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := watchConfig(tempDir)
+	cfg.FlushPeriod = 50 * time.Millisecond
+	operator, sink := testManager(t, cfg)
+
+	require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	// the incomplete token is flushed although no event is received once the flush period expires
+	file := filetest.OpenFile(t, filepath.Join(tempDir, "app.log"))
+	filetest.WriteString(t, file, "complete\nincomplete")
+	sink.ExpectTokens(t, []byte("complete"), []byte("incomplete"))
+}
+
+func TestWatchRead(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	operator, sink := testManager(t, watchConfig(tempDir))
+	operator.persister = testutil.NewUnscopedMockPersister()
+
+	fsw, err := newFSWatcher()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, fsw.Close())
+	}()
+	w := &fileWatcher{
+		m:         operator,
+		fsw:       fsw,
+		dirs:      make(map[string]struct{}),
+		pending:   make(map[string]struct{}),
+		unflushed: make(map[string]struct{}),
+	}
+
+	// the first read is a full poll, which finds the existing files and watches their directories
+	file := filetest.OpenFile(t, filepath.Join(tempDir, "app.log"))
+	filetest.WriteString(t, file, "testlog1\n")
+	w.pollPending = true
+	require.NoError(t, w.read(context.Background()))
+	sink.ExpectToken(t, []byte("testlog1"))
+	assert.Contains(t, w.dirs, tempDir)
+	assert.Contains(t, w.unflushed, file.Name())
+
+	// the offsets are kept when the file is read from an event, and persisted
+	filetest.WriteString(t, file, "testlog2\n")
+	assert.True(t, w.handleEvent(fsnotify.Event{Name: file.Name(), Op: fsnotify.Write}))
+	require.NoError(t, w.read(context.Background()))
+	sink.ExpectToken(t, []byte("testlog2"))
+	sink.ExpectNoCalls(t)
+
+	metadata := operator.tracker.GetMetadata()
+	require.Len(t, metadata, 1)
+	assert.Equal(t, int64(len("testlog1\ntestlog2\n")), metadata[0].Offset)
+
+	// directories which can no longer contain matched files are no longer watched
+	subDir := filepath.Join(tempDir, "sub")
+	require.NoError(t, os.Mkdir(subDir, 0o700))
+	assert.True(t, w.handleEvent(fsnotify.Event{Name: subDir, Op: fsnotify.Create}))
+	require.NoError(t, w.read(context.Background()))
+	assert.Contains(t, w.dirs, subDir)
+
+	require.NoError(t, os.Remove(subDir))
+	assert.True(t, w.handleEvent(fsnotify.Event{Name: subDir, Op: fsnotify.Remove}))
+	require.NoError(t, w.read(context.Background()))
+	assert.NotContains(t, w.dirs, subDir)
+}
+
+func TestWatchManyFiles(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := watchConfig(tempDir)
+	cfg.MaxConcurrentFiles = 4
+	operator, sink := testManager(t, cfg)
+
+	require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	// the files for which events were received are read in batches
+	numFiles := 10
+	expected := make([][]byte, 0, numFiles)
+	for i := 0; i < numFiles; i++ {
+		file := filetest.OpenFile(t, filepath.Join(tempDir, fmt.Sprintf("%d.log", i)))
+		filetest.WriteString(t, file, fmt.Sprintf("testlog%d\n", i))
+		expected = append(expected, []byte(fmt.Sprintf("testlog%d", i)))
+	}
+	sink.ExpectTokens(t, expected...)
+}
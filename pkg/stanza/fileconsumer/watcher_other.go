@@ -0,0 +1,22 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"errors"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+var errWatchUnsupported = errors.New("watch_mode 'inotify' is only supported on Linux")
+
+func newFSWatcher() (*fsnotify.Watcher, error) {
+	return nil, errWatchUnsupported
+}
+
+func checkWatchable(string) error {
+	return errWatchUnsupported
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helper // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+
+import (
+	"context"
+	"sync"
+)
+
+type acknowledgementKey struct{}
+
+// Acknowledgement tracks the entries written with a context carrying it, until they are consumed by the
+// consumer of a LogEmitter. Entries which are dropped before reaching a LogEmitter aren't tracked.
+type Acknowledgement struct {
+	mux     sync.Mutex
+	pending int
+	sealed  bool
+	err     error
+	done    chan struct{}
+	// held are the acknowledgements of the entries the tracked entries were derived from, which are
+	// acknowledged once this one is done
+	held []*Acknowledgement
+}
+
+// NewAcknowledgement creates an acknowledgement with no pending entry.
+func NewAcknowledgement() *Acknowledgement {
+	return &Acknowledgement{done: make(chan struct{})}
+}
+
+// ContextWithAcknowledgement returns a context carrying the acknowledgement, for the entries written with it
+// to be tracked.
+func ContextWithAcknowledgement(ctx context.Context, ack *Acknowledgement) context.Context {
+	return context.WithValue(ctx, acknowledgementKey{}, ack)
+}
+
+func acknowledgementFromContext(ctx context.Context) *Acknowledgement {
+	ack, _ := ctx.Value(acknowledgementKey{}).(*Acknowledgement)
+	return ack
+}
+
+// HoldAcknowledgement keeps the acknowledgement carried by ctx, if any, pending for the entry written with ctx.
+// It's meant for operators which buffer entries instead of writing them, such as the ones combining several
+// entries into one. The entries derived from the buffered ones must then be written with a context returned by
+// ContextWithHeldAcknowledgements. It returns nil if ctx doesn't carry any acknowledgement.
+func HoldAcknowledgement(ctx context.Context) *Acknowledgement {
+	ack := acknowledgementFromContext(ctx)
+	if ack != nil {
+		ack.add()
+	}
+	return ack
+}
+
+// ContextWithHeldAcknowledgements returns a context for writing the entries derived from entries whose
+// acknowledgements were held. The held acknowledgements are acknowledged once the entries written with the
+// returned context are consumed, and release was called with the error of writing them, if any.
+func ContextWithHeldAcknowledgements(ctx context.Context, held []*Acknowledgement) (_ context.Context, release func(error)) {
+	if len(held) == 0 {
+		// don't track the entries with the acknowledgement of another entry
+		return ContextWithAcknowledgement(ctx, nil), func(error) {}
+	}
+	ack := &Acknowledgement{done: make(chan struct{}), held: held}
+	ack.add()
+	return ContextWithAcknowledgement(ctx, ack), func(err error) {
+		ack.ack(err)
+		ack.Seal()
+	}
+}
+
+// Seal reports that no more entries are written with the acknowledgement. It's done once all the entries
+// written before were consumed.
+func (a *Acknowledgement) Seal() {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	if !a.sealed {
+		a.sealed = true
+		a.checkDone()
+	}
+}
+
+// Done returns a channel which is closed once the acknowledgement is sealed and all of its entries were consumed.
+func (a *Acknowledgement) Done() <-chan struct{} {
+	return a.done
+}
+
+// Err returns the first error returned by the consumer for the entries of the acknowledgement.
+func (a *Acknowledgement) Err() error {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	return a.err
+}
+
+func (a *Acknowledgement) add() {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	a.pending++
+}
+
+func (a *Acknowledgement) ack(err error) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	a.pending--
+	if err != nil && a.err == nil {
+		a.err = err
+	}
+	a.checkDone()
+}
+
+func (a *Acknowledgement) checkDone() {
+	if a.sealed && a.pending == 0 {
+		select {
+		case <-a.done:
+		default:
+			close(a.done)
+			for _, held := range a.held {
+				held.ack(a.err)
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func isDone(ack *Acknowledgement) bool {
+	select {
+	case <-ack.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+func TestAcknowledgement(t *testing.T) {
+	ack := NewAcknowledgement()
+	assert.Same(t, ack, acknowledgementFromContext(ContextWithAcknowledgement(context.Background(), ack)))
+	assert.Nil(t, acknowledgementFromContext(context.Background()))
+
+	ack.add()
+	ack.add()
+	ack.ack(nil)
+	assert.False(t, isDone(ack))
+
+	// done once sealed and all the entries are acknowledged
+	ack.Seal()
+	assert.False(t, isDone(ack))
+	err := errors.New("failed")
+	ack.ack(err)
+	assert.True(t, isDone(ack))
+	assert.Equal(t, err, ack.Err())
+
+	// sealing again has no effect
+	ack.Seal()
+	assert.True(t, isDone(ack))
+}
+
+func TestAcknowledgementEmpty(t *testing.T) {
+	ack := NewAcknowledgement()
+	assert.False(t, isDone(ack))
+	ack.Seal()
+	assert.True(t, isDone(ack))
+	require.NoError(t, ack.Err())
+}
+
+func TestHeldAcknowledgements(t *testing.T) {
+	ack := NewAcknowledgement()
+	held := HoldAcknowledgement(ContextWithAcknowledgement(context.Background(), ack))
+	assert.Same(t, ack, held)
+	ack.Seal()
+	assert.False(t, isDone(ack))
+
+	// the entry derived from the held one is written and then consumed
+	ctx, release := ContextWithHeldAcknowledgements(context.Background(), []*Acknowledgement{held})
+	derived := HoldAcknowledgement(ctx)
+	require.NotNil(t, derived)
+	release(nil)
+	assert.False(t, isDone(ack))
+	err := errors.New("failed")
+	derived.ack(err)
+	assert.True(t, isDone(ack))
+	assert.Equal(t, err, ack.Err())
+
+	// without held acknowledgements, the entries aren't tracked with the one of the context
+	ctx, release = ContextWithHeldAcknowledgements(ContextWithAcknowledgement(context.Background(), NewAcknowledgement()), nil)
+	assert.Nil(t, HoldAcknowledgement(ctx))
+	release(nil)
+}
@@ -21,10 +21,11 @@ type LogEmitter struct {
 	stopOnce      sync.Once
 	batchMux      sync.Mutex
 	batch         []*entry.Entry
+	batchAcks     []*Acknowledgement
 	wg            sync.WaitGroup
 	maxBatchSize  uint
 	flushInterval time.Duration
-	consumerFunc  func(context.Context, []*entry.Entry)
+	consumerFunc  func(context.Context, []*entry.Entry) error
 }
 
 var (
@@ -62,6 +63,15 @@ func (o flushIntervalOption) apply(e *LogEmitter) {
 
 // NewLogEmitter creates a new receiver output
 func NewLogEmitter(set component.TelemetrySettings, consumerFunc func(context.Context, []*entry.Entry), opts ...EmitterOption) *LogEmitter {
+	return NewLogEmitterWithError(set, func(ctx context.Context, entries []*entry.Entry) error {
+		consumerFunc(ctx, entries)
+		return nil
+	}, opts...)
+}
+
+// NewLogEmitterWithError creates a new receiver output, like NewLogEmitter. The error returned by consumerFunc
+// is reported to the acknowledgements of the consumed entries.
+func NewLogEmitterWithError(set component.TelemetrySettings, consumerFunc func(context.Context, []*entry.Entry) error, opts ...EmitterOption) *LogEmitter {
 	op, _ := NewOutputConfig("log_emitter", "log_emitter").Build(set)
 	e := &LogEmitter{
 		OutputOperator: op,
@@ -96,27 +106,41 @@ func (e *LogEmitter) Stop() error {
 
 // Process will emit an entry to the output channel
 func (e *LogEmitter) Process(ctx context.Context, ent *entry.Entry) error {
-	if oldBatch := e.appendEntry(ent); len(oldBatch) > 0 {
-		e.consumerFunc(ctx, oldBatch)
+	ack := HoldAcknowledgement(ctx)
+	if oldBatch, oldAcks := e.appendEntry(ent, ack); len(oldBatch) > 0 {
+		e.consume(ctx, oldBatch, oldAcks)
 	}
 
 	return nil
 }
 
 // appendEntry appends the entry to the current batch. If maxBatchSize is reached, a new batch will be made, and the old batch
-// (which should be flushed) will be returned
-func (e *LogEmitter) appendEntry(ent *entry.Entry) []*entry.Entry {
+// (which should be flushed) will be returned, along with the acknowledgements of its entries
+func (e *LogEmitter) appendEntry(ent *entry.Entry, ack *Acknowledgement) ([]*entry.Entry, []*Acknowledgement) {
 	e.batchMux.Lock()
 	defer e.batchMux.Unlock()
 
 	e.batch = append(e.batch, ent)
+	if ack != nil {
+		e.batchAcks = append(e.batchAcks, ack)
+	}
 	if uint(len(e.batch)) >= e.maxBatchSize {
 		var oldBatch []*entry.Entry
+		var oldAcks []*Acknowledgement
 		oldBatch, e.batch = e.batch, make([]*entry.Entry, 0, e.maxBatchSize)
-		return oldBatch
+		oldAcks, e.batchAcks = e.batchAcks, nil
+		return oldBatch, oldAcks
 	}
 
-	return nil
+	return nil, nil
+}
+
+// consume passes the batch to the consumer, and acknowledges its entries once consumed. When the consumer writes
+// the entries to other operators, e.g. as the emitter of an operator's internal pipeline, they are acknowledged
+// once the entries it writes are consumed in turn.
+func (e *LogEmitter) consume(ctx context.Context, batch []*entry.Entry, acks []*Acknowledgement) {
+	ctx, release := ContextWithHeldAcknowledgements(ctx, acks)
+	release(e.consumerFunc(ctx, batch))
 }
 
 // flusher flushes the current batch every flush interval. Intended to be run as a goroutine
@@ -129,29 +153,32 @@ func (e *LogEmitter) flusher() {
 	for {
 		select {
 		case <-ticker.C:
-			if oldBatch := e.makeNewBatch(); len(oldBatch) > 0 {
-				e.consumerFunc(context.Background(), oldBatch)
+			if oldBatch, oldAcks := e.makeNewBatch(); len(oldBatch) > 0 {
+				e.consume(context.Background(), oldBatch, oldAcks)
 			}
 		case <-e.closeChan:
 			// flush currently batched entries
-			if oldBatch := e.makeNewBatch(); len(oldBatch) > 0 {
-				e.consumerFunc(context.Background(), oldBatch)
+			if oldBatch, oldAcks := e.makeNewBatch(); len(oldBatch) > 0 {
+				e.consume(context.Background(), oldBatch, oldAcks)
 			}
 			return
 		}
 	}
 }
 
-// makeNewBatch replaces the current batch on the log emitter with a new batch, returning the old one
-func (e *LogEmitter) makeNewBatch() []*entry.Entry {
+// makeNewBatch replaces the current batch on the log emitter with a new batch, returning the old one along with
+// the acknowledgements of its entries
+func (e *LogEmitter) makeNewBatch() ([]*entry.Entry, []*Acknowledgement) {
 	e.batchMux.Lock()
 	defer e.batchMux.Unlock()
 
 	if len(e.batch) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	var oldBatch []*entry.Entry
+	var oldAcks []*Acknowledgement
 	oldBatch, e.batch = e.batch, make([]*entry.Entry, 0, e.maxBatchSize)
-	return oldBatch
+	oldAcks, e.batchAcks = e.batchAcks, nil
+	return oldBatch, oldAcks
 }
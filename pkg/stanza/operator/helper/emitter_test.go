@@ -5,8 +5,10 @@ package helper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -217,3 +219,85 @@ func complexEntriesForNDifferentHosts(count int, n int) []*entry.Entry {
 	}
 	return ret
 }
+
+func TestLogEmitterAcknowledgements(t *testing.T) {
+	consumed := make(chan struct{})
+	consumeErr := errors.New("consume failed")
+	var fail atomic.Bool
+	emitter := NewLogEmitterWithError(
+		componenttest.NewNopTelemetrySettings(),
+		func(_ context.Context, _ []*entry.Entry) error {
+			<-consumed
+			if fail.Load() {
+				return consumeErr
+			}
+			return nil
+		},
+		WithMaxBatchSize(2),
+	)
+
+	// the entries are acknowledged once their batch is consumed
+	ack := NewAcknowledgement()
+	ctx := ContextWithAcknowledgement(context.Background(), ack)
+	require.NoError(t, emitter.Process(ctx, complexEntry()))
+	ack.Seal()
+	select {
+	case <-ack.Done():
+		t.Fatal("acknowledged before the entries were consumed")
+	default:
+	}
+
+	// entries without acknowledgement aren't tracked
+	go func() {
+		consumed <- struct{}{}
+	}()
+	require.NoError(t, emitter.Process(context.Background(), complexEntry()))
+	<-ack.Done()
+	require.NoError(t, ack.Err())
+
+	// the error of the consumer is reported
+	fail.Store(true)
+	ack = NewAcknowledgement()
+	ctx = ContextWithAcknowledgement(context.Background(), ack)
+	require.NoError(t, emitter.Start(nil))
+	require.NoError(t, emitter.Process(ctx, complexEntry()))
+	ack.Seal()
+	close(consumed)
+	require.NoError(t, emitter.Stop())
+	<-ack.Done()
+	require.ErrorIs(t, ack.Err(), consumeErr)
+}
+
+func TestLogEmitterAcknowledgementsThroughInternalEmitter(t *testing.T) {
+	consumeErr := errors.New("consume failed")
+	emitter := NewLogEmitterWithError(
+		componenttest.NewNopTelemetrySettings(),
+		func(_ context.Context, _ []*entry.Entry) error {
+			return consumeErr
+		},
+		WithMaxBatchSize(2),
+	)
+	// the internal emitter of an operator writes the entries to the emitter of the receiver
+	internal := NewLogEmitter(
+		componenttest.NewNopTelemetrySettings(),
+		func(ctx context.Context, entries []*entry.Entry) {
+			for _, e := range entries {
+				assert.NoError(t, emitter.Process(ctx, e))
+			}
+		},
+		WithMaxBatchSize(1),
+	)
+
+	ack := NewAcknowledgement()
+	require.NoError(t, internal.Process(ContextWithAcknowledgement(context.Background(), ack), complexEntry()))
+	ack.Seal()
+	select {
+	case <-ack.Done():
+		t.Fatal("acknowledged before the entries were consumed by the receiver")
+	default:
+	}
+
+	require.NoError(t, emitter.Process(context.Background(), complexEntry()))
+	<-ack.Done()
+	require.ErrorIs(t, ack.Err(), consumeErr)
+}
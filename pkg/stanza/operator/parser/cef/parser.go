@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/cef"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const cefPrefix = "CEF:"
+
+// headerFields are the names of the fields of the CEF header, in order.
+var headerFields = []string{
+	"version",
+	"device_vendor",
+	"device_product",
+	"device_version",
+	"device_event_class_id",
+	"name",
+	"severity",
+}
+
+// Parser is an operator that parses Common Event Format (CEF) messages.
+type Parser struct {
+	helper.ParserOperator
+}
+
+// Process will parse an entry for a CEF message.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ParserOperator.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a value as a CEF message.
+func (p *Parser) parse(value any) (any, error) {
+	switch m := value.(type) {
+	case string:
+		if m == "" {
+			return nil, fmt.Errorf("parse from field %s is empty", p.ParseFrom.String())
+		}
+		return parseCEF(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as CEF", value)
+	}
+}
+
+// parseCEF parses a message of the form
+// CEF:Version|Device Vendor|Device Product|Device Version|Device Event Class ID|Name|Severity|Extension
+// In the header, '|' and '\' are escaped with '\'. The extension is a list of space separated key=value pairs,
+// in which the values may contain spaces, and '=', '\', new lines and carriage returns are escaped with '\'.
+func parseCEF(input string) (map[string]any, error) {
+	if !strings.HasPrefix(input, cefPrefix) {
+		return nil, fmt.Errorf("message does not start with %q", cefPrefix)
+	}
+
+	result := make(map[string]any, len(headerFields)+1)
+	rest := input[len(cefPrefix):]
+	for _, field := range headerFields {
+		value, remaining, ok := cutHeaderField(rest)
+		if !ok {
+			return nil, fmt.Errorf("missing header field %q", field)
+		}
+		result[field] = value
+		rest = remaining
+	}
+
+	extensions, err := parseExtensions(rest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid extension: %w", err)
+	}
+	result["extensions"] = extensions
+	return result, nil
+}
+
+// cutHeaderField returns the unescaped header field at the beginning of s, and the remaining of s after its
+// '|' separator.
+func cutHeaderField(s string) (string, string, bool) {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s) && (s[i+1] == '|' || s[i+1] == '\\'):
+			i++
+			sb.WriteByte(s[i])
+		case c == '|':
+			return sb.String(), s[i+1:], true
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", "", false
+}
+
+// parseExtensions parses the key=value pairs of the extension. A key is a word directly followed by an
+// unescaped '=', and its value runs until the space before the next key.
+func parseExtensions(s string) (map[string]any, error) {
+	extensions := make(map[string]any)
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return extensions, nil
+	}
+
+	var key string
+	valueStart := -1
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+			continue
+		case '=':
+		default:
+			continue
+		}
+
+		keyStart := strings.LastIndexByte(s[:i], ' ') + 1
+		if keyStart < valueStart || !isKey(s[keyStart:i]) {
+			// an unescaped '=' in a value
+			if valueStart < 0 {
+				return nil, fmt.Errorf("invalid key %q", s[:i])
+			}
+			continue
+		}
+		if valueStart < 0 {
+			if keyStart != 0 {
+				return nil, fmt.Errorf("unexpected %q before the first key", s[:keyStart-1])
+			}
+		} else {
+			extensions[key] = unescapeValue(strings.TrimRight(s[valueStart:keyStart], " "))
+		}
+		key = s[keyStart:i]
+		valueStart = i + 1
+	}
+	if valueStart < 0 {
+		return nil, errors.New("no key=value pair found")
+	}
+	extensions[key] = unescapeValue(s[valueStart:])
+	return extensions, nil
+}
+
+func isKey(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.IndexByte("_.-[]", c) >= 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// unescapeValue unescapes the sequences allowed in extension values. Unknown sequences are kept as is.
+func unescapeValue(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+		switch s[i+1] {
+		case '=', '\\', '|':
+			sb.WriteByte(s[i+1])
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		default:
+			sb.WriteByte('\\')
+			sb.WriteByte(s[i+1])
+		}
+		i++
+	}
+	return sb.String()
+}
@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cef
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := config.Build(set)
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("cef_parser")
+	require.True(t, ok, "expected cef_parser to be registered")
+	require.Equal(t, "cef_parser", builder().Type())
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.OnError = "invalid_on_error"
+	set := componenttest.NewNopTelemetrySettings()
+	_, err := config.Build(set)
+	require.ErrorContains(t, err, "invalid `on_error` field")
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.ErrorContains(t, err, "type []int cannot be parsed as CEF")
+}
+
+func TestParserEmptyInput(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse("")
+	require.ErrorContains(t, err, "parse from field body is empty")
+}
+
+func header(vendor, product, version, classID, name, severity string) map[string]any {
+	return map[string]any{
+		"version":               "0",
+		"device_vendor":         vendor,
+		"device_product":        product,
+		"device_version":        version,
+		"device_event_class_id": classID,
+		"name":                  name,
+		"severity":              severity,
+	}
+}
+
+func withExtensions(m map[string]any, extensions map[string]any) map[string]any {
+	m["extensions"] = extensions
+	return m
+}
+
+func TestParseCEF(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  string
+		expect map[string]any
+		err    string
+	}{
+		{
+			"spec_example",
+			`CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|src=10.0.0.1 dst=2.1.2.2 spt=1232`,
+			withExtensions(header("Security", "threatmanager", "1.0", "100", "worm successfully stopped", "10"),
+				map[string]any{"src": "10.0.0.1", "dst": "2.1.2.2", "spt": "1232"}),
+			"",
+		},
+		{
+			"escaped_header",
+			`CEF:0|security|threatmanager|1.0|100|detected a \| in message|10|src=10.0.0.1`,
+			withExtensions(header("security", "threatmanager", "1.0", "100", "detected a | in message", "10"),
+				map[string]any{"src": "10.0.0.1"}),
+			"",
+		},
+		{
+			"escaped_backslash_in_header",
+			`CEF:0|security|threatmanager|1.0|100|detected a \\ in packet|10|`,
+			withExtensions(header("security", "threatmanager", "1.0", "100", `detected a \ in packet`, "10"),
+				map[string]any{}),
+			"",
+		},
+		{
+			"escaped_extension",
+			`CEF:0|security|threatmanager|1.0|100|detected|High|msg=detected a \= and a \\ and a | here\nnext line fname=C:\\windows\\temp.log`,
+			withExtensions(header("security", "threatmanager", "1.0", "100", "detected", "High"),
+				map[string]any{"msg": "detected a = and a \\ and a | here\nnext line", "fname": `C:\windows\temp.log`}),
+			"",
+		},
+		{
+			"values_with_spaces",
+			`CEF:0|Vendor|Product|2.1|login|User login|5|suser=John Smith act=login success  cs1Label=Custom Label cs1=some value   `,
+			withExtensions(header("Vendor", "Product", "2.1", "login", "User login", "5"),
+				map[string]any{"suser": "John Smith", "act": "login success", "cs1Label": "Custom Label", "cs1": "some value"}),
+			"",
+		},
+		{
+			"unescaped_equal_in_value",
+			`CEF:0|Vendor|Product|2.1|req|Request|3|request=http://host/?a=b&c=d out=1`,
+			withExtensions(header("Vendor", "Product", "2.1", "req", "Request", "3"),
+				map[string]any{"request": "http://host/?a=b&c=d", "out": "1"}),
+			"",
+		},
+		{
+			"empty_values",
+			`CEF:0|Vendor|Product|2.1|req|Request|3|a= b=1`,
+			withExtensions(header("Vendor", "Product", "2.1", "req", "Request", "3"),
+				map[string]any{"a": "", "b": "1"}),
+			"",
+		},
+		{
+			"missing_prefix",
+			`<134>Feb 25 14:09:07 host CEF:0|Vendor|Product|2.1|req|Request|3|`,
+			nil,
+			`message does not start with "CEF:"`,
+		},
+		{
+			"missing_header_field",
+			`CEF:0|Vendor|Product|2.1|req|Request`,
+			nil,
+			`missing header field "name"`,
+		},
+		{
+			"text_before_first_key",
+			`CEF:0|Vendor|Product|2.1|req|Request|3|unexpected src=1`,
+			nil,
+			`invalid extension: unexpected "unexpected" before the first key`,
+		},
+		{
+			"no_pair",
+			`CEF:0|Vendor|Product|2.1|req|Request|3|unexpected`,
+			nil,
+			"invalid extension: no key=value pair found",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := parseCEF(tc.input)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, result)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.OutputIDs = []string{"fake"}
+	cfg.ParseFrom = entry.NewBodyField("message")
+	cfg.ParseTo = entry.RootableField{Field: entry.NewBodyField("cef")}
+
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := cfg.Build(set)
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+	ots := time.Now()
+	input := &entry.Entry{
+		ObservedTimestamp: ots,
+		Body: map[string]any{
+			"message": `CEF:0|Vendor|Product|2.1|100|Blocked|7|src=10.0.0.1 act=blocked`,
+		},
+	}
+	require.NoError(t, op.Process(context.Background(), input))
+	fake.ExpectEntry(t, &entry.Entry{
+		ObservedTimestamp: ots,
+		Body: map[string]any{
+			"message": `CEF:0|Vendor|Product|2.1|100|Blocked|7|src=10.0.0.1 act=blocked`,
+			"cef": withExtensions(header("Vendor", "Product", "2.1", "100", "Blocked", "7"),
+				map[string]any{"src": "10.0.0.1", "act": "blocked"}),
+		},
+	})
+}
@@ -96,7 +96,7 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		criConsumers:            &wg,
 	}
 
-	cLogEmitter := helper.NewLogEmitter(set, p.consumeEntries)
+	cLogEmitter := helper.NewLogEmitterWithError(set, p.consumeEntries)
 	p.criLogEmitter = cLogEmitter
 	recombineParser, err := createRecombine(set, c, cLogEmitter)
 	if err != nil {
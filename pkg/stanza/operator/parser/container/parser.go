@@ -288,13 +288,16 @@ func (p *Parser) extractk8sMetaFromFilePath(e *entry.Entry) error {
 	return nil
 }
 
-func (p *Parser) consumeEntries(ctx context.Context, entries []*entry.Entry) {
+func (p *Parser) consumeEntries(ctx context.Context, entries []*entry.Entry) error {
+	var errs error
 	for _, e := range entries {
 		err := p.Write(ctx, e)
 		if err != nil {
 			p.Logger().Error("failed to write entry", zap.Error(err))
+			errs = errors.Join(errs, err)
 		}
 	}
+	return errs
 }
 
 func moveField(e *entry.Entry, originalKey, mappedKey string) error {
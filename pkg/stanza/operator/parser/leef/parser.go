@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/leef"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	leefPrefix       = "LEEF:"
+	defaultDelimiter = "\t"
+)
+
+// headerFields are the names of the fields of the LEEF header common to all versions, in order.
+var headerFields = []string{
+	"version",
+	"device_vendor",
+	"device_product",
+	"device_version",
+	"event_id",
+}
+
+// Parser is an operator that parses Log Event Extended Format (LEEF) messages.
+type Parser struct {
+	helper.ParserOperator
+}
+
+// Process will parse an entry for a LEEF message.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ParserOperator.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a value as a LEEF message.
+func (p *Parser) parse(value any) (any, error) {
+	switch m := value.(type) {
+	case string:
+		if m == "" {
+			return nil, fmt.Errorf("parse from field %s is empty", p.ParseFrom.String())
+		}
+		return parseLEEF(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as LEEF", value)
+	}
+}
+
+// parseLEEF parses a message of the form
+// LEEF:1.0|Vendor|Product|Version|EventID|Attributes
+// LEEF:2.0|Vendor|Product|Version|EventID|DelimiterCharacter|Attributes
+// The attributes are key=value pairs separated by the delimiter character, which is a tab unless specified
+// by a LEEF 2.0 header. In the header, '|' and '\' are escaped with '\'.
+func parseLEEF(input string) (map[string]any, error) {
+	if !strings.HasPrefix(input, leefPrefix) {
+		return nil, fmt.Errorf("message does not start with %q", leefPrefix)
+	}
+
+	result := make(map[string]any, len(headerFields)+1)
+	rest := input[len(leefPrefix):]
+	for i, field := range headerFields {
+		value, remaining, ok := cutHeaderField(rest)
+		if !ok {
+			// the attributes of LEEF 1.0 messages may be omitted along with their separator
+			if i < len(headerFields)-1 {
+				return nil, fmt.Errorf("missing header field %q", field)
+			}
+			value, remaining = unescapeHeader(rest), ""
+		}
+		result[field] = value
+		rest = remaining
+	}
+
+	delimiter := defaultDelimiter
+	switch version := result["version"].(string); {
+	case strings.HasPrefix(version, "1"):
+	case strings.HasPrefix(version, "2"):
+		value, remaining, ok := cutHeaderField(rest)
+		if !ok {
+			return nil, fmt.Errorf("missing header field %q", "delimiter")
+		}
+		if value != "" {
+			var err error
+			if delimiter, err = parseDelimiter(value); err != nil {
+				return nil, err
+			}
+		}
+		rest = remaining
+	default:
+		return nil, fmt.Errorf("unsupported LEEF version %q", version)
+	}
+
+	attributes := make(map[string]any)
+	for _, pair := range strings.Split(rest, delimiter) {
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid attribute %q, expected key=value", pair)
+		}
+		attributes[key] = value
+	}
+	result["attributes"] = attributes
+	return result, nil
+}
+
+// parseDelimiter parses the delimiter of a LEEF 2.0 header, which is either a single character, or the hex
+// code of a character prefixed with 'x' or '0x'.
+func parseDelimiter(s string) (string, error) {
+	hex, isHex := strings.CutPrefix(strings.ToLower(s), "0x")
+	if !isHex {
+		hex, isHex = strings.CutPrefix(strings.ToLower(s), "x")
+	}
+	if isHex && hex != "" {
+		code, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid delimiter %q: %w", s, err)
+		}
+		return string(rune(code)), nil
+	}
+	if len([]rune(s)) != 1 {
+		return "", fmt.Errorf("invalid delimiter %q, expected a single character or its hex code", s)
+	}
+	return s, nil
+}
+
+// cutHeaderField returns the unescaped header field at the beginning of s, and the remaining of s after its
+// '|' separator.
+func cutHeaderField(s string) (string, string, bool) {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '|':
+			return unescapeHeader(s[:i]), s[i+1:], true
+		}
+	}
+	return "", "", false
+}
+
+func unescapeHeader(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && (s[i+1] == '|' || s[i+1] == '\\') {
+			i++
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
@@ -0,0 +1,204 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package leef
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := config.Build(set)
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("leef_parser")
+	require.True(t, ok, "expected leef_parser to be registered")
+	require.Equal(t, "leef_parser", builder().Type())
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.OnError = "invalid_on_error"
+	set := componenttest.NewNopTelemetrySettings()
+	_, err := config.Build(set)
+	require.ErrorContains(t, err, "invalid `on_error` field")
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.ErrorContains(t, err, "type []int cannot be parsed as LEEF")
+}
+
+func TestParserEmptyInput(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse("")
+	require.ErrorContains(t, err, "parse from field body is empty")
+}
+
+func leef(version, vendor, product, productVersion, eventID string, attributes map[string]any) map[string]any {
+	return map[string]any{
+		"version":        version,
+		"device_vendor":  vendor,
+		"device_product": product,
+		"device_version": productVersion,
+		"event_id":       eventID,
+		"attributes":     attributes,
+	}
+}
+
+func TestParseLEEF(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  string
+		expect map[string]any
+		err    string
+	}{
+		{
+			"v1",
+			"LEEF:1.0|Microsoft|MSExchange|4.0 SP1|15345|src=192.0.2.0\tdst=172.50.123.1\tsev=5\tcat=anomaly\tmsg=there are spaces",
+			leef("1.0", "Microsoft", "MSExchange", "4.0 SP1", "15345", map[string]any{
+				"src": "192.0.2.0", "dst": "172.50.123.1", "sev": "5", "cat": "anomaly", "msg": "there are spaces",
+			}),
+			"",
+		},
+		{
+			"v1_without_attributes",
+			"LEEF:1.0|Microsoft|MSExchange|4.0 SP1|15345",
+			leef("1.0", "Microsoft", "MSExchange", "4.0 SP1", "15345", map[string]any{}),
+			"",
+		},
+		{
+			"v2_custom_delimiter",
+			"LEEF:2.0|Lancope|StealthWatch|1.0|41|^|src=10.0.1.8^dst=10.0.0.5^sev=5^url=http://host/?a=b",
+			leef("2.0", "Lancope", "StealthWatch", "1.0", "41", map[string]any{
+				"src": "10.0.1.8", "dst": "10.0.0.5", "sev": "5", "url": "http://host/?a=b",
+			}),
+			"",
+		},
+		{
+			"v2_hex_delimiter",
+			"LEEF:2.0|Lancope|StealthWatch|1.0|41|0x7c|src=10.0.1.8|dst=10.0.0.5",
+			leef("2.0", "Lancope", "StealthWatch", "1.0", "41", map[string]any{
+				"src": "10.0.1.8", "dst": "10.0.0.5",
+			}),
+			"",
+		},
+		{
+			"v2_short_hex_delimiter",
+			"LEEF:2.0|Lancope|StealthWatch|1.0|41|x09|src=10.0.1.8\tdst=10.0.0.5",
+			leef("2.0", "Lancope", "StealthWatch", "1.0", "41", map[string]any{
+				"src": "10.0.1.8", "dst": "10.0.0.5",
+			}),
+			"",
+		},
+		{
+			"v2_default_delimiter",
+			"LEEF:2.0|Lancope|StealthWatch|1.0|41||src=10.0.1.8\tdst=10.0.0.5\t",
+			leef("2.0", "Lancope", "StealthWatch", "1.0", "41", map[string]any{
+				"src": "10.0.1.8", "dst": "10.0.0.5",
+			}),
+			"",
+		},
+		{
+			"escaped_header",
+			`LEEF:1.0|Vendor \| Inc|Product\\Suite|1.0|id|`,
+			leef("1.0", "Vendor | Inc", `Product\Suite`, "1.0", "id", map[string]any{}),
+			"",
+		},
+		{
+			"missing_prefix",
+			"<13>Jan 18 11:07:53 host LEEF:1.0|Vendor|Product|1.0|id|",
+			nil,
+			`message does not start with "LEEF:"`,
+		},
+		{
+			"missing_header_field",
+			"LEEF:1.0|Vendor|Product",
+			nil,
+			`missing header field "device_product"`,
+		},
+		{
+			"missing_delimiter",
+			"LEEF:2.0|Vendor|Product|1.0|id",
+			nil,
+			`missing header field "delimiter"`,
+		},
+		{
+			"invalid_delimiter",
+			"LEEF:2.0|Vendor|Product|1.0|id|ab|a=1",
+			nil,
+			`invalid delimiter "ab"`,
+		},
+		{
+			"invalid_hex_delimiter",
+			"LEEF:2.0|Vendor|Product|1.0|id|0xzz|a=1",
+			nil,
+			`invalid delimiter "0xzz"`,
+		},
+		{
+			"unsupported_version",
+			"LEEF:3.0|Vendor|Product|1.0|id|a=1",
+			nil,
+			`unsupported LEEF version "3.0"`,
+		},
+		{
+			"invalid_attribute",
+			"LEEF:1.0|Vendor|Product|1.0|id|a=1\tinvalid",
+			nil,
+			`invalid attribute "invalid", expected key=value`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := parseLEEF(tc.input)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, result)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.OutputIDs = []string{"fake"}
+
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := cfg.Build(set)
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+	ots := time.Now()
+	input := &entry.Entry{
+		ObservedTimestamp: ots,
+		Body:              "LEEF:1.0|Vendor|Product|1.0|login|usrName=admin\tsrc=10.0.0.1",
+	}
+	require.NoError(t, op.Process(context.Background(), input))
+	fake.ExpectEntry(t, &entry.Entry{
+		ObservedTimestamp: ots,
+		Body:              "LEEF:1.0|Vendor|Product|1.0|login|usrName=admin\tsrc=10.0.0.1",
+		Attributes: leef("1.0", "Vendor", "Product", "1.0", "login", map[string]any{
+			"usrName": "admin", "src": "10.0.0.1",
+		}),
+	})
+}
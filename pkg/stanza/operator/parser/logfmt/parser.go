@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logfmt // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/logfmt"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+// Parser is an operator that parses logfmt lines.
+type Parser struct {
+	helper.ParserOperator
+}
+
+// Process will parse an entry for logfmt pairs.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ParserOperator.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a value as logfmt pairs.
+func (p *Parser) parse(value any) (any, error) {
+	switch m := value.(type) {
+	case string:
+		if m == "" {
+			return nil, fmt.Errorf("parse from field %s is empty", p.ParseFrom.String())
+		}
+		return parseLogfmt(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as logfmt", value)
+	}
+}
+
+// parseLogfmt parses space separated key=value pairs. Values containing spaces, '=' or '"' are quoted,
+// and use the escape sequences of Go string literals. Keys without value are parsed with an empty value.
+func parseLogfmt(input string) (map[string]any, error) {
+	result := make(map[string]any)
+	i := 0
+	for {
+		for i < len(input) && isSpace(input[i]) {
+			i++
+		}
+		if i == len(input) {
+			return result, nil
+		}
+
+		start := i
+		for i < len(input) && isKeyChar(input[i]) {
+			i++
+		}
+		if i == start {
+			return nil, fmt.Errorf("unexpected %q at position %d, expected a key", input[i], i)
+		}
+		key := input[start:i]
+
+		if i == len(input) || isSpace(input[i]) {
+			result[key] = ""
+			continue
+		}
+		if input[i] != '=' {
+			return nil, fmt.Errorf("unexpected %q at position %d, expected '='", input[i], i)
+		}
+		i++
+
+		if i < len(input) && input[i] == '"' {
+			end, err := quotedEnd(input, i)
+			if err != nil {
+				return nil, err
+			}
+			value, err := strconv.Unquote(input[i:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted value for key %q: %w", key, err)
+			}
+			result[key] = value
+			i = end
+			if i < len(input) && !isSpace(input[i]) {
+				return nil, fmt.Errorf("unexpected %q at position %d, expected a space after the quoted value", input[i], i)
+			}
+			continue
+		}
+
+		start = i
+		for i < len(input) && !isSpace(input[i]) {
+			if input[i] == '"' || input[i] == '=' {
+				return nil, fmt.Errorf("unexpected %q at position %d in the value of key %q, the value must be quoted", input[i], i, key)
+			}
+			i++
+		}
+		result[key] = input[start:i]
+	}
+}
+
+// quotedEnd returns the position after the closing quote of the quoted value starting at start.
+func quotedEnd(input string, start int) (int, error) {
+	for i := start + 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated quoted value starting at position %d", start)
+}
+
+func isSpace(c byte) bool {
+	return strings.IndexByte(" \t\r\n", c) >= 0
+}
+
+func isKeyChar(c byte) bool {
+	return c > ' ' && c != '=' && c != '"' && c != 0x7f
+}
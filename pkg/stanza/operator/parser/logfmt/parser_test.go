@@ -0,0 +1,237 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logfmt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := config.Build(set)
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("logfmt_parser")
+	require.True(t, ok, "expected logfmt_parser to be registered")
+	require.Equal(t, "logfmt_parser", builder().Type())
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.OnError = "invalid_on_error"
+	set := componenttest.NewNopTelemetrySettings()
+	_, err := config.Build(set)
+	require.ErrorContains(t, err, "invalid `on_error` field")
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.ErrorContains(t, err, "type []int cannot be parsed as logfmt")
+}
+
+func TestParserEmptyInput(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse("")
+	require.ErrorContains(t, err, "parse from field body is empty")
+}
+
+func TestParseLogfmt(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  string
+		expect map[string]any
+		err    string
+	}{
+		{
+			"simple",
+			`level=info msg=started port=8080`,
+			map[string]any{"level": "info", "msg": "started", "port": "8080"},
+			"",
+		},
+		{
+			"quoted",
+			`msg="request completed" path="/api?q=\"a b\""`,
+			map[string]any{"msg": "request completed", "path": `/api?q="a b"`},
+			"",
+		},
+		{
+			"escapes",
+			`msg="line1\nline2\ttab \\ é"`,
+			map[string]any{"msg": "line1\nline2\ttab \\ é"},
+			"",
+		},
+		{
+			"empty_values",
+			`a= b="" flag c=1`,
+			map[string]any{"a": "", "b": "", "flag": "", "c": "1"},
+			"",
+		},
+		{
+			"extra_spaces",
+			"  a=1 \t b=2  ",
+			map[string]any{"a": "1", "b": "2"},
+			"",
+		},
+		{
+			"keys_with_symbols",
+			`http.status=200 user-id=abc caller=main.go:42`,
+			map[string]any{"http.status": "200", "user-id": "abc", "caller": "main.go:42"},
+			"",
+		},
+		{
+			"duplicate_keys",
+			`a=1 a=2`,
+			map[string]any{"a": "2"},
+			"",
+		},
+		{
+			"missing_key",
+			`=value`,
+			nil,
+			`unexpected '=' at position 0, expected a key`,
+		},
+		{
+			"unterminated_quote",
+			`msg="unterminated`,
+			nil,
+			"unterminated quoted value starting at position 4",
+		},
+		{
+			"unquoted_quote",
+			`msg=a"b`,
+			nil,
+			"the value must be quoted",
+		},
+		{
+			"unquoted_equal",
+			`msg=a=b`,
+			nil,
+			"the value must be quoted",
+		},
+		{
+			"text_after_quote",
+			`msg="a"b`,
+			nil,
+			"expected a space after the quoted value",
+		},
+		{
+			"invalid_escape",
+			`msg="\q"`,
+			nil,
+			`invalid quoted value for key "msg"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := parseLogfmt(tc.input)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, result)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cases := []struct {
+		name        string
+		configure   func(*Config)
+		input       *entry.Entry
+		expect      *entry.Entry
+		expectError bool
+	}{
+		{
+			"simple",
+			func(_ *Config) {},
+			&entry.Entry{
+				Body: `level=warn msg="disk almost full"`,
+			},
+			&entry.Entry{
+				Attributes: map[string]any{
+					"level": "warn",
+					"msg":   "disk almost full",
+				},
+				Body: `level=warn msg="disk almost full"`,
+			},
+			false,
+		},
+		{
+			"from-to",
+			func(cfg *Config) {
+				cfg.ParseFrom = entry.NewAttributeField("from")
+				cfg.ParseTo = entry.RootableField{Field: entry.NewBodyField("to")}
+			},
+			&entry.Entry{
+				Attributes: map[string]any{
+					"from": "a=1 b=2",
+				},
+			},
+			&entry.Entry{
+				Attributes: map[string]any{
+					"from": "a=1 b=2",
+				},
+				Body: map[string]any{
+					"to": map[string]any{
+						"a": "1",
+						"b": "2",
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid",
+			func(_ *Config) {},
+			&entry.Entry{
+				Body: `msg="unterminated`,
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			cfg.OutputIDs = []string{"fake"}
+			tc.configure(cfg)
+
+			set := componenttest.NewNopTelemetrySettings()
+			op, err := cfg.Build(set)
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+			ots := time.Now()
+			tc.input.ObservedTimestamp = ots
+
+			err = op.Process(context.Background(), tc.input)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			tc.expect.ObservedTimestamp = ots
+			fake.ExpectEntry(t, tc.expect)
+		})
+	}
+}
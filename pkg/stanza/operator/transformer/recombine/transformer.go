@@ -50,6 +50,8 @@ type sourceBatch struct {
 	recombined             *bytes.Buffer
 	firstEntryObservedTime time.Time
 	matchDetected          bool
+	// acks holds the acknowledgements of the batched entries until the combined entry is written
+	acks []*helper.Acknowledgement
 }
 
 func (t *Transformer) Start(_ operator.Persister) error {
@@ -162,6 +164,9 @@ func (t *Transformer) addToBatch(ctx context.Context, e *entry.Entry, source str
 			batch.baseEntry = e
 		}
 	}
+	if ack := helper.HoldAcknowledgement(ctx); ack != nil {
+		batch.acks = append(batch.acks, ack)
+	}
 
 	// mark that match occurred to use max_unmatched_batch_size only when match didn't occur
 	if matches && !batch.matchDetected {
@@ -212,19 +217,24 @@ func (t *Transformer) flushSource(ctx context.Context, source string) error {
 		return nil
 	}
 
+	// the batched entries are acknowledged once the combined entry is consumed
+	ctx, release := helper.ContextWithHeldAcknowledgements(ctx, batch.acks)
 	if batch.baseEntry == nil {
 		t.removeBatch(source)
+		release(nil)
 		return nil
 	}
 
 	// Set the recombined field on the entry
 	err := batch.baseEntry.Set(t.combineField, batch.recombined.String())
 	if err != nil {
+		release(err)
 		return err
 	}
 
 	err = t.Write(ctx, batch.baseEntry)
 	t.removeBatch(source)
+	release(err)
 	return err
 }
 
@@ -236,6 +246,7 @@ func (t *Transformer) addNewBatch(source string, e *entry.Entry) *sourceBatch {
 	batch.recombined.Reset()
 	batch.firstEntryObservedTime = e.ObservedTimestamp
 	batch.matchDetected = false
+	batch.acks = nil
 	t.batchMap[source] = batch
 	return batch
 }
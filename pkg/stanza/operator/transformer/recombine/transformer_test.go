@@ -955,3 +955,49 @@ func TestSourceBatchDelete(t *testing.T) {
 	fake.ExpectEntry(t, expect)
 	require.NoError(t, recombine.Stop())
 }
+
+func TestAcknowledgements(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewConfig()
+	cfg.CombineField = entry.NewBodyField()
+	cfg.IsFirstEntry = "body == 'start'"
+	cfg.OutputIDs = []string{"log_emitter"}
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := cfg.Build(set)
+	require.NoError(t, err)
+	recombine := op.(*Transformer)
+
+	var consumed []*entry.Entry
+	emitter := helper.NewLogEmitter(set, func(_ context.Context, entries []*entry.Entry) {
+		consumed = append(consumed, entries...)
+	}, helper.WithMaxBatchSize(1))
+	require.NoError(t, recombine.SetOutputs([]operator.Operator{emitter}))
+
+	first, second := helper.NewAcknowledgement(), helper.NewAcknowledgement()
+	for _, body := range []string{"start", "next"} {
+		e := entry.New()
+		e.Body = body
+		require.NoError(t, recombine.Process(helper.ContextWithAcknowledgement(context.Background(), first), e))
+	}
+	e := entry.New()
+	e.Body = "start"
+	require.NoError(t, recombine.Process(helper.ContextWithAcknowledgement(context.Background(), second), e))
+	first.Seal()
+	second.Seal()
+
+	// the entries of the first batch are acknowledged once the combined entry is consumed
+	<-first.Done()
+	require.NoError(t, first.Err())
+	require.Len(t, consumed, 1)
+	assert.Equal(t, "start\nnext", consumed[0].Body)
+
+	select {
+	case <-second.Done():
+		require.FailNow(t, "Acknowledged an entry which is still batched")
+	default:
+	}
+	require.NoError(t, recombine.Stop())
+	<-second.Done()
+	require.Len(t, consumed, 2)
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/transformer/transform"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "transform"
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new transform operator config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new transform operator config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		TransformerConfig: helper.NewTransformerConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a transform operator
+type Config struct {
+	helper.TransformerConfig `mapstructure:",squash"`
+	Statements               []string `mapstructure:"statements"`
+}
+
+// Build will build a transform operator from the supplied configuration
+func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error) {
+	transformer, err := c.TransformerConfig.Build(set)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.Statements) == 0 {
+		return nil, errors.New("at least one statement must be specified")
+	}
+
+	parser, err := ottllog.NewParser(ottlfuncs.StandardFuncs[ottllog.TransformContext](), set)
+	if err != nil {
+		return nil, err
+	}
+	statements, err := parser.ParseStatements(c.Statements)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse statements: %w", err)
+	}
+
+	return &Transformer{
+		TransformerOperator: transformer,
+		statements:          ottl.NewStatementSequence(statements, set, ottl.WithStatementSequenceErrorMode[ottllog.TransformContext](ottl.PropagateError)),
+	}, nil
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
+)
+
+func TestUnmarshal(t *testing.T) {
+	operatortest.ConfigUnmarshalTests{
+		DefaultConfig: NewConfig(),
+		TestsFile:     filepath.Join(".", "testdata", "config.yaml"),
+		Tests: []operatortest.ConfigUnmarshalTest{
+			{
+				Name: "statements",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Statements = []string{
+						`merge_maps(attributes, ParseJSON(body), "upsert") where IsMatch(body, "^\\{")`,
+						`set(severity_text, attributes["level"])`,
+					}
+					return cfg
+				}(),
+			},
+			{
+				Name: "with_if",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.IfExpr = `body matches "^{"`
+					cfg.OnError = "send_quiet"
+					cfg.Statements = []string{`set(attributes["parsed"], true)`}
+					return cfg
+				}(),
+			},
+		},
+	}.Run(t)
+}
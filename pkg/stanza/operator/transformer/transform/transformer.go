@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/transformer/transform"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+// Transformer is an operator that executes OTTL statements against entries
+type Transformer struct {
+	helper.TransformerOperator
+	statements ottl.StatementSequence[ottllog.TransformContext]
+}
+
+// Process will execute the statements against an entry
+func (t *Transformer) Process(ctx context.Context, e *entry.Entry) error {
+	return t.ProcessWith(ctx, e, func(e *entry.Entry) error {
+		return t.transform(ctx, e)
+	})
+}
+
+// transform converts the entry to a log record, executes the statements against it, and converts it back
+func (t *Transformer) transform(ctx context.Context, e *entry.Entry) error {
+	resourceLogs := adapter.ConvertEntries([]*entry.Entry{e}).ResourceLogs().At(0)
+	resource := resourceLogs.Resource()
+	scopeLogs := resourceLogs.ScopeLogs().At(0)
+	scope := scopeLogs.Scope()
+	logRecord := scopeLogs.LogRecords().At(0)
+	severityNumber, severityText := logRecord.SeverityNumber(), logRecord.SeverityText()
+
+	tCtx := ottllog.NewTransformContext(logRecord, scope, resource, scopeLogs, resourceLogs)
+	if err := t.statements.Execute(ctx, tCtx); err != nil {
+		return err
+	}
+
+	severity, entrySeverityText := e.Severity, e.SeverityText
+	adapter.ConvertFrom(logRecord, e)
+	// the log record holds the default text of the severity when the entry has none, so keep the
+	// severity of the entry as it was unless it was changed
+	if logRecord.SeverityNumber() == severityNumber && logRecord.SeverityText() == severityText {
+		e.Severity = severity
+		e.SeverityText = entrySeverityText
+	}
+	e.Attributes = asRaw(logRecord.Attributes())
+	e.Resource = asRaw(resource.Attributes())
+	e.ScopeName = scope.Name()
+	return nil
+}
+
+// asRaw keeps the fields of the entry nil when they are empty, as they are on new entries
+func asRaw(m pcommon.Map) map[string]any {
+	if m.Len() == 0 {
+		return nil
+	}
+	return m.AsRaw()
+}
@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+type testCase struct {
+	name      string
+	op        *Config
+	input     func() *entry.Entry
+	output    func() *entry.Entry
+	expectErr bool
+}
+
+func TestProcessAndBuild(t *testing.T) {
+	newTestEntry := func() *entry.Entry {
+		e := entry.New()
+		e.ObservedTimestamp = time.Unix(1586632810, 0).UTC()
+		e.Timestamp = time.Unix(1586632809, 0).UTC()
+		e.Body = `{"level":"warn","message":"test","user":{"id":10}}`
+		e.Attributes = map[string]any{"key": "val"}
+		return e
+	}
+
+	cases := []testCase{
+		{
+			"parse_body",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.Statements = []string{
+					`merge_maps(attributes, ParseJSON(body), "upsert")`,
+					`set(body, attributes["message"])`,
+					`delete_key(attributes, "message")`,
+				}
+				return cfg
+			}(),
+			newTestEntry,
+			func() *entry.Entry {
+				e := newTestEntry()
+				e.Body = "test"
+				e.Attributes = map[string]any{
+					"key":   "val",
+					"level": "warn",
+					"user":  map[string]any{"id": float64(10)},
+				}
+				return e
+			},
+			false,
+		},
+		{
+			"severity_resource_and_scope",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.Statements = []string{
+					`set(severity_number, SEVERITY_NUMBER_WARN)`,
+					`set(severity_text, "warning")`,
+					`set(resource.attributes["host.name"], "host")`,
+					`set(instrumentation_scope.name, "scope")`,
+				}
+				return cfg
+			}(),
+			newTestEntry,
+			func() *entry.Entry {
+				e := newTestEntry()
+				e.Severity = entry.Warn
+				e.SeverityText = "warning"
+				e.Resource = map[string]any{"host.name": "host"}
+				e.ScopeName = "scope"
+				return e
+			},
+			false,
+		},
+		{
+			"severity_without_text",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.Statements = []string{`set(attributes["key"], "changed")`}
+				return cfg
+			}(),
+			func() *entry.Entry {
+				e := newTestEntry()
+				e.Severity = entry.Error
+				return e
+			},
+			func() *entry.Entry {
+				e := newTestEntry()
+				e.Severity = entry.Error
+				e.Attributes["key"] = "changed"
+				return e
+			},
+			false,
+		},
+		{
+			"where_clause",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.Statements = []string{
+					`set(attributes["matched"], true) where attributes["key"] == "val"`,
+					`set(attributes["unmatched"], true) where attributes["key"] == "other"`,
+				}
+				return cfg
+			}(),
+			newTestEntry,
+			func() *entry.Entry {
+				e := newTestEntry()
+				e.Attributes["matched"] = true
+				return e
+			},
+			false,
+		},
+		{
+			"remove_all_attributes",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.Statements = []string{`delete_key(attributes, "key")`}
+				return cfg
+			}(),
+			newTestEntry,
+			func() *entry.Entry {
+				e := newTestEntry()
+				e.Attributes = nil
+				return e
+			},
+			false,
+		},
+		{
+			"if_expr",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.IfExpr = `attributes.key == "other"`
+				cfg.Statements = []string{`set(attributes["key"], "changed")`}
+				return cfg
+			}(),
+			newTestEntry,
+			newTestEntry,
+			false,
+		},
+		{
+			"execution_error",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.Statements = []string{`set(attributes["parsed"], ParseJSON(attributes["key"]))`}
+				return cfg
+			}(),
+			newTestEntry,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run("BuildandProcess/"+tc.name, func(t *testing.T) {
+			cfg := tc.op
+			cfg.OutputIDs = []string{"fake"}
+			cfg.OnError = "drop"
+			set := componenttest.NewNopTelemetrySettings()
+			op, err := cfg.Build(set)
+			require.NoError(t, err)
+
+			transform := op.(*Transformer)
+			fake := testutil.NewFakeOutput(t)
+			require.NoError(t, transform.SetOutputs([]operator.Operator{fake}))
+			val := tc.input()
+			err = transform.Process(context.Background(), val)
+			if tc.expectErr {
+				require.Error(t, err)
+				fake.ExpectNoEntry(t, 100*time.Millisecond)
+			} else {
+				require.NoError(t, err)
+				fake.ExpectEntry(t, tc.output())
+			}
+		})
+	}
+}
+
+func TestBuildInvalid(t *testing.T) {
+	set := componenttest.NewNopTelemetrySettings()
+
+	cfg := NewConfig()
+	_, err := cfg.Build(set)
+	require.ErrorContains(t, err, "at least one statement")
+
+	cfg.Statements = []string{`set(attributes["key"]`}
+	_, err = cfg.Build(set)
+	require.ErrorContains(t, err, "failed to parse statements")
+
+	cfg.Statements = []string{`UnknownFunction(attributes)`}
+	_, err = cfg.Build(set)
+	require.Error(t, err)
+}
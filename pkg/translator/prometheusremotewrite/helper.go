@@ -521,6 +521,22 @@ func addResourceTargetInfo(resource pcommon.Resource, settings Settings, timesta
 		return
 	}
 
+	labels := createTargetInfoLabels(resource, settings)
+	if labels == nil {
+		return
+	}
+
+	sample := &prompb.Sample{
+		Value: float64(1),
+		// convert ns to ms
+		Timestamp: convertTimeStamp(timestamp),
+	}
+	converter.addSample(sample, labels)
+}
+
+// createTargetInfoLabels returns the labels of the target info metric of the resource, or nil if the
+// resource has no attributes other than the identifying ones, or no identifying attributes.
+func createTargetInfoLabels(resource pcommon.Resource, settings Settings) []prompb.Label {
 	attributes := resource.Attributes()
 	identifyingAttrs := []string{
 		conventions.AttributeServiceNamespace,
@@ -536,7 +552,7 @@ func addResourceTargetInfo(resource pcommon.Resource, settings Settings, timesta
 	}
 	if nonIdentifyingAttrsCount == 0 {
 		// If we only have job + instance, then target_info isn't useful, so don't add it.
-		return
+		return nil
 	}
 
 	name := prometheustranslator.TargetInfoMetricName
@@ -555,15 +571,9 @@ func addResourceTargetInfo(resource pcommon.Resource, settings Settings, timesta
 
 	if !haveIdentifier {
 		// We need at least one identifying label to generate target_info.
-		return
-	}
-
-	sample := &prompb.Sample{
-		Value: float64(1),
-		// convert ns to ms
-		Timestamp: convertTimeStamp(timestamp),
+		return nil
 	}
-	converter.addSample(sample, labels)
+	return labels
 }
 
 // convertTimeStamp converts OTLP timestamp in ns to timestamp in ms
@@ -6,6 +6,7 @@ package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/prometheus/prometheus/prompb"
@@ -18,6 +19,9 @@ import (
 )
 
 // FromMetricsV2 converts pmetric.Metrics to Prometheus remote write format 2.0.
+// The label names and values, exemplar labels and metadata of the returned time series are references to the
+// returned symbols table. Exponential histograms are converted to native histograms, and the start timestamps
+// of cumulative metrics are sent as created timestamps rather than as _created series.
 func FromMetricsV2(md pmetric.Metrics, settings Settings) (map[string]*writev2.TimeSeries, writev2.SymbolsTable, error) {
 	c := newPrometheusConverterV2()
 	errs := c.fromMetrics(md, settings)
@@ -32,14 +36,15 @@ func FromMetricsV2(md pmetric.Metrics, settings Settings) (map[string]*writev2.T
 
 // prometheusConverterV2 converts from OTLP to Prometheus write 2.0 format.
 type prometheusConverterV2 struct {
-	// TODO handle conflicts
 	unique      map[uint64]*writev2.TimeSeries
+	conflicts   map[uint64][]*writev2.TimeSeries
 	symbolTable writev2.SymbolsTable
 }
 
 func newPrometheusConverterV2() *prometheusConverterV2 {
 	return &prometheusConverterV2{
 		unique:      map[uint64]*writev2.TimeSeries{},
+		conflicts:   map[uint64][]*writev2.TimeSeries{},
 		symbolTable: writev2.NewSymbolTable(),
 	}
 }
@@ -68,6 +73,7 @@ func (c *prometheusConverterV2) fromMetrics(md pmetric.Metrics, settings Setting
 				}
 
 				promName := prometheustranslator.BuildCompliantName(metric, settings.Namespace, settings.AddMetricSuffixes)
+				metadata := c.metadata(metric)
 
 				// handle individual metrics based on type
 				//exhaustive:enforce
@@ -78,55 +84,171 @@ func (c *prometheusConverterV2) fromMetrics(md pmetric.Metrics, settings Setting
 						errs = multierr.Append(errs, fmt.Errorf("empty data points. %s is dropped", metric.Name()))
 						break
 					}
-					c.addGaugeNumberDataPoints(dataPoints, resource, settings, promName)
+					c.addGaugeNumberDataPoints(dataPoints, resource, settings, promName, metadata)
 				case pmetric.MetricTypeSum:
-					// TODO implement
+					dataPoints := metric.Sum().DataPoints()
+					if dataPoints.Len() == 0 {
+						errs = multierr.Append(errs, fmt.Errorf("empty data points. %s is dropped", metric.Name()))
+						break
+					}
+					c.addSumNumberDataPoints(dataPoints, resource, metric, settings, promName, metadata)
 				case pmetric.MetricTypeHistogram:
-					// TODO implement
+					dataPoints := metric.Histogram().DataPoints()
+					if dataPoints.Len() == 0 {
+						errs = multierr.Append(errs, fmt.Errorf("empty data points. %s is dropped", metric.Name()))
+						break
+					}
+					c.addHistogramDataPoints(dataPoints, resource, settings, promName, metadata)
 				case pmetric.MetricTypeExponentialHistogram:
-					// TODO implement
+					dataPoints := metric.ExponentialHistogram().DataPoints()
+					if dataPoints.Len() == 0 {
+						errs = multierr.Append(errs, fmt.Errorf("empty data points. %s is dropped", metric.Name()))
+						break
+					}
+					errs = multierr.Append(errs, c.addExponentialHistogramDataPoints(
+						dataPoints,
+						resource,
+						settings,
+						promName,
+						metadata,
+					))
 				case pmetric.MetricTypeSummary:
-					// TODO implement
+					dataPoints := metric.Summary().DataPoints()
+					if dataPoints.Len() == 0 {
+						errs = multierr.Append(errs, fmt.Errorf("empty data points. %s is dropped", metric.Name()))
+						break
+					}
+					c.addSummaryDataPoints(dataPoints, resource, settings, promName, metadata)
 				default:
 					errs = multierr.Append(errs, errors.New("unsupported metric type"))
 				}
 			}
 		}
-		// TODO implement
-		// addResourceTargetInfov2(resource, settings, mostRecentTimestamp, c)
+		addResourceTargetInfoV2(resource, settings, mostRecentTimestamp, c)
 	}
 
 	return
 }
 
+// metadata returns the metadata of the time series of the metric, whose help and unit are
+// references to the symbols table.
+func (c *prometheusConverterV2) metadata(metric pmetric.Metric) writev2.Metadata {
+	return writev2.Metadata{
+		Type:    otelMetricTypeToPromMetricTypeV2(metric),
+		HelpRef: c.symbolTable.Symbolize(metric.Description()),
+		UnitRef: c.symbolTable.Symbolize(metric.Unit()),
+	}
+}
+
 // timeSeries returns a slice of the writev2.TimeSeries that were converted from OTel format.
 func (c *prometheusConverterV2) timeSeries() []writev2.TimeSeries {
-	allTS := make([]writev2.TimeSeries, 0, len(c.unique))
+	conflicts := 0
+	for _, ts := range c.conflicts {
+		conflicts += len(ts)
+	}
+	allTS := make([]writev2.TimeSeries, 0, len(c.unique)+conflicts)
 	for _, ts := range c.unique {
 		allTS = append(allTS, *ts)
 	}
+	for _, cTS := range c.conflicts {
+		for _, ts := range cTS {
+			allTS = append(allTS, *ts)
+		}
+	}
 	return allTS
 }
 
-func (c *prometheusConverterV2) addSample(sample *writev2.Sample, lbls []prompb.Label) *writev2.TimeSeries {
+// symbolizeLabels returns the references to the names and values of the labels in the symbols table.
+func (c *prometheusConverterV2) symbolizeLabels(lbls []prompb.Label) []uint32 {
+	refs := make([]uint32, 0, len(lbls)*2)
+	for _, l := range lbls {
+		refs = append(refs, c.symbolTable.Symbolize(l.Name), c.symbolTable.Symbolize(l.Value))
+	}
+	return refs
+}
+
+// getOrCreateTimeSeries returns the time series corresponding to the label set if existent, and false.
+// Otherwise it creates a new one with the metadata and returns that, and true.
+func (c *prometheusConverterV2) getOrCreateTimeSeries(lbls []prompb.Label, metadata writev2.Metadata) (*writev2.TimeSeries, bool) {
+	// the signature sorts the labels, so that the references of identical label sets are equal
+	h := timeSeriesSignature(lbls)
+	refs := c.symbolizeLabels(lbls)
+	ts := c.unique[h]
+	if ts != nil {
+		if slices.Equal(ts.LabelsRefs, refs) {
+			// We already have this metric
+			return ts, false
+		}
+
+		// Look for a matching conflict
+		for _, cTS := range c.conflicts[h] {
+			if slices.Equal(cTS.LabelsRefs, refs) {
+				// We already have this metric
+				return cTS, false
+			}
+		}
+
+		// New conflict
+		ts = &writev2.TimeSeries{
+			LabelsRefs: refs,
+			Metadata:   metadata,
+		}
+		c.conflicts[h] = append(c.conflicts[h], ts)
+		return ts, true
+	}
+
+	// This metric is new
+	ts = &writev2.TimeSeries{
+		LabelsRefs: refs,
+		Metadata:   metadata,
+	}
+	c.unique[h] = ts
+	return ts, true
+}
+
+// addSample finds a TimeSeries that corresponds to lbls, and adds sample to it.
+// If there is no corresponding TimeSeries already, it's created with the metadata.
+// The corresponding TimeSeries is returned.
+// If either lbls is nil/empty or sample is nil, nothing is done.
+func (c *prometheusConverterV2) addSample(sample *writev2.Sample, lbls []prompb.Label, metadata writev2.Metadata) *writev2.TimeSeries {
 	if sample == nil || len(lbls) == 0 {
 		// This shouldn't happen
 		return nil
 	}
 
-	buf := make([]uint32, 0, len(lbls)*2)
-	var off uint32
-	for _, l := range lbls {
-		off = c.symbolTable.Symbolize(l.Name)
-		buf = append(buf, off)
-		off = c.symbolTable.Symbolize(l.Value)
-		buf = append(buf, off)
+	ts, _ := c.getOrCreateTimeSeries(lbls, metadata)
+	ts.Samples = append(ts.Samples, *sample)
+	return ts
+}
+
+// exemplars converts the exemplars to the 2.0 format, with their labels as references to the symbols table.
+func (c *prometheusConverterV2) exemplars(promExemplars []prompb.Exemplar) []writev2.Exemplar {
+	exemplars := make([]writev2.Exemplar, 0, len(promExemplars))
+	for _, e := range promExemplars {
+		exemplars = append(exemplars, writev2.Exemplar{
+			LabelsRefs: c.symbolizeLabels(e.Labels),
+			Value:      e.Value,
+			Timestamp:  e.Timestamp,
+		})
 	}
-	ts := writev2.TimeSeries{
-		LabelsRefs: buf,
-		Samples:    []writev2.Sample{*sample},
+	return exemplars
+}
+
+// addResourceTargetInfoV2 converts the resource to the target info metric.
+func addResourceTargetInfoV2(resource pcommon.Resource, settings Settings, timestamp pcommon.Timestamp, converter *prometheusConverterV2) {
+	if settings.DisableTargetInfo || timestamp == 0 {
+		return
 	}
-	c.unique[timeSeriesSignature(lbls)] = &ts
 
-	return &ts
+	labels := createTargetInfoLabels(resource, settings)
+	if labels == nil {
+		return
+	}
+
+	sample := &writev2.Sample{
+		Value: float64(1),
+		// convert ns to ms
+		Timestamp: convertTimeStamp(timestamp),
+	}
+	converter.addSample(sample, labels, writev2.Metadata{Type: writev2.Metadata_METRIC_TYPE_INFO})
 }
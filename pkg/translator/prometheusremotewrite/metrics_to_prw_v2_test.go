@@ -7,11 +7,44 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/prompb"
 	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
 )
 
+// desymbolizedV2 is a time series with its labels resolved from the symbols table.
+type desymbolizedV2 struct {
+	help, unit string
+	exemplars  []labels.Labels
+	ts         *writev2.TimeSeries
+}
+
+// desymbolizeV2 returns the time series by the string representation of their labels.
+func desymbolizeV2(t *testing.T, tsMap map[string]*writev2.TimeSeries, symbols []string) map[string]desymbolizedV2 {
+	b := labels.NewScratchBuilder(0)
+	out := make(map[string]desymbolizedV2, len(tsMap))
+	for _, ts := range tsMap {
+		s := desymbolizedV2{
+			help: symbols[ts.Metadata.HelpRef],
+			unit: symbols[ts.Metadata.UnitRef],
+			ts:   ts,
+		}
+		for _, e := range ts.Exemplars {
+			s.exemplars = append(s.exemplars, e.ToExemplar(&b, symbols).Labels)
+		}
+		key := ts.ToLabels(&b, symbols).String()
+		require.NotContains(t, out, key)
+		out[key] = s
+	}
+	return out
+}
+
 func TestFromMetricsV2(t *testing.T) {
 	settings := Settings{
 		Namespace:           "",
@@ -22,21 +55,238 @@ func TestFromMetricsV2(t *testing.T) {
 		SendMetadata:        false,
 	}
 
-	ts := uint64(time.Now().UnixNano())
-	payload := createExportRequest(5, 0, 1, 3, 0, pcommon.Timestamp(ts))
-	want := func() map[string]*writev2.TimeSeries {
-		return map[string]*writev2.TimeSeries{
-			"0": {
-				LabelsRefs: []uint32{1, 2, 3, 4, 5, 6, 7, 8},
-				Samples: []writev2.Sample{
-					{Timestamp: convertTimeStamp(pcommon.Timestamp(ts)), Value: 1.23},
-				},
-			},
-		}
-	}
-	wantedSymbols := []string{"", "series_name_2", "value-2", "series_name_3", "value-3", "__name__", "gauge_1", "series_name_1", "value-1"}
-	tsMap, symbolsTable, err := FromMetricsV2(payload.Metrics(), settings)
+	start := pcommon.NewTimestampFromTime(time.Unix(100, 0))
+	ts := pcommon.NewTimestampFromTime(time.Unix(200, 0))
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "svc")
+	rm.Resource().Attributes().PutStr("service.instance.id", "id")
+	rm.Resource().Attributes().PutStr("host.name", "host")
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("gauge")
+	gauge.SetDescription("a gauge")
+	gauge.SetUnit("1")
+	gaugePoint := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	gaugePoint.SetTimestamp(ts)
+	gaugePoint.SetDoubleValue(1.5)
+	gaugePoint.Attributes().PutStr("a", "b")
+
+	counter := metrics.AppendEmpty()
+	counter.SetName("counter")
+	counter.SetUnit("By")
+	sum := counter.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	counterPoint := sum.DataPoints().AppendEmpty()
+	counterPoint.SetStartTimestamp(start)
+	counterPoint.SetTimestamp(ts)
+	counterPoint.SetIntValue(5)
+	counterExemplar := counterPoint.Exemplars().AppendEmpty()
+	counterExemplar.SetTimestamp(ts)
+	counterExemplar.SetDoubleValue(3)
+	counterExemplar.SetTraceID(traceID)
+
+	hist := metrics.AppendEmpty()
+	hist.SetName("hist")
+	h := hist.SetEmptyHistogram()
+	h.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	histPoint := h.DataPoints().AppendEmpty()
+	histPoint.SetStartTimestamp(start)
+	histPoint.SetTimestamp(ts)
+	histPoint.SetCount(6)
+	histPoint.SetSum(10)
+	histPoint.ExplicitBounds().FromRaw([]float64{1, 2})
+	histPoint.BucketCounts().FromRaw([]uint64{1, 2, 3})
+	histExemplar := histPoint.Exemplars().AppendEmpty()
+	histExemplar.SetTimestamp(ts)
+	histExemplar.SetDoubleValue(1.5)
+	histExemplar.SetTraceID(traceID)
+
+	expHist := metrics.AppendEmpty()
+	expHist.SetName("exphist")
+	eh := expHist.SetEmptyExponentialHistogram()
+	eh.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	expHistPoint := eh.DataPoints().AppendEmpty()
+	expHistPoint.SetStartTimestamp(start)
+	expHistPoint.SetTimestamp(ts)
+	expHistPoint.SetCount(3)
+	expHistPoint.SetSum(5)
+	expHistPoint.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+
+	summary := metrics.AppendEmpty()
+	summary.SetName("summary")
+	summaryPoint := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	summaryPoint.SetTimestamp(ts)
+	summaryPoint.SetCount(2)
+	summaryPoint.SetSum(10)
+	quantile := summaryPoint.QuantileValues().AppendEmpty()
+	quantile.SetQuantile(0.5)
+	quantile.SetValue(3)
+
+	tsMap, symbolsTable, err := FromMetricsV2(md, settings)
 	require.NoError(t, err)
-	require.Equal(t, want(), tsMap)
-	require.ElementsMatch(t, wantedSymbols, symbolsTable.Symbols())
+	series := desymbolizeV2(t, tsMap, symbolsTable.Symbols())
+
+	wantSample := func(v float64) []writev2.Sample {
+		return []writev2.Sample{{Timestamp: convertTimeStamp(ts), Value: v}}
+	}
+	for _, tt := range []struct {
+		labels    string
+		typ       writev2.Metadata_MetricType
+		help      string
+		unit      string
+		samples   []writev2.Sample
+		created   int64
+		exemplars int
+	}{
+		{
+			labels:  `{__name__="gauge", a="b", instance="id", job="svc"}`,
+			typ:     writev2.Metadata_METRIC_TYPE_GAUGE,
+			help:    "a gauge",
+			unit:    "1",
+			samples: wantSample(1.5),
+		},
+		{
+			labels:    `{__name__="counter", instance="id", job="svc"}`,
+			typ:       writev2.Metadata_METRIC_TYPE_COUNTER,
+			unit:      "By",
+			samples:   wantSample(5),
+			created:   convertTimeStamp(start),
+			exemplars: 1,
+		},
+		{
+			labels:  `{__name__="hist_sum", instance="id", job="svc"}`,
+			typ:     writev2.Metadata_METRIC_TYPE_HISTOGRAM,
+			samples: wantSample(10),
+			created: convertTimeStamp(start),
+		},
+		{
+			labels:  `{__name__="hist_count", instance="id", job="svc"}`,
+			typ:     writev2.Metadata_METRIC_TYPE_HISTOGRAM,
+			samples: wantSample(6),
+			created: convertTimeStamp(start),
+		},
+		{
+			labels:  `{__name__="hist_bucket", instance="id", job="svc", le="1"}`,
+			typ:     writev2.Metadata_METRIC_TYPE_HISTOGRAM,
+			samples: wantSample(1),
+			created: convertTimeStamp(start),
+		},
+		{
+			labels:    `{__name__="hist_bucket", instance="id", job="svc", le="2"}`,
+			typ:       writev2.Metadata_METRIC_TYPE_HISTOGRAM,
+			samples:   wantSample(3),
+			created:   convertTimeStamp(start),
+			exemplars: 1,
+		},
+		{
+			labels:  `{__name__="hist_bucket", instance="id", job="svc", le="+Inf"}`,
+			typ:     writev2.Metadata_METRIC_TYPE_HISTOGRAM,
+			samples: wantSample(6),
+			created: convertTimeStamp(start),
+		},
+		{
+			labels:  `{__name__="exphist", instance="id", job="svc"}`,
+			typ:     writev2.Metadata_METRIC_TYPE_HISTOGRAM,
+			created: convertTimeStamp(start),
+		},
+		{
+			labels:  `{__name__="summary_sum", instance="id", job="svc"}`,
+			typ:     writev2.Metadata_METRIC_TYPE_SUMMARY,
+			samples: wantSample(10),
+		},
+		{
+			labels:  `{__name__="summary_count", instance="id", job="svc"}`,
+			typ:     writev2.Metadata_METRIC_TYPE_SUMMARY,
+			samples: wantSample(2),
+		},
+		{
+			labels:  `{__name__="summary", instance="id", job="svc", quantile="0.5"}`,
+			typ:     writev2.Metadata_METRIC_TYPE_SUMMARY,
+			samples: wantSample(3),
+		},
+		{
+			labels:  `{__name__="target_info", host_name="host", instance="id", job="svc"}`,
+			typ:     writev2.Metadata_METRIC_TYPE_INFO,
+			samples: wantSample(1),
+		},
+	} {
+		t.Run(tt.labels, func(t *testing.T) {
+			s, ok := series[tt.labels]
+			require.True(t, ok)
+			assert.Equal(t, tt.typ, s.ts.Metadata.Type)
+			assert.Equal(t, tt.help, s.help)
+			assert.Equal(t, tt.unit, s.unit)
+			assert.Equal(t, tt.samples, s.ts.Samples)
+			assert.Equal(t, tt.created, s.ts.CreatedTimestamp)
+			require.Len(t, s.exemplars, tt.exemplars)
+			for _, e := range s.exemplars {
+				assert.Equal(t, traceID.String(), e.Get(prometheustranslator.ExemplarTraceIDKey))
+			}
+		})
+	}
+	assert.Len(t, series, 12)
+
+	// exponential histograms are converted to native histograms
+	nativeHist := series[`{__name__="exphist", instance="id", job="svc"}`].ts.Histograms
+	require.Len(t, nativeHist, 1)
+	assert.Equal(t, convertTimeStamp(ts), nativeHist[0].Timestamp)
+	assert.Equal(t, 5.0, nativeHist[0].Sum)
+	assert.Equal(t, int32(0), nativeHist[0].Schema)
+	assert.Equal(t, []int64{1, 1}, nativeHist[0].PositiveDeltas)
+
+	t.Run("target_info disabled", func(t *testing.T) {
+		settings := settings
+		settings.DisableTargetInfo = true
+		tsMap, symbolsTable, err := FromMetricsV2(md, settings)
+		require.NoError(t, err)
+		series := desymbolizeV2(t, tsMap, symbolsTable.Symbols())
+		assert.Len(t, series, 11)
+		assert.NotContains(t, series, `{__name__="target_info", host_name="host", instance="id", job="svc"}`)
+	})
+}
+
+func TestPrometheusConverterV2_getOrCreateTimeSeries(t *testing.T) {
+	converter := newPrometheusConverterV2()
+	metadata := writev2.Metadata{Type: writev2.Metadata_METRIC_TYPE_GAUGE}
+	lbls := []prompb.Label{
+		{Name: "key2", Value: "value2"},
+		{Name: "key1", Value: "value1"},
+	}
+	ts, created := converter.getOrCreateTimeSeries(lbls, metadata)
+	require.NotNil(t, ts)
+	require.True(t, created)
+	assert.Equal(t, metadata, ts.Metadata)
+	b := labels.NewScratchBuilder(0)
+	assert.Equal(t, labels.FromStrings("key1", "value1", "key2", "value2"), ts.ToLabels(&b, converter.symbolTable.Symbols()))
+
+	// Now, get (not create) the unique time series, whatever the order of the labels
+	gotTS, created := converter.getOrCreateTimeSeries([]prompb.Label{
+		{Name: "key1", Value: "value1"},
+		{Name: "key2", Value: "value2"},
+	}, metadata)
+	require.Same(t, ts, gotTS)
+	require.False(t, created)
+	require.Len(t, converter.unique, 1)
+	require.Empty(t, converter.conflicts)
+
+	// Fake a hash collision, by making this not equal to the next series with the same hash
+	ts.LabelsRefs = append(ts.LabelsRefs, converter.symbolTable.Symbolize("key3"), converter.symbolTable.Symbolize("value3"))
+
+	cTS, created := converter.getOrCreateTimeSeries(lbls, metadata)
+	require.NotNil(t, cTS)
+	require.True(t, created)
+	for _, conflicts := range converter.conflicts {
+		require.Equal(t, []*writev2.TimeSeries{cTS}, conflicts)
+	}
+
+	// Now, get (not create) the colliding time series
+	gotCTS, created := converter.getOrCreateTimeSeries(lbls, metadata)
+	require.Same(t, cTS, gotCTS)
+	require.False(t, created)
+	assert.Len(t, converter.timeSeries(), 2)
 }
@@ -6,6 +6,7 @@ package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry
 import (
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
@@ -42,6 +43,30 @@ func otelMetricTypeToPromMetricType(otelMetric pmetric.Metric) prompb.MetricMeta
 	return prompb.MetricMetadata_UNKNOWN
 }
 
+// otelMetricTypeToPromMetricTypeV2 returns the type of the metric in the metadata of remote write 2.0.
+func otelMetricTypeToPromMetricTypeV2(otelMetric pmetric.Metric) writev2.Metadata_MetricType {
+	//exhaustive:enforce
+	switch otelMetricTypeToPromMetricType(otelMetric) {
+	case prompb.MetricMetadata_COUNTER:
+		return writev2.Metadata_METRIC_TYPE_COUNTER
+	case prompb.MetricMetadata_GAUGE:
+		return writev2.Metadata_METRIC_TYPE_GAUGE
+	case prompb.MetricMetadata_HISTOGRAM:
+		return writev2.Metadata_METRIC_TYPE_HISTOGRAM
+	case prompb.MetricMetadata_GAUGEHISTOGRAM:
+		return writev2.Metadata_METRIC_TYPE_GAUGEHISTOGRAM
+	case prompb.MetricMetadata_SUMMARY:
+		return writev2.Metadata_METRIC_TYPE_SUMMARY
+	case prompb.MetricMetadata_INFO:
+		return writev2.Metadata_METRIC_TYPE_INFO
+	case prompb.MetricMetadata_STATESET:
+		return writev2.Metadata_METRIC_TYPE_STATESET
+	case prompb.MetricMetadata_UNKNOWN:
+		return writev2.Metadata_METRIC_TYPE_UNSPECIFIED
+	}
+	return writev2.Metadata_METRIC_TYPE_UNSPECIFIED
+}
+
 func OtelMetricsToMetadata(md pmetric.Metrics, addMetricSuffixes bool) []*prompb.MetricMetadata {
 	resourceMetricsSlice := md.ResourceMetrics()
 
@@ -10,6 +10,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
 )
@@ -43,6 +44,10 @@ type Config struct {
 
 	// Context section allows specifying the source type to look for the IP. Available options: resource or record.
 	Context ContextID `mapstructure:"context"`
+
+	// Attributes specifies the attributes to look for the IP, the first one holding a valid IP address is used.
+	// Default: [source.address]
+	Attributes []attribute.Key `mapstructure:"attributes"`
 }
 
 var (
@@ -55,6 +60,10 @@ func (cfg *Config) Validate() error {
 		return errors.New("must specify at least one geo IP data provider when using the geoip processor")
 	}
 
+	if len(cfg.Attributes) == 0 {
+		return errors.New("must specify at least one attribute to look for the IP address")
+	}
+
 	// validate all provider's configuration
 	for providerID, providerConfig := range cfg.Providers {
 		if err := providerConfig.Validate(); err != nil {
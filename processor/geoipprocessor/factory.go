@@ -16,6 +16,8 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+	asn "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/asnprovider"
+	csv "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/csvprovider"
 	maxmind "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
 )
 
@@ -31,6 +33,8 @@ var (
 // providerFactories is a map that stores GeoIPProviderFactory instances, keyed by the provider type.
 var providerFactories = map[string]provider.GeoIPProviderFactory{
 	maxmind.TypeStr: &maxmind.Factory{},
+	csv.TypeStr:     &csv.Factory{},
+	asn.TypeStr:     &asn.Factory{},
 }
 
 // NewFactory creates a new processor factory with default configuration,
@@ -52,7 +56,8 @@ func getProviderFactory(key string) (provider.GeoIPProviderFactory, bool) {
 // createDefaultConfig returns a default configuration for the processor.
 func createDefaultConfig() component.Config {
 	return &Config{
-		Context: resource,
+		Context:    resource,
+		Attributes: defaultResourceAttributes,
 	}
 }
 
@@ -73,6 +78,9 @@ func createGeoIPProviders(
 
 		provider, err := factory.CreateGeoIPProvider(ctx, set, cfg)
 		if err != nil {
+			for _, created := range providers {
+				_ = created.Close(ctx)
+			}
 			return nil, fmt.Errorf("failed to create provider for key %q: %w", key, err)
 		}
 
@@ -88,7 +96,8 @@ func createMetricsProcessor(ctx context.Context, set processor.Settings, cfg com
 	if err != nil {
 		return nil, err
 	}
-	return processorhelper.NewMetrics(ctx, set, cfg, nextConsumer, newGeoIPProcessor(geoCfg, defaultResourceAttributes, providers, set).processMetrics, processorhelper.WithCapabilities(processorCapabilities))
+	geoProcessor := newGeoIPProcessor(geoCfg, geoCfg.Attributes, providers, set)
+	return processorhelper.NewMetrics(ctx, set, cfg, nextConsumer, geoProcessor.processMetrics, processorhelper.WithCapabilities(processorCapabilities), processorhelper.WithStart(geoProcessor.start), processorhelper.WithShutdown(geoProcessor.shutdown))
 }
 
 func createTracesProcessor(ctx context.Context, set processor.Settings, cfg component.Config, nextConsumer consumer.Traces) (processor.Traces, error) {
@@ -97,7 +106,8 @@ func createTracesProcessor(ctx context.Context, set processor.Settings, cfg comp
 	if err != nil {
 		return nil, err
 	}
-	return processorhelper.NewTraces(ctx, set, cfg, nextConsumer, newGeoIPProcessor(geoCfg, defaultResourceAttributes, providers, set).processTraces, processorhelper.WithCapabilities(processorCapabilities))
+	geoProcessor := newGeoIPProcessor(geoCfg, geoCfg.Attributes, providers, set)
+	return processorhelper.NewTraces(ctx, set, cfg, nextConsumer, geoProcessor.processTraces, processorhelper.WithCapabilities(processorCapabilities), processorhelper.WithStart(geoProcessor.start), processorhelper.WithShutdown(geoProcessor.shutdown))
 }
 
 func createLogsProcessor(ctx context.Context, set processor.Settings, cfg component.Config, nextConsumer consumer.Logs) (processor.Logs, error) {
@@ -106,5 +116,6 @@ func createLogsProcessor(ctx context.Context, set processor.Settings, cfg compon
 	if err != nil {
 		return nil, err
 	}
-	return processorhelper.NewLogs(ctx, set, cfg, nextConsumer, newGeoIPProcessor(geoCfg, defaultResourceAttributes, providers, set).processLogs, processorhelper.WithCapabilities(processorCapabilities))
+	geoProcessor := newGeoIPProcessor(geoCfg, geoCfg.Attributes, providers, set)
+	return processorhelper.NewLogs(ctx, set, cfg, nextConsumer, geoProcessor.processLogs, processorhelper.WithCapabilities(processorCapabilities), processorhelper.WithStart(geoProcessor.start), processorhelper.WithShutdown(geoProcessor.shutdown))
 }
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/otel/attribute"
@@ -110,6 +111,8 @@ func (g *geoIPProcessor) processAttributes(ctx context.Context, metadata pcommon
 		switch geoAttr.Value.Type() {
 		case attribute.FLOAT64:
 			metadata.PutDouble(string(geoAttr.Key), geoAttr.Value.AsFloat64())
+		case attribute.INT64:
+			metadata.PutInt(string(geoAttr.Key), geoAttr.Value.AsInt64())
 		case attribute.STRING:
 			metadata.PutStr(string(geoAttr.Key), geoAttr.Value.AsString())
 		}
@@ -117,3 +120,22 @@ func (g *geoIPProcessor) processAttributes(ctx context.Context, metadata pcommon
 
 	return nil
 }
+
+// start starts all the configured providers.
+func (g *geoIPProcessor) start(ctx context.Context, _ component.Host) error {
+	for _, geoProvider := range g.providers {
+		if err := geoProvider.Start(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shutdown closes all the configured providers.
+func (g *geoIPProcessor) shutdown(ctx context.Context) error {
+	var errs error
+	for _, geoProvider := range g.providers {
+		errs = errors.Join(errs, geoProvider.Close(ctx))
+	}
+	return errs
+}
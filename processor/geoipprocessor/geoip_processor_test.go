@@ -5,6 +5,7 @@ package geoipprocessor
 
 import (
 	"context"
+	"errors"
 	"net"
 	"path/filepath"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.opentelemetry.io/otel/attribute"
@@ -36,6 +38,8 @@ type providerFactoryMock struct {
 
 type providerMock struct {
 	LocationF func(context.Context, net.IP) (attribute.Set, error)
+	StartF    func(context.Context) error
+	CloseF    func(context.Context) error
 }
 
 var (
@@ -60,6 +64,20 @@ func (pm *providerMock) Location(ctx context.Context, ip net.IP) (attribute.Set,
 	return pm.LocationF(ctx, ip)
 }
 
+func (pm *providerMock) Start(ctx context.Context) error {
+	if pm.StartF == nil {
+		return nil
+	}
+	return pm.StartF(ctx)
+}
+
+func (pm *providerMock) Close(ctx context.Context) error {
+	if pm.CloseF == nil {
+		return nil
+	}
+	return pm.CloseF(ctx)
+}
+
 var baseMockProvider = providerMock{
 	LocationF: func(context.Context, net.IP) (attribute.Set, error) {
 		return attribute.Set{}, nil
@@ -205,7 +223,6 @@ func TestProcessor(t *testing.T) {
 	baseProviderMock.LocationF = func(_ context.Context, sourceIP net.IP) (attribute.Set, error) {
 		if sourceIP.Equal(net.IPv4(1, 2, 3, 4)) {
 			return attribute.NewSet([]attribute.KeyValue{
-				semconv.SourceAddress("1.2.3.4"),
 				attribute.String(conventions.AttributeGeoCityName, "Boxford"),
 				attribute.String(conventions.AttributeGeoContinentCode, "EU"),
 				attribute.String(conventions.AttributeGeoContinentName, "Europe"),
@@ -226,8 +243,52 @@ func TestProcessor(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			cfg := &Config{Context: tt.context, Providers: map[string]provider.Config{providerKey: &providerConfigMock{}}}
+			cfg := &Config{Context: tt.context, Attributes: tt.lookupAttributes, Providers: map[string]provider.Config{providerKey: &providerConfigMock{}}}
 			compareAllSignals(cfg, tt.goldenDir)(t)
 		})
 	}
 }
+
+func TestProcessAttributesTypes(t *testing.T) {
+	asnProvider := &providerMock{
+		LocationF: func(context.Context, net.IP) (attribute.Set, error) {
+			return attribute.NewSet(
+				attribute.Int64(conventions.AttributeASNumber, 15169),
+				attribute.String(conventions.AttributeASOrganizationName, "GOOGLE"),
+				attribute.Float64(conventions.AttributeGeoLocationLat, 1.5),
+			), nil
+		},
+	}
+	processor := newGeoIPProcessor(&Config{}, []attribute.Key{semconv.SourceAddressKey, semconv.ClientAddressKey}, []provider.GeoIPProvider{asnProvider}, processortest.NewNopSettings())
+
+	attributes := pcommon.NewMap()
+	attributes.PutStr(string(semconv.ClientAddressKey), "8.8.8.8")
+	require.NoError(t, processor.processAttributes(context.Background(), attributes))
+
+	require.Equal(t, map[string]any{
+		string(semconv.ClientAddressKey):        "8.8.8.8",
+		conventions.AttributeASNumber:           int64(15169),
+		conventions.AttributeASOrganizationName: "GOOGLE",
+		conventions.AttributeGeoLocationLat:     1.5,
+	}, attributes.AsRaw())
+}
+
+func TestProcessorShutdown(t *testing.T) {
+	var closed int
+	closingProvider := &providerMock{
+		CloseF: func(context.Context) error {
+			closed++
+			return nil
+		},
+	}
+	failingProvider := &providerMock{
+		CloseF: func(context.Context) error {
+			closed++
+			return errors.New("error closing provider")
+		},
+	}
+	processor := newGeoIPProcessor(&Config{}, defaultResourceAttributes, []provider.GeoIPProvider{closingProvider, failingProvider}, processortest.NewNopSettings())
+
+	require.EqualError(t, processor.shutdown(context.Background()), "error closing provider")
+	require.Equal(t, 2, closed)
+}
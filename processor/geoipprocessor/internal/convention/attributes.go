@@ -37,4 +37,10 @@ const (
 
 	// AttributeGeoLocationLon represents the attribute name for the longitude.
 	AttributeGeoLocationLon = "geo.location.lon"
+
+	// AttributeASNumber represents the attribute name for the autonomous system number.
+	AttributeASNumber = "as.number"
+
+	// AttributeASOrganizationName represents the attribute name for the organization owning the autonomous system.
+	AttributeASOrganizationName = "as.organization.name"
 )
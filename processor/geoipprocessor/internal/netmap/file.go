@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netmap // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/netmap"
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// ParseFunc builds a Map from the content of a file.
+type ParseFunc func(io.Reader) (*Map, error)
+
+// File is a Map loaded from a local file. When a reload interval is set, the file is checked for changes
+// periodically and reloaded when its modification time or size changes. Lookups keep using the previous
+// Map until the new one is completely loaded, and a file that fails to load doesn't replace it.
+type File struct {
+	path   string
+	parse  ParseFunc
+	logger *zap.Logger
+
+	reloadInterval time.Duration
+
+	current atomic.Pointer[Map]
+	// modification time and size of the loaded file
+	modTime time.Time
+	size    int64
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewFile loads the Map from the file in the given path. The file is only watched for changes once
+// Start is called, and a reload interval of 0 disables the reloading.
+func NewFile(path string, reloadInterval time.Duration, parse ParseFunc, logger *zap.Logger) (*File, error) {
+	f := &File{
+		path:           path,
+		parse:          parse,
+		logger:         logger,
+		reloadInterval: reloadInterval,
+		stop:           make(chan struct{}),
+	}
+	if _, err := f.reload(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Start watches the file for changes until Close is called.
+func (f *File) Start() {
+	if f.reloadInterval <= 0 {
+		return
+	}
+	f.wg.Add(1)
+	go f.watch(f.reloadInterval)
+}
+
+// Lookup returns the attributes of the most specific network containing the IP in the current Map.
+func (f *File) Lookup(ip net.IP) (attribute.Set, bool) {
+	return f.current.Load().Lookup(ip)
+}
+
+// Close stops watching the file for changes.
+func (f *File) Close() error {
+	select {
+	case <-f.stop:
+	default:
+		close(f.stop)
+	}
+	f.wg.Wait()
+	return nil
+}
+
+func (f *File) watch(interval time.Duration) {
+	defer f.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-f.stop:
+			return
+		case <-ticker.C:
+			reloaded, err := f.reload()
+			if err != nil {
+				f.logger.Error("Failed to reload the file, keeping the previous content", zap.String("path", f.path), zap.Error(err))
+			} else if reloaded {
+				f.logger.Info("Reloaded the file", zap.String("path", f.path), zap.Int("networks", f.current.Load().Len()))
+			}
+		}
+	}
+}
+
+// reload loads the file if it changed since it was last loaded. It reports whether the file was loaded.
+func (f *File) reload() (bool, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return false, fmt.Errorf("could not read file: %w", err)
+	}
+	if f.current.Load() != nil && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return false, nil
+	}
+
+	file, err := os.Open(f.path)
+	if err != nil {
+		return false, fmt.Errorf("could not open file: %w", err)
+	}
+	defer file.Close()
+
+	m, err := f.parse(file)
+	if err != nil {
+		return false, fmt.Errorf("could not parse file %s: %w", f.path, err)
+	}
+
+	f.current.Store(m)
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	return true, nil
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netmap
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap/zaptest"
+)
+
+// parseLines parses a file with a network and a name separated by a space on each line.
+func parseLines(r io.Reader) (*Map, error) {
+	m := New()
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		network, name, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			return nil, errors.New("invalid line")
+		}
+		prefix, err := ParseNetwork(network)
+		if err != nil {
+			return nil, err
+		}
+		m.Add(prefix, attribute.NewSet(attribute.String("name", name)))
+	}
+	return m, scanner.Err()
+}
+
+func lookupName(f *File, ip string) string {
+	attrs, _ := f.Lookup(net.ParseIP(ip))
+	name, _ := attrs.Value("name")
+	return name.AsString()
+}
+
+func TestNewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "networks")
+
+	_, err := NewFile(path, 0, parseLines, zaptest.NewLogger(t))
+	assert.ErrorContains(t, err, "could not read file")
+
+	require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o600))
+	_, err = NewFile(path, 0, parseLines, zaptest.NewLogger(t))
+	assert.ErrorContains(t, err, "could not parse file")
+
+	require.NoError(t, os.WriteFile(path, []byte("10.0.0.0/8 internal"), 0o600))
+	f, err := NewFile(path, 0, parseLines, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	assert.Equal(t, "internal", lookupName(f, "10.0.0.1"))
+	assert.NoError(t, f.Close())
+	assert.NoError(t, f.Close())
+}
+
+func TestFileReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "networks")
+	require.NoError(t, os.WriteFile(path, []byte("10.0.0.0/8 before"), 0o600))
+
+	f, err := NewFile(path, 10*time.Millisecond, parseLines, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, f.Close())
+	}()
+	assert.Equal(t, "before", lookupName(f, "10.0.0.1"))
+
+	// the file isn't watched before being started
+	require.NoError(t, os.WriteFile(path, []byte("10.0.0.0/8 after\n192.168.0.0/16 added"), 0o600))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, "before", lookupName(f, "10.0.0.1"))
+
+	f.Start()
+	assert.Eventually(t, func() bool {
+		return lookupName(f, "10.0.0.1") == "after" && lookupName(f, "192.168.0.1") == "added"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// an invalid file keeps the previous content
+	require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o600))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, "after", lookupName(f, "10.0.0.1"))
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package netmap provides lookup tables of IP networks to attributes, which can be loaded from a local file
+// and reloaded whenever the file changes on disk.
+package netmap // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/netmap"
+
+import (
+	"net"
+	"net/netip"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Map is a lookup table of IP networks to attributes. A lookup returns the attributes of the most specific
+// network containing the given IP, so that nested networks (e.g. a rack inside a datacenter) can be described.
+type Map struct {
+	// networks grouped by their prefix length
+	networks map[int]map[netip.Prefix]attribute.Set
+	// prefix lengths of the known networks, from the most to the least specific
+	bits []int
+}
+
+// New creates an empty Map.
+func New() *Map {
+	return &Map{networks: map[int]map[netip.Prefix]attribute.Set{}}
+}
+
+// Add associates the attributes to the given network. The attributes of a network which was already added are replaced.
+func (m *Map) Add(network netip.Prefix, attrs attribute.Set) {
+	network = unmapPrefix(network).Masked()
+	networks, ok := m.networks[network.Bits()]
+	if !ok {
+		networks = map[netip.Prefix]attribute.Set{}
+		m.networks[network.Bits()] = networks
+		m.bits = append(m.bits, network.Bits())
+		slices.SortFunc(m.bits, func(a, b int) int { return b - a })
+	}
+	networks[network] = attrs
+}
+
+// Lookup returns the attributes of the most specific network containing the IP.
+func (m *Map) Lookup(ip net.IP) (attribute.Set, bool) {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return attribute.Set{}, false
+	}
+	addr = addr.Unmap()
+
+	for _, bits := range m.bits {
+		if bits > addr.BitLen() {
+			continue
+		}
+		network, err := addr.Prefix(bits)
+		if err != nil {
+			continue
+		}
+		if attrs, found := m.networks[bits][network]; found {
+			return attrs, true
+		}
+	}
+	return attribute.Set{}, false
+}
+
+// Len returns the number of networks in the Map.
+func (m *Map) Len() int {
+	var count int
+	for _, networks := range m.networks {
+		count += len(networks)
+	}
+	return count
+}
+
+// ParseNetwork parses a network in CIDR notation, or a single IP address.
+func ParseNetwork(s string) (netip.Prefix, error) {
+	if network, err := netip.ParsePrefix(s); err == nil {
+		return network, nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// unmapPrefix converts an IPv4-mapped IPv6 network to its IPv4 form, e.g. ::ffff:10.0.0.0/104 to 10.0.0.0/8.
+func unmapPrefix(network netip.Prefix) netip.Prefix {
+	if !network.Addr().Is4In6() {
+		return network
+	}
+	bits := network.Bits() - 96
+	if bits < 0 {
+		bits = 0
+	}
+	return netip.PrefixFrom(network.Addr().Unmap(), bits)
+}
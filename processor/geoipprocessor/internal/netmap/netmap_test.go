@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netmap
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestLookup(t *testing.T) {
+	datacenter := attribute.NewSet(attribute.String("datacenter", "dc1"))
+	rack := attribute.NewSet(attribute.String("datacenter", "dc1"), attribute.String("rack", "r42"))
+	host := attribute.NewSet(attribute.String("host", "db1"))
+	ipv6 := attribute.NewSet(attribute.String("vpc", "vpc-6"))
+
+	m := New()
+	m.Add(netip.MustParsePrefix("10.0.0.0/8"), datacenter)
+	m.Add(netip.MustParsePrefix("10.1.2.0/24"), rack)
+	m.Add(netip.MustParsePrefix("::ffff:10.1.2.3/128"), host)
+	m.Add(netip.MustParsePrefix("2001:db8::/32"), ipv6)
+	assert.Equal(t, 4, m.Len())
+
+	tests := []struct {
+		name     string
+		ip       net.IP
+		expected attribute.Set
+		found    bool
+	}{
+		{
+			name:     "least specific network",
+			ip:       net.ParseIP("10.200.0.1"),
+			expected: datacenter,
+			found:    true,
+		},
+		{
+			name:     "nested network",
+			ip:       net.ParseIP("10.1.2.200"),
+			expected: rack,
+			found:    true,
+		},
+		{
+			name:     "IPv4-mapped single address",
+			ip:       net.IPv4(10, 1, 2, 3).To4(),
+			expected: host,
+			found:    true,
+		},
+		{
+			name:     "IPv6 network",
+			ip:       net.ParseIP("2001:db8::1"),
+			expected: ipv6,
+			found:    true,
+		},
+		{
+			name: "unknown network",
+			ip:   net.ParseIP("192.168.0.1"),
+		},
+		{
+			name: "invalid IP",
+			ip:   net.IP{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs, found := m.Lookup(tt.ip)
+			assert.Equal(t, tt.found, found)
+			assert.True(t, tt.expected.Equals(&attrs))
+		})
+	}
+}
+
+func TestParseNetwork(t *testing.T) {
+	network, err := ParseNetwork("10.0.0.0/8")
+	assert.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), network)
+
+	network, err = ParseNetwork("10.1.2.3")
+	assert.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("10.1.2.3/32"), network)
+
+	network, err = ParseNetwork("2001:db8::1")
+	assert.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("2001:db8::1/128"), network)
+
+	_, err = ParseNetwork("not a network")
+	assert.Error(t, err)
+}
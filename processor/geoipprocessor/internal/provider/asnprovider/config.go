@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package asn // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/asnprovider"
+
+import (
+	"errors"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+)
+
+// Config defines configuration for the ASN provider.
+type Config struct {
+	// DatabasePath section allows specifying a local CSV file mapping
+	// networks to their autonomous system.
+	DatabasePath string `mapstructure:"database_path"`
+
+	// ReloadInterval is the interval to check the file for changes, which
+	// are loaded without restarting the collector. Set to 0 to disable it.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+}
+
+var _ provider.Config = (*Config)(nil)
+
+// Validate implements provider.Config.
+func (c *Config) Validate() error {
+	if c.DatabasePath == "" {
+		return errors.New("a local ASN database path must be provided")
+	}
+	if c.ReloadInterval < 0 {
+		return errors.New("the reload interval must not be negative")
+	}
+	return nil
+}
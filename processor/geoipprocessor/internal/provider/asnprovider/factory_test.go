@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package asn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+func TestCreateDefaultConfig(t *testing.T) {
+	factory := &Factory{}
+	cfg := factory.CreateDefaultConfig()
+	assert.Equal(t, &Config{ReloadInterval: time.Minute}, cfg)
+}
+
+func TestCreateProvider(t *testing.T) {
+	factory := &Factory{}
+	cfg := &Config{
+		DatabasePath: "",
+	}
+
+	provider, err := factory.CreateGeoIPProvider(context.Background(), processortest.NewNopSettings(), cfg)
+
+	assert.ErrorContains(t, err, "could not load ASN database")
+	assert.Nil(t, provider)
+}
+
+func TestValidateConfig(t *testing.T) {
+	assert.EqualError(t, (&Config{}).Validate(), "a local ASN database path must be provided")
+	assert.EqualError(t, (&Config{DatabasePath: "networks.csv", ReloadInterval: -time.Second}).Validate(), "the reload interval must not be negative")
+	assert.NoError(t, (&Config{DatabasePath: "networks.csv"}).Validate())
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package asn // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/asnprovider"
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	conventions "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/netmap"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+)
+
+// numColumns is the number of columns of the database: network, autonomous system number and organization.
+const numColumns = 3
+
+type asnProvider struct {
+	networks *netmap.File
+}
+
+var _ provider.GeoIPProvider = (*asnProvider)(nil)
+
+func newASNProvider(cfg *Config, logger *zap.Logger) (*asnProvider, error) {
+	networks, err := netmap.NewFile(cfg.DatabasePath, cfg.ReloadInterval, parseASN, logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not load ASN database: %w", err)
+	}
+	return &asnProvider{networks: networks}, nil
+}
+
+// Location implements provider.GeoIPProvider for the ASN provider. The autonomous system of the most specific
+// network containing the IP is returned.
+func (p *asnProvider) Location(_ context.Context, ipAddress net.IP) (attribute.Set, error) {
+	attrs, found := p.networks.Lookup(ipAddress)
+	if !found {
+		return attribute.Set{}, provider.ErrNoMetadataFound
+	}
+	return attrs, nil
+}
+
+// Start implements provider.GeoIPProvider, watching the database for changes.
+func (p *asnProvider) Start(_ context.Context) error {
+	p.networks.Start()
+	return nil
+}
+
+// Close implements provider.GeoIPProvider.
+func (p *asnProvider) Close(_ context.Context) error {
+	return p.networks.Close()
+}
+
+// parseASN parses a CSV file with the network, the autonomous system number and the organization owning it on
+// each row, which is the format of the GeoLite2 ASN CSV databases. An optional header is skipped.
+func parseASN(r io.Reader) (*netmap.Map, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = numColumns
+
+	networks := netmap.New()
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return networks, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		network, err := netmap.ParseNetwork(record[0])
+		if err != nil {
+			if first {
+				// header
+				continue
+			}
+			return nil, fmt.Errorf("invalid network on line %d: %w", line, err)
+		}
+		number, err := strconv.ParseInt(record[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid autonomous system number on line %d: %w", line, err)
+		}
+
+		attrs := []attribute.KeyValue{attribute.Int64(conventions.AttributeASNumber, number)}
+		if record[2] != "" {
+			attrs = append(attrs, attribute.String(conventions.AttributeASOrganizationName, record[2]))
+		}
+		networks.Add(network, attribute.NewSet(attrs...))
+	}
+}
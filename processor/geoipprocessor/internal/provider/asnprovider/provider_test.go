@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package asn
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap/zaptest"
+
+	conventions "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
+)
+
+const testDatabase = `network,autonomous_system_number,autonomous_system_organization
+1.0.0.0/24,13335,CLOUDFLARENET
+8.8.8.0/24,15169,GOOGLE
+2001:4860::/32,15169,GOOGLE
+192.0.2.0/24,64496,
+`
+
+func writeDatabase(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "asn.csv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestProviderLocation(t *testing.T) {
+	p, err := newASNProvider(&Config{DatabasePath: writeDatabase(t, testDatabase)}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, p.Close(context.Background()))
+	}()
+
+	tests := []struct {
+		name               string
+		sourceIP           net.IP
+		expectedAttributes attribute.Set
+		expectedErrMsg     string
+	}{
+		{
+			name:     "IPv4 network",
+			sourceIP: net.IPv4(8, 8, 8, 8),
+			expectedAttributes: attribute.NewSet(
+				attribute.Int64(conventions.AttributeASNumber, 15169),
+				attribute.String(conventions.AttributeASOrganizationName, "GOOGLE"),
+			),
+		},
+		{
+			name:     "IPv6 network",
+			sourceIP: net.ParseIP("2001:4860:4860::8888"),
+			expectedAttributes: attribute.NewSet(
+				attribute.Int64(conventions.AttributeASNumber, 15169),
+				attribute.String(conventions.AttributeASOrganizationName, "GOOGLE"),
+			),
+		},
+		{
+			name:     "no organization",
+			sourceIP: net.IPv4(192, 0, 2, 1),
+			expectedAttributes: attribute.NewSet(
+				attribute.Int64(conventions.AttributeASNumber, 64496),
+			),
+		},
+		{
+			name:           "unknown network",
+			sourceIP:       net.IPv4(10, 0, 0, 1),
+			expectedErrMsg: "no geo IP metadata found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualAttributes, err := p.Location(context.Background(), tt.sourceIP)
+			if tt.expectedErrMsg != "" {
+				assert.EqualError(t, err, tt.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, tt.expectedAttributes.Equals(&actualAttributes))
+		})
+	}
+}
+
+func TestProviderReload(t *testing.T) {
+	path := writeDatabase(t, "1.0.0.0/24,13335,CLOUDFLARENET\n")
+	p, err := newASNProvider(&Config{DatabasePath: path, ReloadInterval: 10 * time.Millisecond}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background()))
+	defer func() {
+		assert.NoError(t, p.Close(context.Background()))
+	}()
+
+	_, err = p.Location(context.Background(), net.IPv4(8, 8, 8, 8))
+	require.EqualError(t, err, "no geo IP metadata found")
+
+	require.NoError(t, os.WriteFile(path, []byte("1.0.0.0/24,13335,CLOUDFLARENET\n8.8.8.0/24,15169,GOOGLE\n"), 0o600))
+	assert.Eventually(t, func() bool {
+		_, err := p.Location(context.Background(), net.IPv4(8, 8, 8, 8))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestInvalidDatabase(t *testing.T) {
+	_, err := newASNProvider(&Config{DatabasePath: writeDatabase(t, "network,asn,organization\n1.0.0.0/24,AS13335,CLOUDFLARENET\n")}, zaptest.NewLogger(t))
+	assert.ErrorContains(t, err, "invalid autonomous system number on line 2")
+
+	_, err = newASNProvider(&Config{DatabasePath: writeDatabase(t, "1.0.0.0/24,13335,CLOUDFLARENET\ninvalid,1,ORG\n")}, zaptest.NewLogger(t))
+	assert.ErrorContains(t, err, "invalid network on line 2")
+
+	_, err = newASNProvider(&Config{DatabasePath: writeDatabase(t, "1.0.0.0/24,13335\n")}, zaptest.NewLogger(t))
+	assert.ErrorContains(t, err, "wrong number of fields")
+}
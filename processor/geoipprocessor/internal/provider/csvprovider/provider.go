@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package csv // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/csvprovider"
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	conventions "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/netmap"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+)
+
+// networkColumn is the name of the column holding the network of each row.
+const networkColumn = "network"
+
+var errMissingNetworkColumn = fmt.Errorf("missing %q column in the header", networkColumn)
+
+type csvProvider struct {
+	networks *netmap.File
+}
+
+var _ provider.GeoIPProvider = (*csvProvider)(nil)
+
+func newCSVProvider(cfg *Config, logger *zap.Logger) (*csvProvider, error) {
+	networks, err := netmap.NewFile(cfg.DatabasePath, cfg.ReloadInterval, parseCSV, logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not load CSV database: %w", err)
+	}
+	return &csvProvider{networks: networks}, nil
+}
+
+// Location implements provider.GeoIPProvider for the CSV provider. The attributes of the most specific network
+// containing the IP are returned.
+func (p *csvProvider) Location(_ context.Context, ipAddress net.IP) (attribute.Set, error) {
+	attrs, found := p.networks.Lookup(ipAddress)
+	if !found {
+		return attribute.Set{}, provider.ErrNoMetadataFound
+	}
+	return attrs, nil
+}
+
+// Start implements provider.GeoIPProvider, watching the database for changes.
+func (p *csvProvider) Start(_ context.Context) error {
+	p.networks.Start()
+	return nil
+}
+
+// Close implements provider.GeoIPProvider.
+func (p *csvProvider) Close(_ context.Context) error {
+	return p.networks.Close()
+}
+
+// parseCSV parses a CSV file whose header names the attributes of each column, besides the network column
+// holding the network in CIDR notation or a single IP address. Empty values are not added as attributes.
+func parseCSV(r io.Reader) (*netmap.Map, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errMissingNetworkColumn
+	} else if err != nil {
+		return nil, err
+	}
+	networkIndex := -1
+	for i, column := range header {
+		if column == networkColumn {
+			networkIndex = i
+		}
+	}
+	if networkIndex < 0 {
+		return nil, errMissingNetworkColumn
+	}
+
+	networks := netmap.New()
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return networks, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		network, err := netmap.ParseNetwork(record[networkIndex])
+		if err != nil {
+			return nil, fmt.Errorf("invalid network on line %d: %w", line, err)
+		}
+
+		attrs := make([]attribute.KeyValue, 0, len(record)-1)
+		for i, value := range record {
+			if i == networkIndex || value == "" {
+				continue
+			}
+			attr, err := parseAttribute(header[i], value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %q on line %d: %w", header[i], line, err)
+			}
+			attrs = append(attrs, attr)
+		}
+		networks.Add(network, attribute.NewSet(attrs...))
+	}
+}
+
+// parseAttribute parses the value according to the type of the known attributes, other attributes are strings.
+func parseAttribute(key, value string) (attribute.KeyValue, error) {
+	switch key {
+	case conventions.AttributeGeoLocationLat, conventions.AttributeGeoLocationLon:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return attribute.KeyValue{}, err
+		}
+		return attribute.Float64(key, f), nil
+	case conventions.AttributeASNumber:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return attribute.KeyValue{}, err
+		}
+		return attribute.Int64(key, i), nil
+	default:
+		return attribute.String(key, value), nil
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package csv
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap/zaptest"
+
+	conventions "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
+)
+
+const testDatabase = `# internal network map
+network,geo.city_name,geo.location.lat,geo.location.lon,as.number,datacenter,rack
+10.0.0.0/8,Amsterdam,52.37,4.89,64512,ams1,
+10.1.2.0/24,Amsterdam,52.37,4.89,64512,ams1,r42
+2001:db8::/32,Frankfurt,,,,fra1,
+`
+
+func writeDatabase(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "networks.csv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestProviderLocation(t *testing.T) {
+	p, err := newCSVProvider(&Config{DatabasePath: writeDatabase(t, testDatabase)}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, p.Close(context.Background()))
+	}()
+
+	tests := []struct {
+		name               string
+		sourceIP           net.IP
+		expectedAttributes attribute.Set
+		expectedErrMsg     string
+	}{
+		{
+			name:     "datacenter network",
+			sourceIP: net.IPv4(10, 200, 0, 1),
+			expectedAttributes: attribute.NewSet(
+				attribute.String(conventions.AttributeGeoCityName, "Amsterdam"),
+				attribute.Float64(conventions.AttributeGeoLocationLat, 52.37),
+				attribute.Float64(conventions.AttributeGeoLocationLon, 4.89),
+				attribute.Int64(conventions.AttributeASNumber, 64512),
+				attribute.String("datacenter", "ams1"),
+			),
+		},
+		{
+			name:     "rack network",
+			sourceIP: net.IPv4(10, 1, 2, 3),
+			expectedAttributes: attribute.NewSet(
+				attribute.String(conventions.AttributeGeoCityName, "Amsterdam"),
+				attribute.Float64(conventions.AttributeGeoLocationLat, 52.37),
+				attribute.Float64(conventions.AttributeGeoLocationLon, 4.89),
+				attribute.Int64(conventions.AttributeASNumber, 64512),
+				attribute.String("datacenter", "ams1"),
+				attribute.String("rack", "r42"),
+			),
+		},
+		{
+			name:     "IPv6 network",
+			sourceIP: net.ParseIP("2001:db8::1"),
+			expectedAttributes: attribute.NewSet(
+				attribute.String(conventions.AttributeGeoCityName, "Frankfurt"),
+				attribute.String("datacenter", "fra1"),
+			),
+		},
+		{
+			name:           "unknown network",
+			sourceIP:       net.IPv4(192, 168, 0, 1),
+			expectedErrMsg: "no geo IP metadata found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualAttributes, err := p.Location(context.Background(), tt.sourceIP)
+			if tt.expectedErrMsg != "" {
+				assert.EqualError(t, err, tt.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, tt.expectedAttributes.Equals(&actualAttributes))
+		})
+	}
+}
+
+func TestInvalidDatabase(t *testing.T) {
+	tests := []struct {
+		name           string
+		content        string
+		expectedErrMsg string
+	}{
+		{
+			name:           "empty file",
+			content:        "",
+			expectedErrMsg: `missing "network" column in the header`,
+		},
+		{
+			name:           "missing network column",
+			content:        "cidr,datacenter\n10.0.0.0/8,ams1\n",
+			expectedErrMsg: `missing "network" column in the header`,
+		},
+		{
+			name:           "invalid network",
+			content:        "network,datacenter\n10.0.0.0/8,ams1\n10.0.0.0/64,ams1\n",
+			expectedErrMsg: "invalid network on line 3",
+		},
+		{
+			name:           "invalid latitude",
+			content:        "network,geo.location.lat\n10.0.0.0/8,north\n",
+			expectedErrMsg: `invalid value for "geo.location.lat" on line 2`,
+		},
+		{
+			name:           "wrong number of fields",
+			content:        "network,datacenter\n10.0.0.0/8\n",
+			expectedErrMsg: "wrong number of fields",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newCSVProvider(&Config{DatabasePath: writeDatabase(t, tt.content)}, zaptest.NewLogger(t))
+			assert.ErrorContains(t, err, tt.expectedErrMsg)
+		})
+	}
+}
@@ -25,6 +25,12 @@ type Config interface {
 type GeoIPProvider interface {
 	// Location returns a set of attributes representing the geographical location for the given IP address. It requires a context for managing request lifetime.
 	Location(context.Context, net.IP) (attribute.Set, error)
+
+	// Start starts the background routines of the provider, such as watching its database for changes.
+	Start(context.Context) error
+
+	// Close releases the resources held by the provider, such as open files or background routines.
+	Close(context.Context) error
 }
 
 // GeoIPProviderFactory can create GeoIPProvider instances.
@@ -58,6 +58,16 @@ func (g *maxMindProvider) Location(_ context.Context, ipAddress net.IP) (attribu
 	}
 }
 
+// Start implements provider.GeoIPProvider for MaxMind, the database is opened when the provider is created.
+func (g *maxMindProvider) Start(_ context.Context) error {
+	return nil
+}
+
+// Close implements provider.GeoIPProvider for MaxMind, closing the database.
+func (g *maxMindProvider) Close(_ context.Context) error {
+	return g.geoReader.Close()
+}
+
 // cityAttributes returns a list of key-values containing geographical metadata associated to the provided IP. The key names are populated using the internal geo IP conventions package. If the an invalid or nil IP is provided, an error is returned.
 func (g *maxMindProvider) cityAttributes(ipAddress net.IP) (*[]attribute.KeyValue, error) {
 	attributes := make([]attribute.KeyValue, 0, 11)
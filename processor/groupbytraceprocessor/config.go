@@ -5,6 +5,8 @@ package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"time"
+
+	"go.opentelemetry.io/collector/component"
 )
 
 // Config is the configuration for the processor.
@@ -27,9 +29,13 @@ type Config struct {
 	// Not yet implemented, and an error will be returned when this option is used.
 	DiscardOrphans bool `mapstructure:"discard_orphans"`
 
-	// StoreOnDisk tells the processor to keep only the trace ID in memory, serializing the trace spans to disk.
-	// Useful when the duration to wait for traces to complete is high.
-	// Default: false.
-	// Not yet implemented, and an error will be returned when this option is used.
+	// Deprecated: [v0.115.0] Use StorageID instead, the spans are kept in the storage extension it refers to.
+	// StoreOnDisk is only accepted when false, and an error will be returned when this option is set to true.
 	StoreOnDisk bool `mapstructure:"store_on_disk"`
+
+	// StorageID is the ID of the storage extension used to keep the spans of the pending traces,
+	// with only the trace IDs kept in memory. Traces that are pending when the collector stops are
+	// recovered from the storage once the processor starts again.
+	// Default: nil, keeping all the spans in memory.
+	StorageID *component.ID `mapstructure:"storage"`
 }
@@ -24,7 +24,7 @@ const (
 )
 
 var (
-	errDiskStorageNotSupported    = fmt.Errorf("option 'disk storage' not supported in this release")
+	errDiskStorageNotSupported    = fmt.Errorf("option 'store_on_disk' is deprecated, use 'storage' to keep the spans in a storage extension")
 	errDiscardOrphansNotSupported = fmt.Errorf("option 'discard orphans' not supported in this release")
 )
 
@@ -45,7 +45,9 @@ func createDefaultConfig() component.Config {
 
 		// not supported for now
 		DiscardOrphans: defaultDiscardOrphans,
-		StoreOnDisk:    defaultStoreOnDisk,
+
+		// deprecated in favor of the storage extension
+		StoreOnDisk: defaultStoreOnDisk,
 	}
 }
 
@@ -58,7 +60,6 @@ func createTracesProcessor(
 ) (processor.Traces, error) {
 	oCfg := cfg.(*Config)
 
-	var st storage
 	if oCfg.StoreOnDisk {
 		return nil, errDiskStorageNotSupported
 	}
@@ -67,8 +68,10 @@ func createTracesProcessor(
 	}
 
 	processor := newGroupByTraceProcessor(params, nextConsumer, *oCfg)
-	// the only supported storage for now
-	st = newMemoryStorage(processor.telemetryBuilder)
-	processor.st = st
+	if oCfg.StorageID == nil {
+		processor.st = newMemoryStorage(processor.telemetryBuilder)
+	}
+	// otherwise, the persistent storage is created once the storage extension
+	// is available at start
 	return processor, nil
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
@@ -64,3 +65,16 @@ func TestCreateTestProcessorWithNotImplementedOptions(t *testing.T) {
 		assert.Nil(t, p)
 	}
 }
+
+func TestCreateTestProcessorWithStorage(t *testing.T) {
+	c := createDefaultConfig().(*Config)
+	storageID := component.MustNewIDWithName("file_storage", "groupbytrace")
+	c.StorageID = &storageID
+
+	// test
+	p, err := createTracesProcessor(context.Background(), processortest.NewNopSettings(), c, consumertest.NewNop())
+
+	// verify
+	assert.NoError(t, err)
+	assert.NotNil(t, p)
+}
@@ -33,6 +33,7 @@ import (
 // Each worker in the eventMachine also uses a ring buffer to hold the in-flight trace IDs, so that we don't hold more than the given maximum number
 // of traces in memory/storage. Items that are evicted from the buffer are discarded without warning.
 type groupByTraceProcessor struct {
+	id               component.ID
 	nextConsumer     consumer.Traces
 	config           Config
 	logger           *zap.Logger
@@ -59,6 +60,7 @@ func newGroupByTraceProcessor(set processor.Settings, nextConsumer consumer.Trac
 	eventMachine := newEventMachine(set.Logger, 10000, config.NumWorkers, config.NumTraces, telemetryBuilder)
 
 	sp := &groupByTraceProcessor{
+		id:               set.ID,
 		logger:           set.Logger,
 		nextConsumer:     nextConsumer,
 		config:           config,
@@ -71,6 +73,7 @@ func newGroupByTraceProcessor(set processor.Settings, nextConsumer consumer.Trac
 	eventMachine.onTraceExpired = sp.onTraceExpired
 	eventMachine.onTraceReleased = sp.onTraceReleased
 	eventMachine.onTraceRemoved = sp.onTraceRemoved
+	eventMachine.onTraceRecovered = sp.onTraceRecovered
 
 	return sp
 }
@@ -88,18 +91,46 @@ func (sp *groupByTraceProcessor) Capabilities() consumer.Capabilities {
 }
 
 // Start is invoked during service startup.
-func (sp *groupByTraceProcessor) Start(context.Context, component.Host) error {
+func (sp *groupByTraceProcessor) Start(ctx context.Context, host component.Host) error {
+	if sp.config.StorageID != nil {
+		client, err := getStorageClient(ctx, host, *sp.config.StorageID, sp.id)
+		if err != nil {
+			return err
+		}
+		sp.st = newPersistentStorage(client, sp.telemetryBuilder)
+	}
+
 	// start these metrics, as it might take a while for them to receive their first event
 	sp.telemetryBuilder.ProcessorGroupbytraceTracesEvicted.Add(context.Background(), 0)
 	sp.telemetryBuilder.ProcessorGroupbytraceIncompleteReleases.Add(context.Background(), 0)
 	sp.telemetryBuilder.ProcessorGroupbytraceConfNumTraces.Record(context.Background(), (int64(sp.config.NumTraces)))
 	sp.eventMachine.startInBackground()
-	return sp.st.start()
+	if err := sp.st.start(); err != nil {
+		return err
+	}
+
+	// traces that were in-flight when the processor was last stopped are still
+	// in the storage, and need to be tracked again so that they are released
+	traceIDs, err := sp.st.traceIDs()
+	if err != nil {
+		return fmt.Errorf("couldn't retrieve the in-flight traces from the storage: %w", err)
+	}
+	if len(traceIDs) > 0 {
+		sp.logger.Info("recovering in-flight traces from the storage", zap.Int("traces", len(traceIDs)))
+	}
+	for _, traceID := range traceIDs {
+		sp.eventMachine.recover(traceID)
+	}
+	return nil
 }
 
 // Shutdown is invoked during service shutdown.
 func (sp *groupByTraceProcessor) Shutdown(_ context.Context) error {
 	sp.eventMachine.shutdown()
+	if sp.st == nil {
+		// the storage is only set at start when using a storage extension
+		return nil
+	}
 	return sp.st.shutdown()
 }
 
@@ -119,7 +150,34 @@ func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eve
 
 	// at this point, we determined that we haven't seen the trace yet, so, record the
 	// traceID in the map and the spans to the storage
+	sp.track(traceID, worker)
+
+	// we have the traceID in the memory, place the spans in the storage too
+	if err := sp.addSpans(traceID, trace.td); err != nil {
+		return fmt.Errorf("couldn't add spans to existing trace: %w", err)
+	}
 
+	sp.scheduleRelease(traceID, worker)
+	return nil
+}
+
+func (sp *groupByTraceProcessor) onTraceRecovered(traceID pcommon.TraceID, worker *eventMachineWorker) error {
+	if worker.buffer.contains(traceID) {
+		// spans for this trace were received before the recovery took place,
+		// and the trace is already scheduled to be released
+		return nil
+	}
+
+	// the spans are already in the storage, so the trace only needs to be
+	// tracked again. The wait duration starts over from the recovery.
+	sp.track(traceID, worker)
+	sp.scheduleRelease(traceID, worker)
+	return nil
+}
+
+// track places the trace ID in the worker's buffer, removing the evicted trace from the storage
+// in case the buffer is full.
+func (sp *groupByTraceProcessor) track(traceID pcommon.TraceID, worker *eventMachineWorker) {
 	// place the trace ID in the buffer, and check if an item had to be evicted
 	evicted := worker.buffer.put(traceID)
 	if !evicted.IsEmpty() {
@@ -133,12 +191,9 @@ func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eve
 		sp.logger.Info("trace evicted: in order to avoid this in the future, adjust the wait duration and/or number of traces to keep in memory",
 			zap.Stringer("traceID", evicted))
 	}
+}
 
-	// we have the traceID in the memory, place the spans in the storage too
-	if err := sp.addSpans(traceID, trace.td); err != nil {
-		return fmt.Errorf("couldn't add spans to existing trace: %w", err)
-	}
-
+func (sp *groupByTraceProcessor) scheduleRelease(traceID pcommon.TraceID, worker *eventMachineWorker) {
 	sp.logger.Debug("scheduled to release trace", zap.Duration("duration", sp.config.WaitDuration))
 
 	time.AfterFunc(sp.config.WaitDuration, func() {
@@ -148,7 +203,6 @@ func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eve
 			payload: traceID,
 		})
 	})
-	return nil
 }
 
 func (sp *groupByTraceProcessor) onTraceExpired(traceID pcommon.TraceID, worker *eventMachineWorker) error {
@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor/internal/metadata"
 )
@@ -137,6 +138,89 @@ func TestInternalCacheLimit(t *testing.T) {
 	assert.NotContains(t, receivedTraceIDs, traceIDs[0])
 }
 
+func TestTracesAreRecoveredAfterRestart(t *testing.T) {
+	// prepare
+	storageID := storagetest.NewStorageID("groupbytrace")
+	host := storagetest.NewStorageHost().WithFileBackedStorageExtension("groupbytrace", t.TempDir())
+	traces := simpleTraces()
+
+	config := Config{
+		// the trace must not be released before the processor stops
+		WaitDuration: time.Hour,
+		NumTraces:    10,
+		NumWorkers:   2,
+		StorageID:    &storageID,
+	}
+	// the same settings are used after the restart, so that the processor gets the same storage client
+	set := processortest.NewNopSettings()
+	p := newGroupByTraceProcessor(set, consumertest.NewNop(), config)
+	ctx := context.Background()
+	require.NoError(t, p.Start(ctx, host))
+	require.NoError(t, p.ConsumeTraces(ctx, traces))
+	assert.Eventually(t, func() bool {
+		traceIDs, err := p.st.traceIDs()
+		return err == nil && len(traceIDs) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, p.Shutdown(ctx))
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	next := &mockProcessor{
+		onTraces: func(_ context.Context, received ptrace.Traces) error {
+			assert.Equal(t, traces, received)
+			wg.Done()
+			return nil
+		},
+	}
+
+	// test
+	config.WaitDuration = time.Millisecond
+	p = newGroupByTraceProcessor(set, next, config)
+	require.NoError(t, p.Start(ctx, host))
+	defer func() {
+		assert.NoError(t, p.Shutdown(ctx))
+	}()
+
+	// verify
+	wg.Wait()
+	assert.Eventually(t, func() bool {
+		traceIDs, err := p.st.traceIDs()
+		return err == nil && len(traceIDs) == 0
+	}, time.Second, 10*time.Millisecond, "Must remove the released trace from the storage")
+}
+
+func TestStartWithInvalidStorage(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		storageID component.ID
+		host      component.Host
+	}{
+		{
+			name:      "missing extension",
+			storageID: storagetest.NewStorageID("missing"),
+			host:      storagetest.NewStorageHost(),
+		},
+		{
+			name:      "non-storage extension",
+			storageID: storagetest.NewNonStorageID("non-storage"),
+			host:      storagetest.NewStorageHost().WithNonStorageExtension("non-storage"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{
+				WaitDuration: time.Millisecond,
+				NumTraces:    10,
+				NumWorkers:   1,
+				StorageID:    &tt.storageID,
+			}
+			p := newGroupByTraceProcessor(processortest.NewNopSettings(), consumertest.NewNop(), config)
+
+			assert.Error(t, p.Start(context.Background(), tt.host))
+			assert.NoError(t, p.Shutdown(context.Background()))
+		})
+	}
+}
+
 func TestProcessorCapabilities(t *testing.T) {
 	// prepare
 	config := Config{
@@ -616,6 +700,7 @@ type mockStorage struct {
 	onCreateOrAppend func(pcommon.TraceID, ptrace.Traces) error
 	onGet            func(pcommon.TraceID) ([]ptrace.ResourceSpans, error)
 	onDelete         func(pcommon.TraceID) ([]ptrace.ResourceSpans, error)
+	onTraceIDs       func() ([]pcommon.TraceID, error)
 	onStart          func() error
 	onShutdown       func() error
 }
@@ -643,6 +728,13 @@ func (st *mockStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans,
 	return nil, nil
 }
 
+func (st *mockStorage) traceIDs() ([]pcommon.TraceID, error) {
+	if st.onTraceIDs != nil {
+		return st.onTraceIDs()
+	}
+	return nil, nil
+}
+
 func (st *mockStorage) start() error {
 	if st.onStart != nil {
 		return st.onStart()
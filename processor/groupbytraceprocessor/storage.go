@@ -24,6 +24,10 @@ type storage interface {
 	// or nil in case a trace cannot be found
 	delete(pcommon.TraceID) ([]ptrace.ResourceSpans, error)
 
+	// traceIDs returns the IDs of all the traces currently in the storage, which allows
+	// the traces to be tracked again once the processor restarts
+	traceIDs() ([]pcommon.TraceID, error)
+
 	// start gives the storage the opportunity to initialize any resources or procedures
 	start() error
 
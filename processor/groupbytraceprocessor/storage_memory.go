@@ -78,6 +78,17 @@ func (st *memoryStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans
 	return st.content[traceID], nil
 }
 
+func (st *memoryStorage) traceIDs() ([]pcommon.TraceID, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	traceIDs := make([]pcommon.TraceID, 0, len(st.content))
+	for traceID := range st.content {
+		traceIDs = append(traceIDs, traceID)
+	}
+	return traceIDs, nil
+}
+
 func (st *memoryStorage) start() error {
 	go st.periodicMetrics()
 	return nil
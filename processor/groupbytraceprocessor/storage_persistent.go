@@ -0,0 +1,307 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	extstorage "go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor/internal/metadata"
+)
+
+// numIndexShards is the number of keys used to persist the IDs of the traces in the storage.
+// Splitting the index avoids rewriting every known trace ID whenever a single trace is added or removed.
+// The in-memory index is guarded by one lock per shard, so that unrelated traces are processed concurrently.
+const numIndexShards = 256
+
+var errCorruptedIndex = errors.New("corrupted trace index in the storage")
+
+// persistentStorage keeps the spans for each trace in a storage extension, so that the
+// in-flight traces survive a restart and aren't bound by the available memory.
+// Each batch of spans is stored under its own key, so appending to a trace never reads
+// back what was already stored for it. Only the trace IDs and their number of batches are
+// kept in memory, and the trace IDs are also persisted as an index that allows the traces
+// to be recovered once the processor starts again.
+type persistentStorage struct {
+	client                    extstorage.Client
+	shards                    [numIndexShards]traceShard
+	telemetry                 *metadata.TelemetryBuilder
+	stopped                   bool
+	stoppedLock               sync.RWMutex
+	metricsCollectionInterval time.Duration
+
+	marshaler   ptrace.ProtoMarshaler
+	unmarshaler ptrace.ProtoUnmarshaler
+}
+
+// traceShard holds the number of batches stored for each trace of an index shard.
+type traceShard struct {
+	sync.Mutex
+	batches map[pcommon.TraceID]int
+}
+
+var _ storage = (*persistentStorage)(nil)
+
+func newPersistentStorage(client extstorage.Client, telemetry *metadata.TelemetryBuilder) *persistentStorage {
+	st := &persistentStorage{
+		client:                    client,
+		metricsCollectionInterval: time.Second,
+		telemetry:                 telemetry,
+	}
+	for i := range st.shards {
+		st.shards[i].batches = make(map[pcommon.TraceID]int)
+	}
+	return st
+}
+
+// getStorageClient returns the client for the given storage extension.
+func getStorageClient(ctx context.Context, host component.Host, storageID component.ID, componentID component.ID) (extstorage.Client, error) {
+	ext, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExt, ok := ext.(extstorage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExt.GetClient(ctx, component.KindProcessor, componentID, "")
+}
+
+func (st *persistentStorage) createOrAppend(traceID pcommon.TraceID, td ptrace.Traces) error {
+	content, err := st.marshaler.MarshalTraces(td)
+	if err != nil {
+		return fmt.Errorf("couldn't marshal trace: %w", err)
+	}
+
+	shard := indexShard(traceID)
+	ts := &st.shards[shard]
+	ts.Lock()
+	defer ts.Unlock()
+
+	batches, known := ts.batches[traceID]
+	ts.batches[traceID] = batches + 1
+	ops := []extstorage.Operation{extstorage.SetOperation(batchKey(traceID, batches), content)}
+	if !known {
+		ops = append(ops, st.indexOperation(shard))
+	}
+	if err := st.client.Batch(context.Background(), ops...); err != nil {
+		if known {
+			ts.batches[traceID] = batches
+		} else {
+			delete(ts.batches, traceID)
+		}
+		return err
+	}
+	return nil
+}
+
+func (st *persistentStorage) get(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	ts := &st.shards[indexShard(traceID)]
+	ts.Lock()
+	defer ts.Unlock()
+
+	return st.read(traceID, ts.batches[traceID])
+}
+
+// delete will return the trace as it was in the storage before being removed.
+func (st *persistentStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	shard := indexShard(traceID)
+	ts := &st.shards[shard]
+	ts.Lock()
+	defer ts.Unlock()
+
+	batches, known := ts.batches[traceID]
+	if !known {
+		return nil, nil
+	}
+	rss, err := st.read(traceID, batches)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(ts.batches, traceID)
+	ops := make([]extstorage.Operation, 0, batches+1)
+	for i := 0; i < batches; i++ {
+		ops = append(ops, extstorage.DeleteOperation(batchKey(traceID, i)))
+	}
+	ops = append(ops, st.indexOperation(shard))
+	if err := st.client.Batch(context.Background(), ops...); err != nil {
+		ts.batches[traceID] = batches
+		return nil, err
+	}
+	return rss, nil
+}
+
+func (st *persistentStorage) traceIDs() ([]pcommon.TraceID, error) {
+	var traceIDs []pcommon.TraceID
+	for i := range st.shards {
+		ts := &st.shards[i]
+		ts.Lock()
+		for traceID := range ts.batches {
+			traceIDs = append(traceIDs, traceID)
+		}
+		ts.Unlock()
+	}
+	return traceIDs, nil
+}
+
+// start loads the index of the traces that were left in the storage,
+// along with the number of batches stored for each of them.
+func (st *persistentStorage) start() error {
+	ops := make([]extstorage.Operation, numIndexShards)
+	for i := range ops {
+		ops[i] = extstorage.GetOperation(indexKey(i))
+	}
+	if err := st.client.Batch(context.Background(), ops...); err != nil {
+		return fmt.Errorf("couldn't read the trace index from the storage: %w", err)
+	}
+
+	var pending []pcommon.TraceID
+	for _, op := range ops {
+		if len(op.Value)%len(pcommon.TraceID{}) != 0 {
+			return fmt.Errorf("%w: unexpected size %d for key %q", errCorruptedIndex, len(op.Value), op.Key)
+		}
+		for offset := 0; offset < len(op.Value); offset += len(pcommon.TraceID{}) {
+			var traceID pcommon.TraceID
+			copy(traceID[:], op.Value[offset:])
+			pending = append(pending, traceID)
+		}
+	}
+
+	// The batches of a trace are stored under consecutive keys,
+	// so they are counted by looking up the next key of every trace
+	// until it is missing.
+	for batch := 0; len(pending) > 0; batch++ {
+		ops = ops[:0]
+		for _, traceID := range pending {
+			ops = append(ops, extstorage.GetOperation(batchKey(traceID, batch)))
+		}
+		if err := st.client.Batch(context.Background(), ops...); err != nil {
+			return fmt.Errorf("couldn't read the stored traces: %w", err)
+		}
+		next := pending[:0]
+		for i, op := range ops {
+			if op.Value != nil {
+				next = append(next, pending[i])
+				continue
+			}
+			ts := &st.shards[indexShard(pending[i])]
+			ts.Lock()
+			ts.batches[pending[i]] = batch
+			ts.Unlock()
+		}
+		pending = next
+	}
+
+	go st.periodicMetrics()
+	return nil
+}
+
+func (st *persistentStorage) shutdown() error {
+	st.stoppedLock.Lock()
+	st.stopped = true
+	st.stoppedLock.Unlock()
+
+	// wait for the operations in flight to complete
+	for i := range st.shards {
+		st.shards[i].Lock()
+		defer st.shards[i].Unlock()
+	}
+	return st.client.Close(context.Background())
+}
+
+func (st *persistentStorage) periodicMetrics() {
+	numTraces := st.count()
+	st.telemetry.ProcessorGroupbytraceNumTracesInMemory.Record(context.Background(), int64(numTraces))
+
+	st.stoppedLock.RLock()
+	stopped := st.stopped
+	st.stoppedLock.RUnlock()
+	if stopped {
+		return
+	}
+
+	time.AfterFunc(st.metricsCollectionInterval, func() {
+		st.periodicMetrics()
+	})
+}
+
+func (st *persistentStorage) count() int {
+	var count int
+	for i := range st.shards {
+		ts := &st.shards[i]
+		ts.Lock()
+		count += len(ts.batches)
+		ts.Unlock()
+	}
+	return count
+}
+
+// read returns the spans of the given number of batches stored for the trace,
+// or nil if the storage doesn't have any. Callers must hold the lock of the trace's shard.
+func (st *persistentStorage) read(traceID pcommon.TraceID, batches int) ([]ptrace.ResourceSpans, error) {
+	if batches == 0 {
+		return nil, nil
+	}
+	ops := make([]extstorage.Operation, batches)
+	for i := range ops {
+		ops[i] = extstorage.GetOperation(batchKey(traceID, i))
+	}
+	if err := st.client.Batch(context.Background(), ops...); err != nil {
+		return nil, err
+	}
+
+	var result []ptrace.ResourceSpans
+	for _, op := range ops {
+		if op.Value == nil {
+			continue
+		}
+		td, err := st.unmarshaler.UnmarshalTraces(op.Value)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't unmarshal trace %q: %w", traceID, err)
+		}
+		for i := 0; i < td.ResourceSpans().Len(); i++ {
+			result = append(result, td.ResourceSpans().At(i))
+		}
+	}
+	return result, nil
+}
+
+// indexOperation returns the operation persisting the current state of the given index shard.
+// Callers must hold the lock of the shard.
+func (st *persistentStorage) indexOperation(shard int) extstorage.Operation {
+	traceIDs := st.shards[shard].batches
+	if len(traceIDs) == 0 {
+		return extstorage.DeleteOperation(indexKey(shard))
+	}
+
+	value := make([]byte, 0, len(traceIDs)*len(pcommon.TraceID{}))
+	for traceID := range traceIDs {
+		value = append(value, traceID[:]...)
+	}
+	return extstorage.SetOperation(indexKey(shard), value)
+}
+
+func indexShard(traceID pcommon.TraceID) int {
+	// trace IDs are expected to be random, making the first byte evenly distributed
+	return int(traceID[0]) % numIndexShards
+}
+
+func indexKey(shard int) string {
+	return fmt.Sprintf("index_%02x", shard)
+}
+
+func batchKey(traceID pcommon.TraceID, batch int) string {
+	return fmt.Sprintf("trace_%s_%d", traceID, batch)
+}
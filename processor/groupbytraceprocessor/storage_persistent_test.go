@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor/internal/metadata"
+)
+
+func newTestPersistentStorage(t *testing.T, dir string) *persistentStorage {
+	set := processortest.NewNopSettings()
+	tel, _ := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+
+	var client *storagetest.TestClient
+	if dir == "" {
+		client = storagetest.NewInMemoryClient(component.KindProcessor, component.NewID(metadata.Type), "")
+	} else {
+		client = storagetest.NewFileBackedClient(component.KindProcessor, component.NewID(metadata.Type), "", dir)
+	}
+	st := newPersistentStorage(client, tel)
+	require.NoError(t, st.start())
+	return st
+}
+
+func TestPersistentCreateAndGetTrace(t *testing.T) {
+	st := newTestPersistentStorage(t, "")
+	defer func() {
+		assert.NoError(t, st.shutdown())
+	}()
+
+	traceIDs := []pcommon.TraceID{
+		pcommon.TraceID([16]byte{1, 2, 3, 4}),
+		pcommon.TraceID([16]byte{2, 3, 4, 5}),
+	}
+
+	baseTrace := ptrace.NewTraces()
+	rss := baseTrace.ResourceSpans()
+	rs := rss.AppendEmpty()
+	ils := rs.ScopeSpans().AppendEmpty()
+	span := ils.Spans().AppendEmpty()
+
+	// test
+	for _, traceID := range traceIDs {
+		span.SetTraceID(traceID)
+		assert.NoError(t, st.createOrAppend(traceID, baseTrace))
+	}
+
+	// verify
+	assert.Equal(t, 2, st.count())
+	for _, traceID := range traceIDs {
+		expected := []ptrace.ResourceSpans{baseTrace.ResourceSpans().At(0)}
+		expected[0].ScopeSpans().At(0).Spans().At(0).SetTraceID(traceID)
+
+		retrieved, err := st.get(traceID)
+		require.NoError(t, err)
+		assert.Equal(t, expected, retrieved)
+	}
+
+	retrieved, err := st.get(pcommon.TraceID([16]byte{3, 4, 5, 6}))
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestPersistentDeleteTrace(t *testing.T) {
+	st := newTestPersistentStorage(t, "")
+	defer func() {
+		assert.NoError(t, st.shutdown())
+	}()
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	trace := simpleTracesWithID(traceID)
+	assert.NoError(t, st.createOrAppend(traceID, trace))
+
+	// test
+	deleted, err := st.delete(traceID)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, []ptrace.ResourceSpans{trace.ResourceSpans().At(0)}, deleted)
+	assert.Equal(t, 0, st.count())
+
+	retrieved, err := st.get(traceID)
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+
+	deleted, err = st.delete(traceID)
+	require.NoError(t, err)
+	assert.Nil(t, deleted, "Must return nil for unknown traces")
+}
+
+func TestPersistentAppendSpans(t *testing.T) {
+	st := newTestPersistentStorage(t, "")
+	defer func() {
+		assert.NoError(t, st.shutdown())
+	}()
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+
+	first := simpleTracesWithID(traceID)
+	first.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetName("first-name")
+	second := simpleTracesWithID(traceID)
+	secondSpan := second.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	secondSpan.SetName("second-name")
+
+	// test
+	require.NoError(t, st.createOrAppend(traceID, first))
+	require.NoError(t, st.createOrAppend(traceID, second))
+
+	// override something in the second span, to make sure we are storing a copy
+	secondSpan.SetName("changed-second-name")
+
+	// verify
+	retrieved, err := st.get(traceID)
+	require.NoError(t, err)
+	require.Len(t, retrieved, 2)
+	assert.Equal(t, "first-name", retrieved[0].ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Equal(t, "second-name", retrieved[1].ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Equal(t, 1, st.count())
+}
+
+func TestPersistentRecoversTracesAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+
+	traceIDs := []pcommon.TraceID{
+		pcommon.TraceID([16]byte{1, 2, 3, 4}),
+		pcommon.TraceID([16]byte{1, 3, 4, 5}),
+		pcommon.TraceID([16]byte{2, 3, 4, 5}),
+	}
+
+	st := newTestPersistentStorage(t, dir)
+	for _, traceID := range traceIDs {
+		require.NoError(t, st.createOrAppend(traceID, simpleTracesWithID(traceID)))
+	}
+	require.NoError(t, st.createOrAppend(traceIDs[2], simpleTracesWithID(traceIDs[2])))
+	_, err := st.delete(traceIDs[1])
+	require.NoError(t, err)
+	require.NoError(t, st.shutdown())
+
+	// test
+	st = newTestPersistentStorage(t, dir)
+	defer func() {
+		assert.NoError(t, st.shutdown())
+	}()
+
+	// verify
+	recovered, err := st.traceIDs()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []pcommon.TraceID{traceIDs[0], traceIDs[2]}, recovered)
+
+	retrieved, err := st.get(traceIDs[2])
+	require.NoError(t, err)
+	assert.Len(t, retrieved, 2, "Must recover every batch of the trace")
+
+	// appending after the restart must not overwrite the recovered batches
+	require.NoError(t, st.createOrAppend(traceIDs[2], simpleTracesWithID(traceIDs[2])))
+	deleted, err := st.delete(traceIDs[2])
+	require.NoError(t, err)
+	assert.Len(t, deleted, 3)
+	for _, rs := range deleted {
+		assert.Equal(t, simpleTracesWithID(traceIDs[2]).ResourceSpans().At(0), rs)
+	}
+}
+
+func TestPersistentCorruptedIndex(t *testing.T) {
+	set := processortest.NewNopSettings()
+	tel, _ := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+
+	client := storagetest.NewInMemoryClient(component.KindProcessor, component.NewID(metadata.Type), "")
+	require.NoError(t, client.Set(context.Background(), indexKey(1), []byte{1, 2, 3}))
+
+	st := newPersistentStorage(client, tel)
+	assert.ErrorIs(t, st.start(), errCorruptedIndex)
+}
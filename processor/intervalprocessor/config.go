@@ -10,7 +10,24 @@ import (
 	"go.opentelemetry.io/collector/component"
 )
 
-var ErrInvalidIntervalValue = errors.New("invalid interval value")
+var (
+	ErrInvalidIntervalValue        = errors.New("invalid interval value")
+	ErrInvalidExpHistogramMode     = errors.New("invalid exponential histograms mode, must be latest or merge")
+	ErrInvalidExpHistogramMaxScale = errors.New("invalid exponential histograms max_scale, must be between -10 and 20")
+	ErrInvalidExpHistogramMaxSize  = errors.New("invalid exponential histograms max_size, must be 0 or at least 2")
+)
+
+// ExpHistogramMode is the mode of aggregation of the exponential histograms.
+type ExpHistogramMode string
+
+const (
+	// ExpHistogramModeLatest keeps the latest cumulative data point of each stream, and passes the delta
+	// data points through.
+	ExpHistogramModeLatest ExpHistogramMode = "latest"
+	// ExpHistogramModeMerge keeps the latest cumulative data point of each stream, and merges the delta
+	// data points of each stream over the interval.
+	ExpHistogramModeMerge ExpHistogramMode = "merge"
+)
 
 var _ component.Config = (*Config)(nil)
 
@@ -21,6 +38,8 @@ type Config struct {
 	// PassThrough is a configuration that determines whether gauge and summary metrics should be passed through
 	// as they are or aggregated.
 	PassThrough PassThrough `mapstructure:"pass_through"`
+	// ExponentialHistograms configures the aggregation of the exponential histograms.
+	ExponentialHistograms ExponentialHistograms `mapstructure:"exponential_histograms"`
 }
 
 type PassThrough struct {
@@ -32,6 +51,17 @@ type PassThrough struct {
 	Summary bool `mapstructure:"summary"`
 }
 
+type ExponentialHistograms struct {
+	// Mode determines whether the delta data points are passed through or merged.
+	Mode ExpHistogramMode `mapstructure:"mode"`
+	// MaxScale is the maximum scale of the aggregated data points, the ones with a higher scale are downscaled.
+	MaxScale int32 `mapstructure:"max_scale"`
+	// MaxSize is the maximum number of buckets of each of the positive and negative ranges of the aggregated
+	// data points, the ones with more buckets are downscaled. 0 means no limit, except when merging the delta data
+	// points, which are limited to 160 buckets.
+	MaxSize int `mapstructure:"max_size"`
+}
+
 // Validate checks whether the input configuration has all of the required fields for the processor.
 // An error is returned if there are any invalid inputs.
 func (config *Config) Validate() error {
@@ -39,5 +69,17 @@ func (config *Config) Validate() error {
 		return ErrInvalidIntervalValue
 	}
 
+	switch config.ExponentialHistograms.Mode {
+	case ExpHistogramModeLatest, ExpHistogramModeMerge:
+	default:
+		return ErrInvalidExpHistogramMode
+	}
+	if config.ExponentialHistograms.MaxScale < -10 || config.ExponentialHistograms.MaxScale > 20 {
+		return ErrInvalidExpHistogramMaxScale
+	}
+	if config.ExponentialHistograms.MaxSize < 0 || config.ExponentialHistograms.MaxSize == 1 {
+		return ErrInvalidExpHistogramMaxSize
+	}
+
 	return nil
 }
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		modify      func(config *Config)
+		expectedErr error
+	}{
+		{
+			name:   "default",
+			modify: func(*Config) {},
+		},
+		{
+			name:        "invalid interval",
+			modify:      func(config *Config) { config.Interval = 0 },
+			expectedErr: ErrInvalidIntervalValue,
+		},
+		{
+			name:        "invalid exponential histograms mode",
+			modify:      func(config *Config) { config.ExponentialHistograms.Mode = "sum" },
+			expectedErr: ErrInvalidExpHistogramMode,
+		},
+		{
+			name:        "invalid exponential histograms max scale",
+			modify:      func(config *Config) { config.ExponentialHistograms.MaxScale = 21 },
+			expectedErr: ErrInvalidExpHistogramMaxScale,
+		},
+		{
+			name:        "invalid exponential histograms max size",
+			modify:      func(config *Config) { config.ExponentialHistograms.MaxSize = 1 },
+			expectedErr: ErrInvalidExpHistogramMaxSize,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := createDefaultConfig().(*Config)
+			tc.modify(config)
+			require.ErrorIs(t, config.Validate(), tc.expectedErr)
+		})
+	}
+}
@@ -30,6 +30,11 @@ func createDefaultConfig() component.Config {
 			Gauge:   false,
 			Summary: false,
 		},
+		ExponentialHistograms: ExponentialHistograms{
+			Mode:     ExpHistogramModeLatest,
+			MaxScale: 20,
+			MaxSize:  0,
+		},
 	}
 }
 
@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/exphistogram"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/identity"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor/internal/metrics"
 )
@@ -152,6 +153,12 @@ func (p *Processor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) erro
 				case pmetric.MetricTypeExponentialHistogram:
 					expHistogram := m.ExponentialHistogram()
 
+					if expHistogram.AggregationTemporality() == pmetric.AggregationTemporalityDelta && p.config.ExponentialHistograms.Mode == ExpHistogramModeMerge {
+						mClone, metricID := p.getOrCloneMetric(rm, sm, m)
+						mergeExpHistogramDataPoints(expHistogram.DataPoints(), mClone.ExponentialHistogram().DataPoints(), metricID, p.expHistogramLookup, p.config.ExponentialHistograms.MaxSize)
+						return true
+					}
+
 					if expHistogram.AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
 						return false
 					}
@@ -201,6 +208,25 @@ func aggregateDataPoints[DPS metrics.DataPointSlice[DP], DP metrics.DataPoint[DP
 	}
 }
 
+// mergeExpHistogramDataPoints merges the delta data points of each stream into a single data point spanning
+// the interval, with at most maxSize positive and negative buckets.
+func mergeExpHistogramDataPoints(dataPoints pmetric.ExponentialHistogramDataPointSlice, mCloneDataPoints pmetric.ExponentialHistogramDataPointSlice, metricID identity.Metric, dpLookup map[identity.Stream]pmetric.ExponentialHistogramDataPoint, maxSize int) {
+	for i := 0; i < dataPoints.Len(); i++ {
+		dp := dataPoints.At(i)
+
+		streamID := identity.OfStream(metricID, dp)
+		existingDP, ok := dpLookup[streamID]
+		if !ok {
+			dpClone := mCloneDataPoints.AppendEmpty()
+			dp.CopyTo(dpClone)
+			dpLookup[streamID] = dpClone
+			continue
+		}
+
+		exphistogram.Merge(existingDP, dp, maxSize)
+	}
+}
+
 func (p *Processor) exportMetrics() {
 	md := func() pmetric.Metrics {
 		p.stateLock.Lock()
@@ -211,6 +237,11 @@ func (p *Processor) exportMetrics() {
 		out := p.md
 		p.md = pmetric.NewMetrics()
 
+		// Downscale the exponential histograms exceeding the configured limits
+		for _, dp := range p.expHistogramLookup {
+			exphistogram.Limit(dp, p.config.ExponentialHistograms.MaxScale, p.config.ExponentialHistograms.MaxSize)
+		}
+
 		// Clear all the lookup references
 		clear(p.rmLookup)
 		clear(p.smLookup)
@@ -22,8 +22,9 @@ func TestAggregation(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name        string
-		passThrough bool
+		name          string
+		passThrough   bool
+		expHistograms *ExponentialHistograms
 	}{
 		{name: "basic_aggregation"},
 		{name: "histograms_are_aggregated"},
@@ -34,14 +35,21 @@ func TestAggregation(t *testing.T) {
 		{name: "non_monotonic_sums_are_passed_through"}, // Non-monotonic sums are passed through even when aggregation is enabled
 		{name: "gauges_are_passed_through", passThrough: true},
 		{name: "summaries_are_passed_through", passThrough: true},
+		{name: "exp_histograms_are_merged", expHistograms: &ExponentialHistograms{Mode: ExpHistogramModeMerge, MaxScale: 20}},
+		{name: "exp_histograms_are_merged_within_max_size", expHistograms: &ExponentialHistograms{Mode: ExpHistogramModeMerge, MaxScale: 20, MaxSize: 10}},
+		{name: "exp_histograms_are_limited", expHistograms: &ExponentialHistograms{Mode: ExpHistogramModeLatest, MaxScale: 3, MaxSize: 2}},
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	var config *Config
 	for _, tc := range testCases {
-		config = &Config{Interval: time.Second, PassThrough: PassThrough{Gauge: tc.passThrough, Summary: tc.passThrough}}
+		config := createDefaultConfig().(*Config)
+		config.Interval = time.Second
+		config.PassThrough = PassThrough{Gauge: tc.passThrough, Summary: tc.passThrough}
+		if tc.expHistograms != nil {
+			config.ExponentialHistograms = *tc.expHistograms
+		}
 
 		t.Run(tc.name, func(t *testing.T) {
 			// next stores the results of the filter metric processor
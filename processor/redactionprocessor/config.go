@@ -3,6 +3,19 @@
 
 package redactionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
 
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
+// HashFunction is the function used to replace the blocked values.
+type HashFunction string
+
+// HMACSHA256 replaces the blocked values with their HMAC-SHA256 keyed hash
+const HMACSHA256 HashFunction = "hmac-sha256"
+
 type Config struct {
 	// AllowAllKeys is a flag to allow all span attribute keys. Setting this
 	// to true disables the AllowedKeys list. The list of BlockedValues is
@@ -23,6 +36,36 @@ type Config struct {
 	// allowed span attributes. Values that match are masked
 	BlockedValues []string `mapstructure:"blocked_values"`
 
+	// BlockedKeyPatterns is a list of regular expressions for blocking the
+	// values of allowed span attributes by their key. The whole value of the
+	// attributes with a matching key is masked
+	BlockedKeyPatterns []string `mapstructure:"blocked_key_patterns"`
+
+	// AllowedValues is a list of regular expressions for values that are
+	// exempt from masking. A value matching a blocked value or belonging to a
+	// blocked key is left unchanged if it also matches an allowed value, e.g.
+	// the email addresses of your own corporate domain
+	AllowedValues []string `mapstructure:"allowed_values"`
+
+	// HashFunction replaces the blocked values with a keyed hash instead of
+	// masking them with asterisks. Hashed values can still be correlated
+	// across signals without revealing the original value. The only
+	// supported value is `hmac-sha256`
+	HashFunction HashFunction `mapstructure:"hash_function"`
+
+	// HMACKey is the secret key used by the HashFunction. It is required
+	// when the HashFunction is set
+	HMACKey configopaque.String `mapstructure:"hmac_key"`
+
+	// KeepPrefix is the number of leading characters of a blocked value that
+	// are kept unchanged, the rest of the value is masked or hashed
+	KeepPrefix int `mapstructure:"keep_prefix"`
+
+	// KeepSuffix is the number of trailing characters of a blocked value
+	// that are kept unchanged, e.g. 4 keeps the last 4 digits of a credit
+	// card number
+	KeepSuffix int `mapstructure:"keep_suffix"`
+
 	// Summary controls the verbosity level of the diagnostic attributes that
 	// the processor adds to the spans when it redacts or masks other
 	// attributes. In some contexts a list of redacted attributes leaks
@@ -30,3 +73,20 @@ type Config struct {
 	// configuration. Possible values are `debug`, `info`, and `silent`.
 	Summary string `mapstructure:"summary"`
 }
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	switch cfg.HashFunction {
+	case "":
+	case HMACSHA256:
+		if cfg.HMACKey == "" {
+			return fmt.Errorf("hmac_key is required for the hash function %q", cfg.HashFunction)
+		}
+	default:
+		return fmt.Errorf("unsupported hash function %q", cfg.HashFunction)
+	}
+	if cfg.KeepPrefix < 0 || cfg.KeepSuffix < 0 {
+		return errors.New("keep_prefix and keep_suffix must not be negative")
+	}
+	return nil
+}
@@ -19,23 +19,37 @@ func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		id       component.ID
-		expected component.Config
+		id          component.ID
+		expected    component.Config
+		expectedErr string
 	}{
 		{
 			id: component.NewIDWithName(metadata.Type, ""),
 			expected: &Config{
-				AllowAllKeys:  false,
-				AllowedKeys:   []string{"description", "group", "id", "name"},
-				IgnoredKeys:   []string{"safe_attribute"},
-				BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?", "(5[1-5][0-9]{14})"},
-				Summary:       debug,
+				AllowAllKeys:       false,
+				AllowedKeys:        []string{"description", "group", "id", "name"},
+				IgnoredKeys:        []string{"safe_attribute"},
+				BlockedValues:      []string{"4[0-9]{12}(?:[0-9]{3})?", "(5[1-5][0-9]{14})"},
+				BlockedKeyPatterns: []string{".*token.*"},
+				AllowedValues:      []string{".+@mycompany.com"},
+				HashFunction:       HMACSHA256,
+				HMACKey:            "secret",
+				KeepSuffix:         4,
+				Summary:            debug,
 			},
 		},
 		{
 			id:       component.NewIDWithName(metadata.Type, "empty"),
 			expected: createDefaultConfig(),
 		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_hash_function"),
+			expectedErr: `unsupported hash function "md5"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_hmac_key"),
+			expectedErr: `hmac_key is required for the hash function "hmac-sha256"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -50,6 +64,10 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, sub.Unmarshal(cfg))
 
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
 			assert.NoError(t, component.ValidateConfig(cfg))
 			assert.Equal(t, tt.expected, cfg)
 		})
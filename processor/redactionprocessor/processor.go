@@ -5,6 +5,9 @@ package redactionprocessor // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"sort"
@@ -25,7 +28,11 @@ type redaction struct {
 	// Attribute keys ignored in a span
 	ignoreList map[string]string
 	// Attribute values blocked in a span
-	blockRegexList map[string]*regexp.Regexp
+	blockRegexList []*regexp.Regexp
+	// Attribute keys whose values are blocked in a span
+	blockKeyRegexList []*regexp.Regexp
+	// Attribute values exempt from masking
+	allowRegexList []*regexp.Regexp
 	// Redaction processor configuration
 	config *Config
 	// Logger
@@ -36,18 +43,28 @@ type redaction struct {
 func newRedaction(ctx context.Context, config *Config, logger *zap.Logger) (*redaction, error) {
 	allowList := makeAllowList(config)
 	ignoreList := makeIgnoreList(config)
-	blockRegexList, err := makeBlockRegexList(ctx, config)
+	blockRegexList, err := makeRegexList(ctx, config.BlockedValues)
 	if err != nil {
 		// TODO: Placeholder for an error metric in the next PR
 		return nil, fmt.Errorf("failed to process block list: %w", err)
 	}
+	blockKeyRegexList, err := makeRegexList(ctx, config.BlockedKeyPatterns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process block key list: %w", err)
+	}
+	allowRegexList, err := makeRegexList(ctx, config.AllowedValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process allowed values list: %w", err)
+	}
 
 	return &redaction{
-		allowList:      allowList,
-		ignoreList:     ignoreList,
-		blockRegexList: blockRegexList,
-		config:         config,
-		logger:         logger,
+		allowList:         allowList,
+		ignoreList:        ignoreList,
+		blockRegexList:    blockRegexList,
+		blockKeyRegexList: blockKeyRegexList,
+		allowRegexList:    allowRegexList,
+		config:            config,
+		logger:            logger,
 	}, nil
 }
 
@@ -186,22 +203,24 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 			}
 		}
 
-		// Mask any blocked values for the other attributes
-		strVal := value.Str()
-		var matched bool
-		for _, compiledRE := range s.blockRegexList {
-			match := compiledRE.MatchString(strVal)
-			if match {
-				if !matched {
-					matched = true
+		// Mask the whole value of the attributes with a blocked key
+		for _, compiledRE := range s.blockKeyRegexList {
+			if compiledRE.MatchString(k) {
+				strVal := value.AsString()
+				if !s.isAllowedValue(strVal) {
 					toBlock = append(toBlock, k)
+					value.SetStr(s.maskValue(strVal))
 				}
-
-				maskedValue := compiledRE.ReplaceAllString(strVal, "****")
-				value.SetStr(maskedValue)
-				strVal = maskedValue
+				// Skip to the next attribute
+				return true
 			}
 		}
+
+		// Mask any blocked values for the other attributes
+		if maskedValue, masked := s.maskBlockedValues(value.Str()); masked {
+			toBlock = append(toBlock, k)
+			value.SetStr(maskedValue)
+		}
 		return true
 	})
 
@@ -215,6 +234,72 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 	s.addMetaAttrs(ignoring, attributes, "", ignoredKeyCount)
 }
 
+// maskBlockedValues masks the parts of the value matching any of the blocked
+// values that aren't allowed values. The matches of all the patterns are found
+// in the original value and the overlapping ones are masked together, so that
+// a masked value is never masked again
+func (s *redaction) maskBlockedValues(value string) (string, bool) {
+	var matches [][]int
+	for _, compiledRE := range s.blockRegexList {
+		for _, loc := range compiledRE.FindAllStringIndex(value, -1) {
+			// Empty matches have nothing to mask
+			if loc[0] == loc[1] || s.isAllowedValue(value[loc[0]:loc[1]]) {
+				continue
+			}
+			matches = append(matches, loc)
+		}
+	}
+	if len(matches) == 0 {
+		return value, false
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i][0] < matches[j][0]
+	})
+	var masked strings.Builder
+	last := 0
+	for i := 0; i < len(matches); {
+		start, end := matches[i][0], matches[i][1]
+		for i++; i < len(matches) && matches[i][0] < end; i++ {
+			end = max(end, matches[i][1])
+		}
+		masked.WriteString(value[last:start])
+		masked.WriteString(s.maskValue(value[start:end]))
+		last = end
+	}
+	masked.WriteString(value[last:])
+	return masked.String(), true
+}
+
+// isAllowedValue checks if the value matches any of the allowed values
+func (s *redaction) isAllowedValue(value string) bool {
+	for _, compiledRE := range s.allowRegexList {
+		if compiledRE.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskValue replaces the value with asterisks or its keyed hash, keeping the
+// configured prefix and suffix. The whole value is replaced if it's too short
+// to keep both of them
+func (s *redaction) maskValue(value string) string {
+	runes := []rune(value)
+	var prefix, suffix string
+	if len(runes) > s.config.KeepPrefix+s.config.KeepSuffix {
+		prefix = string(runes[:s.config.KeepPrefix])
+		suffix = string(runes[len(runes)-s.config.KeepSuffix:])
+	}
+
+	if s.config.HashFunction == HMACSHA256 {
+		mac := hmac.New(sha256.New, []byte(s.config.HMACKey))
+		mac.Write([]byte(value))
+		return prefix + hex.EncodeToString(mac.Sum(nil)) + suffix
+	}
+	return prefix + "****" + suffix
+}
+
 // addMetaAttrs adds diagnostic information about redacted or masked attribute keys
 func (s *redaction) addMetaAttrs(redactedAttrs []string, attributes pcommon.Map, valuesAttr, countAttr string) {
 	redactedCount := int64(len(redactedAttrs))
@@ -282,16 +367,15 @@ func makeIgnoreList(c *Config) map[string]string {
 	return ignoreList
 }
 
-// makeBlockRegexList precompiles all the blocked regex patterns
-func makeBlockRegexList(_ context.Context, config *Config) (map[string]*regexp.Regexp, error) {
-	blockRegexList := make(map[string]*regexp.Regexp, len(config.BlockedValues))
-	for _, pattern := range config.BlockedValues {
+// makeRegexList precompiles all the given regex patterns, in the order they are configured
+func makeRegexList(_ context.Context, patterns []string) ([]*regexp.Regexp, error) {
+	regexList := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
 		re, err := regexp.Compile(pattern)
 		if err != nil {
-			// TODO: Placeholder for an error metric in the next PR
-			return nil, fmt.Errorf("error compiling regex in block list: %w", err)
+			return nil, fmt.Errorf("error compiling regex %q: %w", pattern, err)
 		}
-		blockRegexList[pattern] = re
+		regexList = append(regexList, re)
 	}
-	return blockRegexList, nil
+	return regexList, nil
 }
@@ -5,6 +5,9 @@ package redactionprocessor
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"sort"
 	"strings"
 	"testing"
@@ -510,6 +513,187 @@ func TestProcessAttrsAppliedTwice(t *testing.T) {
 	assert.Equal(t, int64(2), val.Int())
 }
 
+// TestHashBlockedValues validates that the blocked values are replaced with
+// their HMAC-SHA256 hash, which is the same for the same value
+func TestHashBlockedValues(t *testing.T) {
+	config := &Config{
+		AllowAllKeys:  true,
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		HashFunction:  HMACSHA256,
+		HMACKey:       "secret",
+	}
+	processor, err := newRedaction(context.TODO(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	hash := hmacSHA256("secret", "4111111111111111")
+
+	first := pcommon.NewMap()
+	first.PutStr("credit_card", "card 4111111111111111")
+	processor.processAttrs(context.TODO(), first)
+	second := pcommon.NewMap()
+	second.PutStr("payment", "4111111111111111")
+	processor.processAttrs(context.TODO(), second)
+
+	val, _ := first.Get("credit_card")
+	assert.Equal(t, "card "+hash, val.Str())
+	val, _ = second.Get("payment")
+	assert.Equal(t, hash, val.Str())
+}
+
+// TestHashBlockedValuesDoesNotMaskHashes validates that the hashes of the
+// blocked values aren't masked again by the patterns matching inside of them
+func TestHashBlockedValuesDoesNotMaskHashes(t *testing.T) {
+	config := &Config{
+		AllowAllKeys:  true,
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?", "[0-9]{4,}", "[a-f0-9]{6}"},
+		HashFunction:  HMACSHA256,
+		HMACKey:       "secret",
+	}
+	processor, err := newRedaction(context.TODO(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	expected := "card " + hmacSHA256("secret", "4111111111111111") + " id " + hmacSHA256("secret", "123456")
+	for i := 0; i < 20; i++ {
+		attrs := pcommon.NewMap()
+		attrs.PutStr("payment", "card 4111111111111111 id 123456")
+		processor.processAttrs(context.TODO(), attrs)
+
+		val, _ := attrs.Get("payment")
+		assert.Equal(t, expected, val.Str())
+	}
+}
+
+// TestOverlappingBlockedValues validates that the overlapping matches of the
+// blocked values are masked together
+func TestOverlappingBlockedValues(t *testing.T) {
+	config := &Config{
+		AllowAllKeys:  true,
+		BlockedValues: []string{"abc", "bcd", "x*"},
+	}
+	processor, err := newRedaction(context.TODO(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("value", "-abcd-abc-")
+	processor.processAttrs(context.TODO(), attrs)
+
+	val, _ := attrs.Get("value")
+	assert.Equal(t, "-****-****-", val.Str())
+}
+
+// TestKeepPrefixAndSuffix validates that the configured prefix and suffix of
+// the blocked values are kept unchanged
+func TestKeepPrefixAndSuffix(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *Config
+		value    string
+		expected string
+	}{
+		{
+			name:     "suffix",
+			config:   &Config{KeepSuffix: 4},
+			value:    "4111111111111234",
+			expected: "****1234",
+		},
+		{
+			name:     "prefix and suffix",
+			config:   &Config{KeepPrefix: 2, KeepSuffix: 4},
+			value:    "4111111111111234",
+			expected: "41****1234",
+		},
+		{
+			name:     "value too short",
+			config:   &Config{KeepPrefix: 8, KeepSuffix: 8},
+			value:    "4111111111111234",
+			expected: "****",
+		},
+		{
+			name:     "hashed",
+			config:   &Config{KeepSuffix: 4, HashFunction: HMACSHA256, HMACKey: "secret"},
+			value:    "4111111111111234",
+			expected: hmacSHA256("secret", "4111111111111234") + "1234",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.AllowAllKeys = true
+			tt.config.BlockedValues = []string{"4[0-9]{12}(?:[0-9]{3})?"}
+			processor, err := newRedaction(context.TODO(), tt.config, zaptest.NewLogger(t))
+			require.NoError(t, err)
+
+			attrs := pcommon.NewMap()
+			attrs.PutStr("credit_card", tt.value)
+			processor.processAttrs(context.TODO(), attrs)
+
+			val, _ := attrs.Get("credit_card")
+			assert.Equal(t, tt.expected, val.Str())
+		})
+	}
+}
+
+// TestBlockedKeyPatterns validates that the whole value of the attributes
+// with a key matching a blocked key pattern is masked
+func TestBlockedKeyPatterns(t *testing.T) {
+	config := &Config{
+		AllowAllKeys:       true,
+		BlockedKeyPatterns: []string{".*token.*"},
+		Summary:            "debug",
+	}
+	processor, err := newRedaction(context.TODO(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("auth.token", "abc123")
+	attrs.PutInt("token_count", 3)
+	attrs.PutStr("name", "placeholder")
+	processor.processAttrs(context.TODO(), attrs)
+
+	val, _ := attrs.Get("auth.token")
+	assert.Equal(t, "****", val.Str())
+	val, _ = attrs.Get("token_count")
+	assert.Equal(t, "****", val.Str())
+	val, _ = attrs.Get("name")
+	assert.Equal(t, "placeholder", val.Str())
+	val, _ = attrs.Get(maskedValues)
+	assert.Equal(t, "auth.token,token_count", val.Str())
+}
+
+// TestAllowedValues validates that the values matching an allowed value are
+// not masked even if they match a blocked value or key
+func TestAllowedValues(t *testing.T) {
+	config := &Config{
+		AllowAllKeys:       true,
+		BlockedValues:      []string{"[a-z.]+@[a-z.]+"},
+		BlockedKeyPatterns: []string{"^email$"},
+		AllowedValues:      []string{"@mycompany\\.com$"},
+		Summary:            "debug",
+	}
+	processor, err := newRedaction(context.TODO(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("email", "alice@mycompany.com")
+	attrs.PutStr("recipients", "bob@mycompany.com,eve@example.com")
+	attrs.PutStr("sender", "bob@mycompany.com")
+	processor.processAttrs(context.TODO(), attrs)
+
+	val, _ := attrs.Get("email")
+	assert.Equal(t, "alice@mycompany.com", val.Str())
+	val, _ = attrs.Get("recipients")
+	assert.Equal(t, "bob@mycompany.com,****", val.Str())
+	val, _ = attrs.Get("sender")
+	assert.Equal(t, "bob@mycompany.com", val.Str())
+	val, _ = attrs.Get(maskedValues)
+	assert.Equal(t, "recipients", val.Str())
+}
+
+func hmacSHA256(key, value string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 // runTest transforms the test input data and passes it through the processor
 func runTest(
 	t *testing.T,
@@ -13,8 +13,9 @@ import (
 )
 
 var (
-	errRequiresTargets  = errors.New("requires schema targets")
-	errDuplicateTargets = errors.New("duplicate targets detected")
+	errRequiresTargets   = errors.New("requires schema targets")
+	errDuplicateTargets  = errors.New("duplicate targets detected")
+	errMissingSchemaFile = errors.New("requires a file path")
 )
 
 // Config defines the user provided values for the Schema Processor
@@ -31,6 +32,15 @@ type Config struct {
 	// translated to, allowing older and newer formats
 	// to conform to the target schema identifier.
 	Targets []string `mapstructure:"targets"`
+
+	// SchemaFiles maps a schema URL to a local schema file
+	// that is used instead of downloading the schema URL,
+	// allowing the processor to be used without network access. (Optional field)
+	SchemaFiles map[string]string `mapstructure:"schema_files"`
+
+	// CacheDirectory is where downloaded schema files are stored
+	// so they are reused by later lookups and after restarts. (Optional field)
+	CacheDirectory string `mapstructure:"cache_directory"`
 }
 
 func (c *Config) Validate() error {
@@ -40,6 +50,14 @@ func (c *Config) Validate() error {
 			return err
 		}
 	}
+	for schemaURL, file := range c.SchemaFiles {
+		if _, _, err := translation.GetFamilyAndVersion(schemaURL); err != nil {
+			return err
+		}
+		if file == "" {
+			return fmt.Errorf("schema file for %q: %w", schemaURL, errMissingSchemaFile)
+		}
+	}
 	// Not strictly needed since it would just pass on
 	// any data that doesn't match targets, however defining
 	// this processor with no targets is wasteful.
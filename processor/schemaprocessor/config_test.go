@@ -39,6 +39,10 @@ func TestLoadConfig(t *testing.T) {
 			"https://opentelemetry.io/schemas/1.4.2",
 			"https://example.com/otel/schemas/1.2.0",
 		},
+		SchemaFiles: map[string]string{
+			"https://example.com/otel/schemas/1.2.0": "/etc/otelcol/schemas/1.2.0.yaml",
+		},
+		CacheDirectory: "/var/lib/otelcol/schemas",
 	}, cfg)
 }
 
@@ -86,3 +90,44 @@ func TestConfigurationValidation(t *testing.T) {
 		assert.ErrorIs(t, component.ValidateConfig(cfg), tc.expectError, tc.scenario)
 	}
 }
+
+func TestConfigurationValidationSchemaFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scenario    string
+		files       map[string]string
+		expectError error
+	}{
+		{
+			scenario: "Valid schema file",
+			files: map[string]string{
+				"https://opentelemetry.io/schemas/1.9.0": "schemas/1.9.0.yaml",
+			},
+			expectError: nil,
+		},
+		{
+			scenario: "Invalid schema url",
+			files: map[string]string{
+				"https://opentelemetry.io/schemas/latest": "schemas/latest.yaml",
+			},
+			expectError: translation.ErrInvalidVersion,
+		},
+		{
+			scenario: "Missing file path",
+			files: map[string]string{
+				"https://opentelemetry.io/schemas/1.9.0": "",
+			},
+			expectError: errMissingSchemaFile,
+		},
+	}
+
+	for _, tc := range tests {
+		cfg := &Config{
+			Targets:     []string{"https://opentelemetry.io/schemas/1.9.0"},
+			SchemaFiles: tc.files,
+		}
+
+		assert.ErrorIs(t, component.ValidateConfig(cfg), tc.expectError, tc.scenario)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestMultiConditionalAttributeSetApply(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		cond   *MultiConditionalAttributeSet
+		check  map[string]string
+		attr   pcommon.Map
+		expect pcommon.Map
+	}{
+		{
+			name: "No conditions set, applies to all",
+			cond: NewMultiConditionalAttributeSet(
+				map[string]string{"service.version": "application.version"},
+				map[string][]string{},
+			),
+			check: map[string]string{"span.name": "application start"},
+			attr: testHelperBuildMap(func(m pcommon.Map) {
+				m.PutStr("service.version", "v0.0.0")
+			}),
+			expect: testHelperBuildMap(func(m pcommon.Map) {
+				m.PutStr("application.version", "v0.0.0")
+			}),
+		},
+		{
+			name: "Only one of two conditions matched",
+			cond: NewMultiConditionalAttributeSet(
+				map[string]string{"service.version": "application.version"},
+				map[string][]string{
+					"span.name":  {"application start"},
+					"event.name": {"service started"},
+				},
+			),
+			check: map[string]string{
+				"span.name":  "application start",
+				"event.name": "service stopped",
+			},
+			attr: testHelperBuildMap(func(m pcommon.Map) {
+				m.PutStr("service.version", "v0.0.0")
+			}),
+			expect: testHelperBuildMap(func(m pcommon.Map) {
+				m.PutStr("service.version", "v0.0.0")
+			}),
+		},
+		{
+			name: "Condition field not provided",
+			cond: NewMultiConditionalAttributeSet(
+				map[string]string{"service.version": "application.version"},
+				map[string][]string{
+					"span.name": {"application start"},
+				},
+			),
+			check: map[string]string{"event.name": "service started"},
+			attr: testHelperBuildMap(func(m pcommon.Map) {
+				m.PutStr("service.version", "v0.0.0")
+			}),
+			expect: testHelperBuildMap(func(m pcommon.Map) {
+				m.PutStr("service.version", "v0.0.0")
+			}),
+		},
+		{
+			name: "All conditions matched",
+			cond: NewMultiConditionalAttributeSet(
+				map[string]string{"service.version": "application.version"},
+				map[string][]string{
+					"span.name":  {"application start"},
+					"event.name": {"service started", "service stopped"},
+				},
+			),
+			check: map[string]string{
+				"span.name":  "application start",
+				"event.name": "service stopped",
+			},
+			attr: testHelperBuildMap(func(m pcommon.Map) {
+				m.PutStr("service.version", "v0.0.0")
+			}),
+			expect: testHelperBuildMap(func(m pcommon.Map) {
+				m.PutStr("application.version", "v0.0.0")
+			}),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.NoError(t, tc.cond.Apply(tc.attr, tc.check))
+			assert.Equal(t, tc.expect.AsRaw(), tc.attr.AsRaw(), "Must match the expected value")
+		})
+	}
+}
+
+func TestMultiConditionalAttributeSetSliceRollback(t *testing.T) {
+	t.Parallel()
+
+	slice := NewMultiConditionalAttributeSetSlice(
+		NewMultiConditionalAttributeSet(
+			map[string]string{"service.version": "application.version"},
+			map[string][]string{"span.name": {"application start"}},
+		),
+		NewMultiConditionalAttributeSet(
+			map[string]string{"application.version": "app.version"},
+			map[string][]string{"event.name": {"service started"}},
+		),
+	)
+
+	attrs := testHelperBuildMap(func(m pcommon.Map) {
+		m.PutStr("app.version", "v0.0.0")
+	})
+	check := map[string]string{
+		"span.name":  "application start",
+		"event.name": "service started",
+	}
+
+	assert.NoError(t, slice.Rollback(attrs, check))
+	assert.Equal(t, map[string]any{"service.version": "v0.0.0"}, attrs.AsRaw(), "Must roll back in reverse order")
+
+	assert.NoError(t, slice.Apply(attrs, check))
+	assert.Equal(t, map[string]any{"app.version": "v0.0.0"}, attrs.AsRaw(), "Must apply in defined order")
+}
@@ -0,0 +1,273 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+var errNoProviders = errors.New("no schema providers defined")
+
+// maxCachedTranslations limits the number of schema URLs
+// whose resolved translation is kept in memory.
+const maxCachedTranslations = 1024
+
+// failedLookupMinBackoff and failedLookupMaxBackoff bound the time
+// a failed schema file lookup is remembered before it is attempted again,
+// the backoff doubles each time the lookup fails again.
+const (
+	failedLookupMinBackoff = time.Minute
+	failedLookupMaxBackoff = 30 * time.Minute
+)
+
+// Manager is responsible for loading schema files and
+// handing out the translations that convert signals into
+// the configured targets.
+type Manager interface {
+	// RequestTranslation returns the Translation that converts signals
+	// published with schemaURL into the matching target schema.
+	// If no target matches the schema family, or the schema file
+	// can not be loaded, a Translation that makes no changes is returned.
+	RequestTranslation(ctx context.Context, schemaURL string) Translation
+
+	// Prefetch loads the schema file for schemaURL ahead of time
+	// if it belongs to one of the targeted schema families.
+	Prefetch(ctx context.Context, schemaURL string) error
+
+	// SetProviders sets the providers that are used to look up schema files,
+	// each provider is tried in order until one returns the schema file.
+	SetProviders(providers ...Provider) error
+}
+
+type target struct {
+	schemaURL string
+	version   *Version
+}
+
+// cachedTranslation is a resolved translation, the translations
+// resolved after a failed lookup expire once their backoff has passed.
+type cachedTranslation struct {
+	Translation
+	expires time.Time
+	backoff time.Duration
+}
+
+type manager struct {
+	log *zap.Logger
+	now func() time.Time
+
+	targets map[string]target
+
+	rw          sync.RWMutex
+	providers   []Provider
+	translators map[string]*translator
+
+	// loads ensures a schema file is only fetched once
+	// while concurrent requests are waiting on it.
+	loads        singleflight.Group
+	translations *lru.Cache[string, cachedTranslation]
+}
+
+var _ Manager = (*manager)(nil)
+
+// NewManager creates a manager that will translate any signals
+// belonging to the same schema family as one of the targets.
+func NewManager(targets []string, log *zap.Logger) (Manager, error) {
+	translations, err := lru.New[string, cachedTranslation](maxCachedTranslations)
+	if err != nil {
+		return nil, err
+	}
+	m := &manager{
+		log:          log,
+		now:          time.Now,
+		targets:      make(map[string]target, len(targets)),
+		translators:  make(map[string]*translator),
+		translations: translations,
+	}
+	for _, schemaURL := range targets {
+		family, version, err := GetFamilyAndVersion(schemaURL)
+		if err != nil {
+			return nil, err
+		}
+		m.targets[family] = target{schemaURL: schemaURL, version: version}
+	}
+	return m, nil
+}
+
+func (m *manager) SetProviders(providers ...Provider) error {
+	if len(providers) == 0 {
+		return errNoProviders
+	}
+	m.rw.Lock()
+	defer m.rw.Unlock()
+
+	m.providers = slices.Clone(providers)
+	return nil
+}
+
+func (m *manager) Prefetch(ctx context.Context, schemaURL string) error {
+	family, version, err := GetFamilyAndVersion(schemaURL)
+	if err != nil {
+		return err
+	}
+	if _, ok := m.targets[family]; !ok {
+		m.log.Debug("Skipping prefetch of schema not matching any target", zap.String("schema-url", schemaURL))
+		return nil
+	}
+	_, err = m.loadTranslator(ctx, family, version, schemaURL)
+	return err
+}
+
+func (m *manager) RequestTranslation(ctx context.Context, schemaURL string) Translation {
+	var backoff time.Duration
+	if c, cached := m.translations.Get(schemaURL); cached {
+		if c.expires.IsZero() || m.now().Before(c.expires) {
+			return c.Translation
+		}
+		backoff = c.backoff
+	}
+
+	family, version, err := GetFamilyAndVersion(schemaURL)
+	if err != nil {
+		m.log.Debug("Unable to parse schema url", zap.String("schema-url", schemaURL), zap.Error(err))
+		return m.storeTranslation(schemaURL, newNopTranslation(schemaURL))
+	}
+	tgt, ok := m.targets[family]
+	if !ok || version.Equal(tgt.version) {
+		return m.storeTranslation(schemaURL, newNopTranslation(schemaURL))
+	}
+
+	// The schema file of the newest version is required since
+	// it contains the definitions of all older versions.
+	lookup, required := tgt.schemaURL, tgt.version
+	if version.GreaterThan(tgt.version) {
+		lookup, required = schemaURL, version
+	}
+	t, err := m.loadTranslator(ctx, family, required, lookup)
+	if err != nil {
+		// Failed lookups are stored until their backoff has passed
+		// so that they are attempted again later on without
+		// looking up the schema file for every signal.
+		backoff = min(max(2*backoff, failedLookupMinBackoff), failedLookupMaxBackoff)
+		m.log.Warn("Unable to load schema translation",
+			zap.String("schema-url", lookup),
+			zap.Duration("retry-after", backoff),
+			zap.Error(err),
+		)
+		tn := newNopTranslation(schemaURL)
+		m.translations.Add(schemaURL, cachedTranslation{
+			Translation: tn,
+			expires:     m.now().Add(backoff),
+			backoff:     backoff,
+		})
+		return tn
+	}
+	tn, ok := t.translation(version, tgt.version, tgt.schemaURL)
+	if !ok {
+		m.log.Debug("Schema version is not defined within the schema file",
+			zap.String("schema-url", schemaURL),
+			zap.String("target", tgt.schemaURL),
+		)
+		return m.storeTranslation(schemaURL, newNopTranslation(schemaURL))
+	}
+	return m.storeTranslation(schemaURL, tn)
+}
+
+func (m *manager) storeTranslation(schemaURL string, tn Translation) Translation {
+	m.translations.Add(schemaURL, cachedTranslation{Translation: tn})
+	return tn
+}
+
+// loadTranslator returns the translator for the schema family
+// that is able to translate up to the required version,
+// fetching the schema file from the providers when it is not already loaded.
+// The schema file is fetched without holding the lock so that requests
+// for other schema families are not blocked while it is in flight.
+func (m *manager) loadTranslator(ctx context.Context, family string, required *Version, schemaURL string) (*translator, error) {
+	if t, ok := m.loadedTranslator(family, required); ok {
+		return t, nil
+	}
+	v, err, _ := m.loads.Do(schemaURL, func() (any, error) {
+		// Another request may have loaded the schema file
+		// while this one was waiting on a previous lookup.
+		if t, ok := m.loadedTranslator(family, required); ok {
+			return t, nil
+		}
+		t, err := m.lookupTranslator(ctx, family, schemaURL)
+		if err != nil {
+			return nil, err
+		}
+		return m.installTranslator(t), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*translator), nil
+}
+
+func (m *manager) loadedTranslator(family string, required *Version) (*translator, bool) {
+	m.rw.RLock()
+	defer m.rw.RUnlock()
+
+	t, ok := m.translators[family]
+	return t, ok && !t.version.LessThan(required)
+}
+
+func (m *manager) lookupTranslator(ctx context.Context, family, schemaURL string) (*translator, error) {
+	m.rw.RLock()
+	providers := m.providers
+	m.rw.RUnlock()
+	if len(providers) == 0 {
+		return nil, errNoProviders
+	}
+
+	var errs error
+	for _, p := range providers {
+		content, err := p.Lookup(ctx, schemaURL)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		t, err := newTranslatorFromReader(content)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse schema %q: %w", schemaURL, err)
+		}
+		if t.family != family {
+			return nil, fmt.Errorf("schema file for %q defines family %q: %w", schemaURL, t.family, ErrInvalidFamily)
+		}
+		return t, nil
+	}
+	return nil, errs
+}
+
+// installTranslator stores t as the translator of its family
+// unless a newer schema file has been loaded in the meantime,
+// and returns the translator that is in use.
+func (m *manager) installTranslator(t *translator) *translator {
+	m.rw.Lock()
+	defer m.rw.Unlock()
+
+	if current, ok := m.translators[t.family]; ok && !current.version.LessThan(t.version) {
+		return current
+	}
+	m.translators[t.family] = t
+	// Any previously resolved translations for this family
+	// could now be resolved using the newer schema file.
+	for _, u := range m.translations.Keys() {
+		if f, _, err := GetFamilyAndVersion(u); err == nil && f == t.family {
+			m.translations.Remove(u)
+		}
+	}
+	return t
+}
@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/fixture"
+)
+
+type countingProvider struct {
+	Provider
+	lookups atomic.Int64
+}
+
+func (cp *countingProvider) Lookup(ctx context.Context, schemaURL string) (io.Reader, error) {
+	cp.lookups.Add(1)
+	return cp.Provider.Lookup(ctx, schemaURL)
+}
+
+func newTestManager(t *testing.T, targets ...string) (Manager, *countingProvider) {
+	t.Helper()
+
+	m, err := NewManager(targets, zaptest.NewLogger(t))
+	require.NoError(t, err, "Must not error creating manager")
+
+	p := &countingProvider{Provider: NewFileProvider(map[string]string{
+		"https://example.com/schemas/1.1.0": "testdata/schema-1.1.0.yaml",
+		"https://example.com/schemas/1.2.0": "testdata/schema-1.2.0.yaml",
+	})}
+	require.NoError(t, m.SetProviders(p), "Must not error setting providers")
+	return m, p
+}
+
+func TestNewManagerInvalidTarget(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewManager([]string{"https://example.com/schemas/latest"}, zaptest.NewLogger(t))
+	assert.ErrorIs(t, err, ErrInvalidVersion)
+
+	m, err := NewManager(nil, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.SetProviders(), errNoProviders)
+}
+
+func TestManagerRequestTranslation(t *testing.T) {
+	t.Parallel()
+
+	m, p := newTestManager(t, "https://example.com/schemas/1.1.0")
+
+	for _, tc := range []struct {
+		name      string
+		schemaURL string
+		expectURL string
+		expectKey string
+	}{
+		{
+			name:      "unrelated schema family",
+			schemaURL: "https://opentelemetry.io/schemas/1.0.0",
+			expectURL: "https://opentelemetry.io/schemas/1.0.0",
+			expectKey: "k8s.pod.name",
+		},
+		{
+			name:      "invalid schema url",
+			schemaURL: "not a schema url",
+			expectURL: "not a schema url",
+			expectKey: "k8s.pod.name",
+		},
+		{
+			name:      "already at target version",
+			schemaURL: "https://example.com/schemas/1.1.0",
+			expectURL: "https://example.com/schemas/1.1.0",
+			expectKey: "k8s.pod.name",
+		},
+		{
+			name:      "unknown version within family",
+			schemaURL: "https://example.com/schemas/0.1.0",
+			expectURL: "https://example.com/schemas/0.1.0",
+			expectKey: "k8s.pod.name",
+		},
+		{
+			name:      "older version is upgraded",
+			schemaURL: "https://example.com/schemas/1.0.0",
+			expectURL: "https://example.com/schemas/1.1.0",
+			expectKey: "kubernetes.pod.name",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tn := m.RequestTranslation(context.Background(), tc.schemaURL)
+			require.NotNil(t, tn, "Must always return a translation")
+
+			res := pcommon.NewResource()
+			res.Attributes().PutStr("k8s.pod.name", "pod-0")
+			assert.NoError(t, tn.ApplyResourceChanges(res))
+			assert.Equal(t, tc.expectURL, tn.SchemaURL())
+			assert.Equal(t, map[string]any{tc.expectKey: "pod-0"}, res.Attributes().AsRaw())
+		})
+	}
+	assert.EqualValues(t, 1, p.lookups.Load(), "Must only load the schema file once")
+}
+
+func TestManagerNewerVersionThanTarget(t *testing.T) {
+	t.Parallel()
+
+	m, p := newTestManager(t, "https://example.com/schemas/1.1.0")
+	require.NoError(t, m.Prefetch(context.Background(), "https://example.com/schemas/1.1.0"))
+	require.NoError(t, m.Prefetch(context.Background(), "https://opentelemetry.io/schemas/1.9.0"), "Must skip unrelated families")
+	assert.EqualValues(t, 1, p.lookups.Load())
+
+	tn := m.RequestTranslation(context.Background(), "https://example.com/schemas/1.2.0")
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("k8s.pod.name.value", "pod-0")
+	assert.NoError(t, tn.ApplyResourceChanges(res))
+	assert.Equal(t, "https://example.com/schemas/1.1.0", tn.SchemaURL())
+	assert.Equal(t, map[string]any{"kubernetes.pod.name": "pod-0"}, res.Attributes().AsRaw(), "Must downgrade to target")
+	assert.EqualValues(t, 2, p.lookups.Load(), "Must load the newer schema file")
+}
+
+func TestManagerFailedLookup(t *testing.T) {
+	t.Parallel()
+
+	m, p := newTestManager(t, "https://example.com/schemas/1.0.0")
+	assert.ErrorIs(t, m.Prefetch(context.Background(), "https://example.com/schemas/1.0.0"), ErrSchemaNotFound)
+
+	now := time.Unix(0, 0)
+	m.(*manager).now = func() time.Time { return now }
+	requestTranslation := func() {
+		tn := m.RequestTranslation(context.Background(), "https://example.com/schemas/0.9.0")
+		assert.Equal(t, "https://example.com/schemas/0.9.0", tn.SchemaURL(), "Must not change the schema url")
+	}
+
+	for i := 0; i < 2; i++ {
+		requestTranslation()
+	}
+	assert.EqualValues(t, 2, p.lookups.Load(), "Must not retry failed lookups before the backoff has passed")
+
+	now = now.Add(failedLookupMinBackoff)
+	requestTranslation()
+	assert.EqualValues(t, 3, p.lookups.Load(), "Must retry failed lookups once the backoff has passed")
+
+	now = now.Add(failedLookupMinBackoff)
+	requestTranslation()
+	assert.EqualValues(t, 3, p.lookups.Load(), "Must double the backoff of lookups failing again")
+
+	now = now.Add(failedLookupMinBackoff)
+	requestTranslation()
+	assert.EqualValues(t, 4, p.lookups.Load())
+}
+
+func TestManagerConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newTestManager(t, "https://example.com/schemas/1.1.0")
+	fixture.ParallelRaceCompute(t, 10, func() error {
+		for _, schemaURL := range []string{
+			"https://example.com/schemas/1.0.0",
+			"https://example.com/schemas/1.2.0",
+		} {
+			res := pcommon.NewResource()
+			res.Attributes().PutStr("k8s.pod.name", "pod-0")
+			if err := m.RequestTranslation(context.Background(), schemaURL).ApplyResourceChanges(res); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+type blockingProvider struct {
+	Provider
+	schemaURL string
+	started   chan struct{}
+	release   chan struct{}
+	lookups   atomic.Int64
+}
+
+func (bp *blockingProvider) Lookup(ctx context.Context, schemaURL string) (io.Reader, error) {
+	if schemaURL == bp.schemaURL && bp.lookups.Add(1) == 1 {
+		close(bp.started)
+		<-bp.release
+	}
+	return bp.Provider.Lookup(ctx, schemaURL)
+}
+
+func TestManagerLookupDoesNotBlockLoadedSchemas(t *testing.T) {
+	t.Parallel()
+
+	m, p := newTestManager(t, "https://example.com/schemas/1.1.0")
+	require.NoError(t, m.Prefetch(context.Background(), "https://example.com/schemas/1.1.0"))
+
+	bp := &blockingProvider{
+		Provider:  p,
+		schemaURL: "https://example.com/schemas/1.2.0",
+		started:   make(chan struct{}),
+		release:   make(chan struct{}),
+	}
+	require.NoError(t, m.SetProviders(bp))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tn := m.RequestTranslation(context.Background(), "https://example.com/schemas/1.2.0")
+			assert.Equal(t, "https://example.com/schemas/1.1.0", tn.SchemaURL(), "Must translate to the target")
+		}()
+	}
+	<-bp.started
+
+	// The schema file of the target is already loaded,
+	// so this must not wait on the lookup that is in flight.
+	tn := m.RequestTranslation(context.Background(), "https://example.com/schemas/1.0.0")
+	assert.Equal(t, "https://example.com/schemas/1.1.0", tn.SchemaURL(), "Must translate to the target")
+
+	close(bp.release)
+	wg.Wait()
+	assert.EqualValues(t, 1, bp.lookups.Load(), "Must share the lookup between concurrent requests")
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+
+	schema "go.opentelemetry.io/otel/schema/v1.1"
+	"go.uber.org/zap"
+)
+
+// ErrSchemaNotFound is returned by a Provider when it
+// does not have a definition for the requested schema URL.
+var ErrSchemaNotFound = errors.New("schema not found")
+
+// Provider allows for different sources to be used
+// to look up the content of a schema translation file.
+type Provider interface {
+	// Lookup returns the content of the schema file for the schemaURL.
+	Lookup(ctx context.Context, schemaURL string) (io.Reader, error)
+}
+
+type httpProvider struct {
+	client *http.Client
+}
+
+var _ Provider = (*httpProvider)(nil)
+
+// NewHTTPProvider returns a Provider that downloads
+// schema files from the schema URL itself.
+func NewHTTPProvider(client *http.Client) Provider {
+	return &httpProvider{client: client}
+}
+
+func (hp *httpProvider) Lookup(ctx context.Context, schemaURL string) (io.Reader, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, schemaURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := hp.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d fetching %q", resp.StatusCode, schemaURL)
+	}
+
+	content := bytes.NewBuffer(nil)
+	if _, err := io.Copy(content, resp.Body); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+type fileProvider struct {
+	files map[string]string
+}
+
+var _ Provider = (*fileProvider)(nil)
+
+// NewFileProvider returns a Provider that reads schema files
+// from the local file system, files maps the schema URL to the file path.
+func NewFileProvider(files map[string]string) Provider {
+	return &fileProvider{files: files}
+}
+
+func (fp *fileProvider) Lookup(_ context.Context, schemaURL string) (io.Reader, error) {
+	p, ok := fp.files[schemaURL]
+	if !ok {
+		return nil, fmt.Errorf("no file defined for %q: %w", schemaURL, ErrSchemaNotFound)
+	}
+	content, err := os.ReadFile(filepath.Clean(p))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(content), nil
+}
+
+type cacheProvider struct {
+	dir  string
+	next Provider
+	log  *zap.Logger
+}
+
+var _ Provider = (*cacheProvider)(nil)
+
+// NewCacheProvider returns a Provider that stores each schema file
+// returned by next inside dir so that later lookups, including those
+// done after a restart, do not need to fetch the file again.
+func NewCacheProvider(dir string, next Provider, log *zap.Logger) Provider {
+	return &cacheProvider{
+		dir:  dir,
+		next: next,
+		log:  log,
+	}
+}
+
+func (cp *cacheProvider) Lookup(ctx context.Context, schemaURL string) (io.Reader, error) {
+	p, err := cp.cachePath(schemaURL)
+	if err != nil {
+		return nil, err
+	}
+	if content, err := os.ReadFile(p); err == nil {
+		return bytes.NewReader(content), nil
+	}
+
+	r, err := cp.next.Lookup(ctx, schemaURL)
+	if err != nil {
+		return nil, err
+	}
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// Only valid schema files are cached to avoid
+	// persisting error pages returned by a server.
+	if _, err := schema.Parse(bytes.NewReader(content)); err != nil {
+		return nil, fmt.Errorf("invalid schema file for %q: %w", schemaURL, err)
+	}
+	if err := cp.store(p, content); err != nil {
+		cp.log.Warn("Unable to cache schema file", zap.String("schema-url", schemaURL), zap.Error(err))
+	}
+	return bytes.NewReader(content), nil
+}
+
+// cachePath maps the schema URL into a location within the cache directory
+// that mirrors the host and path of the URL.
+func (cp *cacheProvider) cachePath(schemaURL string) (string, error) {
+	u, err := url.Parse(schemaURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("must have a host name: %w", ErrInvalidFamily)
+	}
+	// Cleaning the path as an absolute path ensures that
+	// the result can not escape the cache directory.
+	return filepath.Join(cp.dir, u.Host, filepath.FromSlash(path.Clean("/"+u.Path))), nil
+}
+
+func (cp *cacheProvider) store(p string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(p), filepath.Base(p)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		return errors.Join(err, f.Close(), os.Remove(f.Name()))
+	}
+	if err := f.Close(); err != nil {
+		return errors.Join(err, os.Remove(f.Name()))
+	}
+	return os.Rename(f.Name(), p)
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func newTestSchemaServer(t *testing.T, file string) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+
+	content, err := os.ReadFile(file)
+	require.NoError(t, err, "Must be able to read fixture")
+
+	requests := new(atomic.Int64)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/schemas/1.1.0" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+func TestFileProvider(t *testing.T) {
+	t.Parallel()
+
+	p := NewFileProvider(map[string]string{
+		"https://example.com/schemas/1.1.0": "testdata/schema-1.1.0.yaml",
+		"https://example.com/schemas/1.3.0": "testdata/does-not-exist.yaml",
+	})
+
+	r, err := p.Lookup(context.Background(), "https://example.com/schemas/1.1.0")
+	require.NoError(t, err, "Must not error reading defined file")
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	expect, err := os.ReadFile("testdata/schema-1.1.0.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, expect, content)
+
+	_, err = p.Lookup(context.Background(), "https://example.com/schemas/1.2.0")
+	assert.ErrorIs(t, err, ErrSchemaNotFound, "Must report undefined schema urls")
+
+	_, err = p.Lookup(context.Background(), "https://example.com/schemas/1.3.0")
+	assert.ErrorIs(t, err, os.ErrNotExist, "Must report missing files")
+}
+
+func TestHTTPProvider(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := newTestSchemaServer(t, "testdata/schema-1.1.0.yaml")
+	p := NewHTTPProvider(srv.Client())
+
+	r, err := p.Lookup(context.Background(), srv.URL+"/schemas/1.1.0")
+	require.NoError(t, err, "Must not error fetching schema")
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.NotEmpty(t, content)
+
+	_, err = p.Lookup(context.Background(), srv.URL+"/schemas/1.2.0")
+	assert.Error(t, err, "Must error on unexpected status code")
+}
+
+func TestCacheProvider(t *testing.T) {
+	t.Parallel()
+
+	srv, requests := newTestSchemaServer(t, "testdata/schema-1.1.0.yaml")
+	dir := t.TempDir()
+
+	for i := 0; i < 3; i++ {
+		// Recreating the provider is equivalent to restarting the
+		// collector, which must continue to use the cached file.
+		p := NewCacheProvider(dir, NewHTTPProvider(srv.Client()), zaptest.NewLogger(t))
+
+		r, err := p.Lookup(context.Background(), srv.URL+"/schemas/1.1.0")
+		require.NoError(t, err, "Must not error fetching schema")
+		_, err = newTranslatorFromReader(r)
+		require.NoError(t, err, "Must return a valid schema file")
+	}
+	assert.EqualValues(t, 1, requests.Load(), "Must only fetch the schema file once")
+
+	entries, err := filepath.Glob(filepath.Join(dir, "*", "schemas", "1.1.0"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "Must store the schema file within the cache directory")
+}
+
+func TestCacheProviderInvalidContent(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("<html>not a schema</html>"))
+	}))
+	t.Cleanup(srv.Close)
+
+	dir := t.TempDir()
+	p := NewCacheProvider(dir, NewHTTPProvider(srv.Client()), zaptest.NewLogger(t))
+	_, err := p.Lookup(context.Background(), srv.URL+"/schemas/1.1.0")
+	assert.Error(t, err, "Must reject invalid schema files")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "Must not cache invalid schema files")
+}
+
+func TestCacheProviderPath(t *testing.T) {
+	t.Parallel()
+
+	cp := &cacheProvider{dir: "/var/cache/schemas"}
+
+	p, err := cp.cachePath("https://example.com/../../schemas/1.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("/var/cache/schemas", "example.com", "schemas", "1.1.0"), p, "Must not escape the cache directory")
+
+	_, err = cp.cachePath("/schemas/1.1.0")
+	assert.ErrorIs(t, err, ErrInvalidFamily)
+}
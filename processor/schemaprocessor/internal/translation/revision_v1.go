@@ -4,7 +4,12 @@
 package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
 
 import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/otel/schema/v1.0/ast"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
 )
@@ -12,17 +17,24 @@ import (
 // RevisionV1 represents all changes that are to be
 // applied to a signal at a given version.
 type RevisionV1 struct {
-	ver              *Version
-	all              *migrate.AttributeChangeSetSlice
-	resource         *migrate.AttributeChangeSetSlice
-	spans            *migrate.ConditionalAttributeSetSlice
-	eventNames       *migrate.SignalNameChangeSlice
-	eventAttrsOnSpan *migrate.ConditionalAttributeSetSlice
-	eventAttrsOnName *migrate.ConditionalAttributeSetSlice
-	metricsAttrs     *migrate.ConditionalAttributeSetSlice
-	metricNames      *migrate.SignalNameChangeSlice
+	ver          *Version
+	all          *migrate.AttributeChangeSetSlice
+	resource     *migrate.AttributeChangeSetSlice
+	spans        *migrate.ConditionalAttributeSetSlice
+	eventNames   *migrate.SignalNameChangeSlice
+	eventAttrs   *migrate.MultiConditionalAttributeSetSlice
+	logs         *migrate.AttributeChangeSetSlice
+	metricsAttrs *migrate.ConditionalAttributeSetSlice
+	metricNames  *migrate.SignalNameChangeSlice
 }
 
+const (
+	// conditionSpanName and conditionEventName are the fields
+	// used to restrict span event attribute changes.
+	conditionSpanName  = "span.name"
+	conditionEventName = "event.name"
+)
+
 // NewRevision processes the VersionDef and assigns the version to this revision
 // to allow sorting within a slice.
 // Since VersionDef uses custom types for various definitions, it isn't possible
@@ -30,15 +42,15 @@ type RevisionV1 struct {
 // Generics would be handy here.
 func NewRevision(ver *Version, def ast.VersionDef) *RevisionV1 {
 	return &RevisionV1{
-		ver:              ver,
-		all:              newAttributeChangeSetSliceFromChanges(def.All),
-		resource:         newAttributeChangeSetSliceFromChanges(def.Resources),
-		spans:            newSpanConditionalAttributeSlice(def.Spans),
-		eventNames:       newSpanEventSignalSlice(def.SpanEvents),
-		eventAttrsOnSpan: newSpanEventConditionalSpans(def.SpanEvents),
-		eventAttrsOnName: newSpanEventConditionalNames(def.SpanEvents),
-		metricsAttrs:     newMetricConditionalSlice(def.Metrics),
-		metricNames:      newMetricNameSignalSlice(def.Metrics),
+		ver:          ver,
+		all:          newAttributeChangeSetSliceFromChanges(def.All),
+		resource:     newAttributeChangeSetSliceFromChanges(def.Resources),
+		spans:        newSpanConditionalAttributeSlice(def.Spans),
+		eventNames:   newSpanEventSignalSlice(def.SpanEvents),
+		eventAttrs:   newSpanEventConditionalAttributes(def.SpanEvents),
+		logs:         newLogsAttributeChangeSetSlice(def.Logs),
+		metricsAttrs: newMetricConditionalSlice(def.Metrics),
+		metricNames:  newMetricNameSignalSlice(def.Metrics),
 	}
 }
 
@@ -75,24 +87,35 @@ func newSpanEventSignalSlice(events ast.SpanEvents) *migrate.SignalNameChangeSli
 	return migrate.NewSignalNameChangeSlice(values...)
 }
 
-func newSpanEventConditionalSpans(events ast.SpanEvents) *migrate.ConditionalAttributeSetSlice {
-	values := make([]*migrate.ConditionalAttributeSet, 0, 10)
+func newSpanEventConditionalAttributes(events ast.SpanEvents) *migrate.MultiConditionalAttributeSetSlice {
+	values := make([]*migrate.MultiConditionalAttributeSet, 0, 10)
 	for _, ch := range events.Changes {
 		if rename := ch.RenameAttributes; rename != nil {
-			values = append(values, migrate.NewConditionalAttributeSet(rename.AttributeMap, rename.ApplyToSpans...))
+			spans := make([]string, 0, len(rename.ApplyToSpans))
+			for _, name := range rename.ApplyToSpans {
+				spans = append(spans, string(name))
+			}
+			events := make([]string, 0, len(rename.ApplyToEvents))
+			for _, name := range rename.ApplyToEvents {
+				events = append(events, string(name))
+			}
+			values = append(values, migrate.NewMultiConditionalAttributeSet(rename.AttributeMap, map[string][]string{
+				conditionSpanName:  spans,
+				conditionEventName: events,
+			}))
 		}
 	}
-	return migrate.NewConditionalAttributeSetSlice(values...)
+	return migrate.NewMultiConditionalAttributeSetSlice(values...)
 }
 
-func newSpanEventConditionalNames(events ast.SpanEvents) *migrate.ConditionalAttributeSetSlice {
-	values := make([]*migrate.ConditionalAttributeSet, 0, 10)
-	for _, ch := range events.Changes {
-		if rename := ch.RenameAttributes; rename != nil {
-			values = append(values, migrate.NewConditionalAttributeSet(rename.AttributeMap, rename.ApplyToEvents...))
+func newLogsAttributeChangeSetSlice(logs ast.Logs) *migrate.AttributeChangeSetSlice {
+	values := make([]*migrate.AttributeChangeSet, 0, 10)
+	for _, ch := range logs.Changes {
+		if renamed := ch.RenameAttributes; renamed != nil {
+			values = append(values, migrate.NewAttributeChangeSet(renamed.AttributeMap))
 		}
 	}
-	return migrate.NewConditionalAttributeSetSlice(values...)
+	return migrate.NewAttributeChangeSetSlice(values...)
 }
 
 func newMetricConditionalSlice(metrics ast.Metrics) *migrate.ConditionalAttributeSetSlice {
@@ -112,3 +135,128 @@ func newMetricNameSignalSlice(metrics ast.Metrics) *migrate.SignalNameChangeSlic
 	}
 	return migrate.NewSignalNameChangeSlice(values...)
 }
+
+// Version returns the schema version this revision migrates to.
+func (r *RevisionV1) Version() *Version {
+	return r.ver
+}
+
+// Resource applies the changes defined for resources to attrs.
+// The `all` section is applied before the resource specific changes
+// when migrating forward, and last when rolling back.
+func (r *RevisionV1) Resource(ss migrate.StateSelector, attrs pcommon.Map) error {
+	switch ss {
+	case migrate.StateSelectorApply:
+		return multierr.Combine(r.all.Apply(attrs), r.resource.Apply(attrs))
+	case migrate.StateSelectorRollback:
+		return multierr.Combine(r.resource.Rollback(attrs), r.all.Rollback(attrs))
+	}
+	return nil
+}
+
+// LogRecord applies the changes defined for logs to the record's attributes.
+func (r *RevisionV1) LogRecord(ss migrate.StateSelector, record plog.LogRecord) error {
+	attrs := record.Attributes()
+	switch ss {
+	case migrate.StateSelectorApply:
+		return multierr.Combine(r.all.Apply(attrs), r.logs.Apply(attrs))
+	case migrate.StateSelectorRollback:
+		return multierr.Combine(r.logs.Rollback(attrs), r.all.Rollback(attrs))
+	}
+	return nil
+}
+
+// Span applies the changes defined for spans and span events.
+// Attribute changes are matched against the names as they were
+// before this revision, so renames are applied after the attributes
+// and are rolled back before them.
+func (r *RevisionV1) Span(ss migrate.StateSelector, span ptrace.Span) (errs error) {
+	switch ss {
+	case migrate.StateSelectorApply:
+		errs = multierr.Combine(
+			r.all.Apply(span.Attributes()),
+			r.spans.Apply(span.Attributes(), span.Name()),
+		)
+		for i := 0; i < span.Events().Len(); i++ {
+			event := span.Events().At(i)
+			errs = multierr.Combine(
+				errs,
+				r.all.Apply(event.Attributes()),
+				r.eventAttrs.Apply(event.Attributes(), map[string]string{
+					conditionSpanName:  span.Name(),
+					conditionEventName: event.Name(),
+				}),
+			)
+			r.eventNames.Apply(event)
+		}
+	case migrate.StateSelectorRollback:
+		for i := 0; i < span.Events().Len(); i++ {
+			event := span.Events().At(i)
+			r.eventNames.Rollback(event)
+			errs = multierr.Combine(
+				errs,
+				r.eventAttrs.Rollback(event.Attributes(), map[string]string{
+					conditionSpanName:  span.Name(),
+					conditionEventName: event.Name(),
+				}),
+				r.all.Rollback(event.Attributes()),
+			)
+		}
+		errs = multierr.Combine(
+			errs,
+			r.spans.Rollback(span.Attributes(), span.Name()),
+			r.all.Rollback(span.Attributes()),
+		)
+	}
+	return errs
+}
+
+// Metric applies the changes defined for metrics onto the
+// metric name and the attributes of every data point.
+func (r *RevisionV1) Metric(ss migrate.StateSelector, metric pmetric.Metric) (errs error) {
+	switch ss {
+	case migrate.StateSelectorApply:
+		errs = forEachDataPointAttributes(metric, func(attrs pcommon.Map) error {
+			return multierr.Combine(
+				r.all.Apply(attrs),
+				r.metricsAttrs.Apply(attrs, metric.Name()),
+			)
+		})
+		r.metricNames.Apply(metric)
+	case migrate.StateSelectorRollback:
+		r.metricNames.Rollback(metric)
+		errs = forEachDataPointAttributes(metric, func(attrs pcommon.Map) error {
+			return multierr.Combine(
+				r.metricsAttrs.Rollback(attrs, metric.Name()),
+				r.all.Rollback(attrs),
+			)
+		})
+	}
+	return errs
+}
+
+func forEachDataPointAttributes(metric pmetric.Metric, fn func(attrs pcommon.Map) error) (errs error) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Gauge().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Sum().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Histogram().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.ExponentialHistogram().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Summary().DataPoints().At(i).Attributes()))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	ast10 "go.opentelemetry.io/otel/schema/v1.0/ast"
+	schema "go.opentelemetry.io/otel/schema/v1.1"
+	ast11 "go.opentelemetry.io/otel/schema/v1.1/ast"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
+)
+
+// Translation applies the changes required to convert signals
+// that were published with one schema version into the target version.
+type Translation interface {
+	// SchemaURL is the schema URL that applies to the signal
+	// once the translation has been applied.
+	SchemaURL() string
+
+	ApplyResourceChanges(resource pcommon.Resource) error
+
+	ApplyLogRecordChanges(record plog.LogRecord) error
+
+	ApplySpanChanges(span ptrace.Span) error
+
+	ApplyMetricChanges(metric pmetric.Metric) error
+}
+
+// changeSet is an ordered set of revisions that are either
+// applied or rolled back in order to reach the target schema.
+type changeSet struct {
+	schemaURL string
+	ss        migrate.StateSelector
+	revisions []*RevisionV1
+}
+
+var _ Translation = (*changeSet)(nil)
+
+// newNopTranslation returns a Translation that makes no changes
+// and keeps the provided schema URL.
+func newNopTranslation(schemaURL string) Translation {
+	return &changeSet{schemaURL: schemaURL, ss: migrate.StateSelectorApply}
+}
+
+func (cs *changeSet) SchemaURL() string {
+	return cs.schemaURL
+}
+
+func (cs *changeSet) ApplyResourceChanges(resource pcommon.Resource) (errs error) {
+	for _, rev := range cs.revisions {
+		errs = multierr.Append(errs, rev.Resource(cs.ss, resource.Attributes()))
+	}
+	return errs
+}
+
+func (cs *changeSet) ApplyLogRecordChanges(record plog.LogRecord) (errs error) {
+	for _, rev := range cs.revisions {
+		errs = multierr.Append(errs, rev.LogRecord(cs.ss, record))
+	}
+	return errs
+}
+
+func (cs *changeSet) ApplySpanChanges(span ptrace.Span) (errs error) {
+	for _, rev := range cs.revisions {
+		errs = multierr.Append(errs, rev.Span(cs.ss, span))
+	}
+	return errs
+}
+
+func (cs *changeSet) ApplyMetricChanges(metric pmetric.Metric) (errs error) {
+	for _, rev := range cs.revisions {
+		errs = multierr.Append(errs, rev.Metric(cs.ss, metric))
+	}
+	return errs
+}
+
+// translator holds all the revisions defined within a schema file
+// and is able to resolve the path between any two versions it contains.
+type translator struct {
+	family    string
+	version   *Version
+	indexes   map[Version]int
+	revisions []*RevisionV1
+}
+
+// newTranslatorFromReader parses the schema file content
+// and orders each of the defined versions so that the
+// changes can be applied in sequence.
+func newTranslatorFromReader(content io.Reader) (*translator, error) {
+	def, err := schema.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	family, version, err := GetFamilyAndVersion(def.SchemaURL)
+	if err != nil {
+		return nil, err
+	}
+	t := &translator{
+		family:    family,
+		version:   version,
+		indexes:   make(map[Version]int, len(def.Versions)),
+		revisions: make([]*RevisionV1, 0, len(def.Versions)),
+	}
+	for key, versionDef := range def.Versions {
+		ver, err := NewVersion(string(key))
+		if err != nil {
+			return nil, fmt.Errorf("version %q: %w", key, err)
+		}
+		t.revisions = append(t.revisions, NewRevision(ver, newVersionDefV1(versionDef)))
+	}
+	sort.Slice(t.revisions, func(i, j int) bool {
+		return t.revisions[i].Version().LessThan(t.revisions[j].Version())
+	})
+	for i, rev := range t.revisions {
+		t.indexes[*rev.Version()] = i
+	}
+	return t, nil
+}
+
+// newVersionDefV1 converts the file format 1.1 definition into
+// the 1.0 definition since metric splits are not supported.
+func newVersionDefV1(def ast11.VersionDef) ast10.VersionDef {
+	metrics := ast10.Metrics{
+		Changes: make([]ast10.MetricsChange, 0, len(def.Metrics.Changes)),
+	}
+	for _, ch := range def.Metrics.Changes {
+		if ch.RenameMetrics == nil && ch.RenameAttributes == nil {
+			continue
+		}
+		metrics.Changes = append(metrics.Changes, ast10.MetricsChange{
+			RenameMetrics:    ch.RenameMetrics,
+			RenameAttributes: ch.RenameAttributes,
+		})
+	}
+	return ast10.VersionDef{
+		All:        def.All,
+		Resources:  def.Resources,
+		Spans:      def.Spans,
+		SpanEvents: def.SpanEvents,
+		Logs:       def.Logs,
+		Metrics:    metrics,
+	}
+}
+
+// translation returns the revisions that are required to
+// convert a signal from one version into the other.
+// If either version is not defined within the schema file,
+// the returned boolean is false.
+func (t *translator) translation(from, to *Version, schemaURL string) (Translation, bool) {
+	start, ok := t.indexes[*from]
+	if !ok {
+		return nil, false
+	}
+	end, ok := t.indexes[*to]
+	if !ok {
+		return nil, false
+	}
+	cs := &changeSet{schemaURL: schemaURL}
+	switch {
+	case start < end:
+		// Upgrading requires each revision after the
+		// starting version to be applied in order.
+		cs.ss = migrate.StateSelectorApply
+		cs.revisions = t.revisions[start+1 : end+1]
+	case start > end:
+		// Downgrading rolls back each revision down to,
+		// but not including, the target version.
+		cs.ss = migrate.StateSelectorRollback
+		for i := start; i > end; i-- {
+			cs.revisions = append(cs.revisions, t.revisions[i])
+		}
+	default:
+		cs.ss = migrate.StateSelectorApply
+	}
+	return cs, true
+}
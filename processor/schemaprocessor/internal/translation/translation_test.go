@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTestTranslator(t *testing.T, file string) *translator {
+	t.Helper()
+
+	f, err := os.Open(file)
+	require.NoError(t, err, "Must be able to open fixture")
+	t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+	tr, err := newTranslatorFromReader(f)
+	require.NoError(t, err, "Must not error when parsing fixture")
+	return tr
+}
+
+func TestNewTranslatorFromReader(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslator(t, "testdata/schema-1.2.0.yaml")
+	assert.Equal(t, "https://example.com/schemas", tr.family)
+	assert.Equal(t, &Version{1, 2, 0}, tr.version)
+	assert.Equal(t, map[Version]int{
+		{1, 0, 0}: 0,
+		{1, 1, 0}: 1,
+		{1, 2, 0}: 2,
+	}, tr.indexes, "Must have revisions sorted by version")
+
+	f, err := os.Open("testdata/invalid-schema.yaml")
+	require.NoError(t, err, "Must be able to open fixture")
+	defer f.Close()
+
+	_, err = newTranslatorFromReader(f)
+	assert.Error(t, err, "Must error when schema url is missing")
+}
+
+func TestTranslationUnknownVersion(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslator(t, "testdata/schema-1.2.0.yaml")
+
+	_, ok := tr.translation(&Version{1, 3, 0}, &Version{1, 0, 0}, "https://example.com/schemas/1.0.0")
+	assert.False(t, ok, "Must not support versions missing from the schema file")
+
+	_, ok = tr.translation(&Version{1, 0, 0}, &Version{0, 9, 0}, "https://example.com/schemas/0.9.0")
+	assert.False(t, ok, "Must not support targets missing from the schema file")
+}
+
+func TestTranslationUpgrade(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslator(t, "testdata/schema-1.2.0.yaml")
+	tn, ok := tr.translation(&Version{1, 0, 0}, &Version{1, 1, 0}, "https://example.com/schemas/1.1.0")
+	require.True(t, ok, "Must support defined versions")
+	assert.Equal(t, "https://example.com/schemas/1.1.0", tn.SchemaURL())
+
+	t.Run("resource", func(t *testing.T) {
+		res := pcommon.NewResource()
+		res.Attributes().PutStr("k8s.pod.name", "pod-0")
+		res.Attributes().PutStr("telemetry.auto.version", "1.0.0")
+
+		assert.NoError(t, tn.ApplyResourceChanges(res))
+		assert.Equal(t, map[string]any{
+			"kubernetes.pod.name":          "pod-0",
+			"telemetry.auto_instr.version": "1.0.0",
+		}, res.Attributes().AsRaw())
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		record := plog.NewLogRecord()
+		record.Attributes().PutStr("process.stacktrace", "panic: oops")
+
+		assert.NoError(t, tn.ApplyLogRecordChanges(record))
+		assert.Equal(t, map[string]any{
+			"exception.stacktrace": "panic: oops",
+		}, record.Attributes().AsRaw())
+	})
+
+	t.Run("spans", func(t *testing.T) {
+		span := ptrace.NewSpan()
+		span.SetName("HTTP GET")
+		span.Attributes().PutStr("peer.service", "database")
+		event := span.Events().AppendEmpty()
+		event.SetName("exception")
+		event.Attributes().PutStr("exception.msg", "timeout")
+		other := span.Events().AppendEmpty()
+		other.SetName("retry")
+		other.Attributes().PutStr("exception.msg", "timeout")
+
+		assert.NoError(t, tn.ApplySpanChanges(span))
+		assert.Equal(t, map[string]any{"peer.service.name": "database"}, span.Attributes().AsRaw())
+		assert.Equal(t, "exception.recorded", event.Name())
+		assert.Equal(t, map[string]any{"exception.message": "timeout"}, event.Attributes().AsRaw())
+		assert.Equal(t, "retry", other.Name())
+		assert.Equal(t, map[string]any{"exception.msg": "timeout"}, other.Attributes().AsRaw(), "Must not change unmatched events")
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		metric := pmetric.NewMetric()
+		metric.SetName("system.cpu.utilization")
+		dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("status", "idle")
+		dp.Attributes().PutStr("k8s.pod.name", "pod-0")
+
+		renamed := pmetric.NewMetric()
+		renamed.SetName("container.cpu.usage.total")
+		renamed.SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("status", "idle")
+
+		assert.NoError(t, tn.ApplyMetricChanges(metric))
+		assert.NoError(t, tn.ApplyMetricChanges(renamed))
+		assert.Equal(t, map[string]any{
+			"state":               "idle",
+			"kubernetes.pod.name": "pod-0",
+		}, dp.Attributes().AsRaw())
+		assert.Equal(t, "cpu.usage.total", renamed.Name())
+		assert.Equal(t, map[string]any{"status": "idle"}, renamed.Sum().DataPoints().At(0).Attributes().AsRaw())
+	})
+}
+
+func TestTranslationMultipleVersions(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslator(t, "testdata/schema-1.2.0.yaml")
+
+	up, ok := tr.translation(&Version{1, 0, 0}, &Version{1, 2, 0}, "https://example.com/schemas/1.2.0")
+	require.True(t, ok, "Must support defined versions")
+
+	metric := pmetric.NewMetric()
+	metric.SetName("container.cpu.usage.total")
+	metric.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("k8s.pod.name", "pod-0")
+
+	assert.NoError(t, up.ApplyMetricChanges(metric))
+	assert.Equal(t, "container.cpu.time", metric.Name())
+	assert.Equal(t, map[string]any{
+		"k8s.pod.name.value": "pod-0",
+	}, metric.Gauge().DataPoints().At(0).Attributes().AsRaw())
+
+	down, ok := tr.translation(&Version{1, 2, 0}, &Version{1, 0, 0}, "https://example.com/schemas/1.0.0")
+	require.True(t, ok, "Must support defined versions")
+
+	assert.NoError(t, down.ApplyMetricChanges(metric))
+	assert.Equal(t, "container.cpu.usage.total", metric.Name(), "Must restore the original name")
+	assert.Equal(t, map[string]any{
+		"k8s.pod.name": "pod-0",
+	}, metric.Gauge().DataPoints().At(0).Attributes().AsRaw(), "Must restore the original attributes")
+}
+
+func TestTranslationDowngradeSpans(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslator(t, "testdata/schema-1.1.0.yaml")
+	tn, ok := tr.translation(&Version{1, 1, 0}, &Version{1, 0, 0}, "https://example.com/schemas/1.0.0")
+	require.True(t, ok, "Must support defined versions")
+
+	span := ptrace.NewSpan()
+	span.SetName("HTTP GET")
+	span.Attributes().PutStr("peer.service.name", "database")
+	event := span.Events().AppendEmpty()
+	event.SetName("exception.recorded")
+	event.Attributes().PutStr("exception.message", "timeout")
+
+	assert.NoError(t, tn.ApplySpanChanges(span))
+	assert.Equal(t, map[string]any{"peer.service": "database"}, span.Attributes().AsRaw())
+	assert.Equal(t, "exception", event.Name())
+	assert.Equal(t, map[string]any{"exception.msg": "timeout"}, event.Attributes().AsRaw())
+}
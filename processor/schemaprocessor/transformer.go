@@ -8,16 +8,25 @@ import (
 	"errors"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
 )
 
 type transformer struct {
-	targets []string
-	log     *zap.Logger
+	targets        []string
+	prefetch       []string
+	schemaFiles    map[string]string
+	cacheDirectory string
+	httpConfig     confighttp.ClientConfig
+	telemetry      component.TelemetrySettings
+	log            *zap.Logger
+	manager        translation.Manager
 }
 
 func newTransformer(
@@ -29,29 +38,152 @@ func newTransformer(
 	if !ok {
 		return nil, errors.New("invalid configuration provided")
 	}
+	m, err := translation.NewManager(cfg.Targets, set.Logger)
+	if err != nil {
+		return nil, err
+	}
 	return &transformer{
-		log:     set.Logger,
-		targets: cfg.Targets,
+		log:            set.Logger,
+		telemetry:      set.TelemetrySettings,
+		targets:        cfg.Targets,
+		prefetch:       cfg.Prefetch,
+		schemaFiles:    cfg.SchemaFiles,
+		cacheDirectory: cfg.CacheDirectory,
+		httpConfig:     cfg.ClientConfig,
+		manager:        m,
 	}, nil
 }
 
-func (t transformer) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+func (t transformer) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	for rl := 0; rl < ld.ResourceLogs().Len(); rl++ {
+		rLogs := ld.ResourceLogs().At(rl)
+		resourceSchemaURL := rLogs.SchemaUrl()
+		if resourceSchemaURL != "" {
+			tn := t.manager.RequestTranslation(ctx, resourceSchemaURL)
+			t.reportErr(tn.ApplyResourceChanges(rLogs.Resource()), resourceSchemaURL)
+			rLogs.SetSchemaUrl(tn.SchemaURL())
+		}
+		for sl := 0; sl < rLogs.ScopeLogs().Len(); sl++ {
+			sLogs := rLogs.ScopeLogs().At(sl)
+			schemaURL := scopeSchemaURL(sLogs.SchemaUrl(), resourceSchemaURL)
+			if schemaURL == "" {
+				continue
+			}
+			tn := t.manager.RequestTranslation(ctx, schemaURL)
+			for lr := 0; lr < sLogs.LogRecords().Len(); lr++ {
+				t.reportErr(tn.ApplyLogRecordChanges(sLogs.LogRecords().At(lr)), schemaURL)
+			}
+			if sLogs.SchemaUrl() != "" {
+				sLogs.SetSchemaUrl(tn.SchemaURL())
+			}
+		}
+	}
 	return ld, nil
 }
 
-func (t transformer) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (t transformer) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	for rm := 0; rm < md.ResourceMetrics().Len(); rm++ {
+		rMetrics := md.ResourceMetrics().At(rm)
+		resourceSchemaURL := rMetrics.SchemaUrl()
+		if resourceSchemaURL != "" {
+			tn := t.manager.RequestTranslation(ctx, resourceSchemaURL)
+			t.reportErr(tn.ApplyResourceChanges(rMetrics.Resource()), resourceSchemaURL)
+			rMetrics.SetSchemaUrl(tn.SchemaURL())
+		}
+		for sm := 0; sm < rMetrics.ScopeMetrics().Len(); sm++ {
+			sMetrics := rMetrics.ScopeMetrics().At(sm)
+			schemaURL := scopeSchemaURL(sMetrics.SchemaUrl(), resourceSchemaURL)
+			if schemaURL == "" {
+				continue
+			}
+			tn := t.manager.RequestTranslation(ctx, schemaURL)
+			for m := 0; m < sMetrics.Metrics().Len(); m++ {
+				t.reportErr(tn.ApplyMetricChanges(sMetrics.Metrics().At(m)), schemaURL)
+			}
+			if sMetrics.SchemaUrl() != "" {
+				sMetrics.SetSchemaUrl(tn.SchemaURL())
+			}
+		}
+	}
 	return md, nil
 }
 
-func (t transformer) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+func (t transformer) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	for rs := 0; rs < td.ResourceSpans().Len(); rs++ {
+		rSpans := td.ResourceSpans().At(rs)
+		resourceSchemaURL := rSpans.SchemaUrl()
+		if resourceSchemaURL != "" {
+			tn := t.manager.RequestTranslation(ctx, resourceSchemaURL)
+			t.reportErr(tn.ApplyResourceChanges(rSpans.Resource()), resourceSchemaURL)
+			rSpans.SetSchemaUrl(tn.SchemaURL())
+		}
+		for ss := 0; ss < rSpans.ScopeSpans().Len(); ss++ {
+			sSpans := rSpans.ScopeSpans().At(ss)
+			schemaURL := scopeSchemaURL(sSpans.SchemaUrl(), resourceSchemaURL)
+			if schemaURL == "" {
+				continue
+			}
+			tn := t.manager.RequestTranslation(ctx, schemaURL)
+			for s := 0; s < sSpans.Spans().Len(); s++ {
+				t.reportErr(tn.ApplySpanChanges(sSpans.Spans().At(s)), schemaURL)
+			}
+			if sSpans.SchemaUrl() != "" {
+				sSpans.SetSchemaUrl(tn.SchemaURL())
+			}
+		}
+	}
 	return td, nil
 }
 
+// reportErr logs any naming conflicts found while translating,
+// the translated data is still forwarded since the conflicting
+// attribute is resolved in favour of the renamed value.
+func (t transformer) reportErr(err error, schemaURL string) {
+	if err != nil {
+		t.log.Debug("Conflict while applying schema translation",
+			zap.String("schema-url", schemaURL),
+			zap.Error(err),
+		)
+	}
+}
+
+// scopeSchemaURL returns the schema URL that applies to the scope,
+// which is the resource schema URL unless the scope defines its own.
+func scopeSchemaURL(scope, resource string) string {
+	if scope != "" {
+		return scope
+	}
+	return resource
+}
+
 // start will load the remote file definition if it isn't already cached
 // and resolve the schema translation file
-func (t *transformer) start(_ context.Context, _ component.Host) error {
-	for _, target := range t.targets {
-		t.log.Info("Fetching remote schema url", zap.String("schema-url", target))
+func (t *transformer) start(ctx context.Context, host component.Host) error {
+	client, err := t.httpConfig.ToClient(ctx, host, t.telemetry)
+	if err != nil {
+		return err
+	}
+
+	var providers []translation.Provider
+	if len(t.schemaFiles) > 0 {
+		providers = append(providers, translation.NewFileProvider(t.schemaFiles))
+	}
+	remote := translation.NewHTTPProvider(client)
+	if t.cacheDirectory != "" {
+		remote = translation.NewCacheProvider(t.cacheDirectory, remote, t.log)
+	}
+	providers = append(providers, remote)
+	if err := t.manager.SetProviders(providers...); err != nil {
+		return err
+	}
+
+	for _, schemaURL := range append(append([]string{}, t.prefetch...), t.targets...) {
+		t.log.Info("Prefetching schema url", zap.String("schema-url", schemaURL))
+		if err := t.manager.Prefetch(ctx, schemaURL); err != nil {
+			// Failing to fetch a schema should not stop the collector,
+			// the lookup is attempted again once a signal requires it.
+			t.log.Warn("Unable to fetch schema url", zap.String("schema-url", schemaURL), zap.Error(err))
+		}
 	}
 	return nil
 }
@@ -6,11 +6,13 @@ package schemaprocessor
 import (
 	"context"
 	_ "embed"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -86,3 +88,108 @@ func TestTransformerProcessing(t *testing.T) {
 		assert.Equal(t, in, out, "Must return the same data (subject to change)")
 	})
 }
+
+func newTestTranslatingTransformer(t *testing.T) *transformer {
+	t.Helper()
+
+	cfg := newDefaultConfiguration().(*Config)
+	cfg.Targets = []string{"https://example.com/schemas/1.1.0"}
+	cfg.SchemaFiles = map[string]string{
+		"https://example.com/schemas/1.1.0": filepath.Join("internal", "translation", "testdata", "schema-1.1.0.yaml"),
+		"https://example.com/schemas/1.2.0": filepath.Join("internal", "translation", "testdata", "schema-1.2.0.yaml"),
+	}
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	trans, err := newTransformer(context.Background(), cfg, processor.Settings{
+		TelemetrySettings: componenttest.NewNopTelemetrySettings(),
+	})
+	require.NoError(t, err, "Must not error when creating transformer")
+	require.NoError(t, trans.start(context.Background(), componenttest.NewNopHost()))
+	return trans
+}
+
+func TestTransformerTranslation(t *testing.T) {
+	t.Parallel()
+
+	trans := newTestTranslatingTransformer(t)
+
+	t.Run("metrics", func(t *testing.T) {
+		in := pmetric.NewMetrics()
+		rMetrics := in.ResourceMetrics().AppendEmpty()
+		rMetrics.SetSchemaUrl("https://example.com/schemas/1.0.0")
+		rMetrics.Resource().Attributes().PutStr("k8s.pod.name", "pod-0")
+		m := rMetrics.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("container.cpu.usage.total")
+		m.SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("k8s.pod.name", "pod-0")
+
+		// Scopes that define their own schema url are translated independently.
+		sMetrics := rMetrics.ScopeMetrics().AppendEmpty()
+		sMetrics.SetSchemaUrl("https://example.com/schemas/1.2.0")
+		m = sMetrics.Metrics().AppendEmpty()
+		m.SetName("container.cpu.time")
+
+		out, err := trans.processMetrics(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing metrics")
+
+		rMetrics = out.ResourceMetrics().At(0)
+		assert.Equal(t, "https://example.com/schemas/1.1.0", rMetrics.SchemaUrl())
+		assert.Equal(t, map[string]any{"kubernetes.pod.name": "pod-0"}, rMetrics.Resource().Attributes().AsRaw())
+		m = rMetrics.ScopeMetrics().At(0).Metrics().At(0)
+		assert.Equal(t, "", rMetrics.ScopeMetrics().At(0).SchemaUrl(), "Must not set an empty scope schema url")
+		assert.Equal(t, "cpu.usage.total", m.Name())
+		assert.Equal(t, map[string]any{"kubernetes.pod.name": "pod-0"}, m.Sum().DataPoints().At(0).Attributes().AsRaw())
+		assert.Equal(t, "https://example.com/schemas/1.1.0", rMetrics.ScopeMetrics().At(1).SchemaUrl())
+		assert.Equal(t, "cpu.usage.total", rMetrics.ScopeMetrics().At(1).Metrics().At(0).Name())
+	})
+
+	t.Run("traces", func(t *testing.T) {
+		in := ptrace.NewTraces()
+		rSpans := in.ResourceSpans().AppendEmpty()
+		rSpans.SetSchemaUrl("https://example.com/schemas/1.0.0")
+		s := rSpans.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		s.SetName("HTTP GET")
+		s.Attributes().PutStr("peer.service", "database")
+		e := s.Events().AppendEmpty()
+		e.SetName("exception")
+		e.Attributes().PutStr("exception.msg", "timeout")
+
+		out, err := trans.processTraces(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing traces")
+
+		rSpans = out.ResourceSpans().At(0)
+		assert.Equal(t, "https://example.com/schemas/1.1.0", rSpans.SchemaUrl())
+		s = rSpans.ScopeSpans().At(0).Spans().At(0)
+		assert.Equal(t, map[string]any{"peer.service.name": "database"}, s.Attributes().AsRaw())
+		assert.Equal(t, "exception.recorded", s.Events().At(0).Name())
+		assert.Equal(t, map[string]any{"exception.message": "timeout"}, s.Events().At(0).Attributes().AsRaw())
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		in := plog.NewLogs()
+		rLogs := in.ResourceLogs().AppendEmpty()
+		rLogs.SetSchemaUrl("https://example.com/schemas/1.2.0")
+		rLogs.Resource().Attributes().PutStr("k8s.pod.name.value", "pod-0")
+		rLogs.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr("exception.stacktrace", "panic: oops")
+
+		out, err := trans.processLogs(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing logs")
+
+		rLogs = out.ResourceLogs().At(0)
+		assert.Equal(t, "https://example.com/schemas/1.1.0", rLogs.SchemaUrl())
+		assert.Equal(t, map[string]any{"kubernetes.pod.name": "pod-0"}, rLogs.Resource().Attributes().AsRaw())
+		assert.Equal(t, map[string]any{"exception.stacktrace": "panic: oops"}, rLogs.ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw())
+	})
+
+	t.Run("unmatched schema family", func(t *testing.T) {
+		in := plog.NewLogs()
+		rLogs := in.ResourceLogs().AppendEmpty()
+		rLogs.SetSchemaUrl("https://opentelemetry.io/schemas/1.9.0")
+		rLogs.Resource().Attributes().PutStr("k8s.pod.name", "pod-0")
+		expect := plog.NewLogs()
+		in.CopyTo(expect)
+
+		out, err := trans.processLogs(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing logs")
+		assert.Equal(t, expect, out, "Must not modify signals from other schema families")
+	})
+}
@@ -6,6 +6,8 @@ package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry
 import (
 	"time"
 
+	"go.opentelemetry.io/collector/component"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
@@ -230,6 +232,13 @@ type DecisionCacheConfig struct {
 	// For effective use, this value should be at least an order of magnitude higher than Config.NumTraces.
 	// If left as default 0, a no-op DecisionCache will be used.
 	SampledCacheSize int `mapstructure:"sampled_cache_size"`
+	// NonSampledCacheSize specifies the size of the cache that holds the non-sampled trace IDs.
+	// Spans for trace IDs in this cache are dropped without being buffered again.
+	// If left as default 0, a no-op DecisionCache will be used.
+	NonSampledCacheSize int `mapstructure:"non_sampled_cache_size"`
+	// TTL specifies for how long a decision is kept in the caches, even when the caches aren't full.
+	// If left as default 0, decisions are only removed once the caches are full.
+	TTL time.Duration `mapstructure:"ttl"`
 }
 
 // Config holds the configuration for tail-based sampling.
@@ -248,4 +257,12 @@ type Config struct {
 	PolicyCfgs []PolicyCfg `mapstructure:"policies"`
 	// DecisionCache holds configuration for the decision cache(s)
 	DecisionCache DecisionCacheConfig `mapstructure:"decision_cache"`
+	// StorageID is the ID of the storage extension used to persist the pending traces
+	// and the decision caches when the processor shuts down, restoring them once it starts again.
+	// If left as default nil, the processor state is only kept in memory.
+	StorageID *component.ID `mapstructure:"storage"`
+	// PersistInterval is how often the processor state is written to the storage while running,
+	// so that it survives the collector being killed without a graceful shutdown.
+	// If set to 0, the state is only persisted when the processor shuts down.
+	PersistInterval time.Duration `mapstructure:"persist_interval"`
 }
@@ -35,7 +35,12 @@ func TestLoadConfig(t *testing.T) {
 			DecisionWait:            10 * time.Second,
 			NumTraces:               100,
 			ExpectedNewTracesPerSec: 10,
-			DecisionCache:           DecisionCacheConfig{SampledCacheSize: 500},
+			DecisionCache: DecisionCacheConfig{
+				SampledCacheSize:    500,
+				NonSampledCacheSize: 1000,
+				TTL:                 time.Hour,
+			},
+			PersistInterval: 30 * time.Second,
 			PolicyCfgs: []PolicyCfg{
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
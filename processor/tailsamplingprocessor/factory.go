@@ -28,6 +28,8 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		DecisionWait: 30 * time.Second,
 		NumTraces:    50000,
+
+		PersistInterval: 10 * time.Second,
 	}
 }
 
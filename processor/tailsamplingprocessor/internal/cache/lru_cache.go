@@ -5,6 +5,7 @@ package cache // import "github.com/open-telemetry/opentelemetry-collector-contr
 
 import (
 	"encoding/binary"
+	"time"
 
 	lru "github.com/hashicorp/golang-lru/v2"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -15,8 +16,16 @@ import (
 // It does not specify the type of sampling decision that was made, only that
 // a decision was made for an ID. You need separate DecisionCaches for caching
 // sampled and not sampled trace IDs.
+// When a TTL is set, entries older than the TTL are treated as missing.
 type lruDecisionCache[V any] struct {
-	cache *lru.Cache[uint64, V]
+	cache *lru.Cache[uint64, lruEntry[V]]
+	ttl   time.Duration
+	now   func() time.Time
+}
+
+type lruEntry[V any] struct {
+	value     V
+	expiresAt time.Time
 }
 
 var _ Cache[any] = (*lruDecisionCache[any])(nil)
@@ -25,24 +34,72 @@ var _ Cache[any] = (*lruDecisionCache[any])(nil)
 // The size parameter indicates the amount of keys the cache will hold before it
 // starts evicting the least recently used key.
 func NewLRUDecisionCache[V any](size int) (Cache[V], error) {
-	c, err := lru.New[uint64, V](size)
+	return NewLRUDecisionCacheWithTTL[V](size, 0)
+}
+
+// NewLRUDecisionCacheWithTTL returns a new lruDecisionCache where entries expire once
+// the given TTL has passed since they were added. A TTL of zero means entries never expire.
+func NewLRUDecisionCacheWithTTL[V any](size int, ttl time.Duration) (Cache[V], error) {
+	c, err := lru.New[uint64, lruEntry[V]](size)
 	if err != nil {
 		return nil, err
 	}
-	return &lruDecisionCache[V]{cache: c}, nil
+	return &lruDecisionCache[V]{cache: c, ttl: ttl, now: time.Now}, nil
 }
 
 func (c *lruDecisionCache[V]) Get(id pcommon.TraceID) (V, bool) {
-	return c.cache.Get(rightHalfTraceID(id))
+	key := rightHalfTraceID(id)
+	e, ok := c.cache.Get(key)
+	if ok && c.expired(e) {
+		c.cache.Remove(key)
+		var v V
+		return v, false
+	}
+	return e.value, ok
 }
 
 func (c *lruDecisionCache[V]) Put(id pcommon.TraceID, v V) {
-	_ = c.cache.Add(rightHalfTraceID(id), v)
+	e := lruEntry[V]{value: v}
+	if c.ttl > 0 {
+		e.expiresAt = c.now().Add(c.ttl)
+	}
+	_ = c.cache.Add(rightHalfTraceID(id), e)
 }
 
 // Delete is no-op since LRU relies on least recently used key being evicting automatically
 func (c *lruDecisionCache[V]) Delete(_ pcommon.TraceID) {}
 
+// Snapshot returns the entries with only the right half of the trace ID set,
+// as that is the part used as the key.
+func (c *lruDecisionCache[V]) Snapshot() []Entry[V] {
+	keys := c.cache.Keys()
+	entries := make([]Entry[V], 0, len(keys))
+	for _, key := range keys {
+		e, ok := c.cache.Peek(key)
+		if !ok || c.expired(e) {
+			continue
+		}
+		var id pcommon.TraceID
+		binary.LittleEndian.PutUint64(id[8:], key)
+		entries = append(entries, Entry[V]{ID: id, Value: e.value, ExpiresAt: e.expiresAt})
+	}
+	return entries
+}
+
+func (c *lruDecisionCache[V]) Restore(entries []Entry[V]) {
+	for _, entry := range entries {
+		e := lruEntry[V]{value: entry.Value, expiresAt: entry.ExpiresAt}
+		if c.expired(e) {
+			continue
+		}
+		_ = c.cache.Add(rightHalfTraceID(entry.ID), e)
+	}
+}
+
+func (c *lruDecisionCache[V]) expired(e lruEntry[V]) bool {
+	return !e.expiresAt.IsZero() && !c.now().Before(e.expiresAt)
+}
+
 func rightHalfTraceID(id pcommon.TraceID) uint64 {
 	return binary.LittleEndian.Uint64(id[8:])
 }
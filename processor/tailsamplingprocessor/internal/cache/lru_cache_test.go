@@ -6,6 +6,7 @@ package cache
 import (
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -81,3 +82,66 @@ func traceIDFromHex(idStr string) (pcommon.TraceID, error) {
 	_, err := hex.Decode(id[:], []byte(idStr))
 	return id, err
 }
+
+func TestTTLExpiration(t *testing.T) {
+	c, err := NewLRUDecisionCacheWithTTL[bool](2, time.Minute)
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	c.(*lruDecisionCache[bool]).now = func() time.Time { return now }
+
+	id, err := traceIDFromHex("12341234123412341234123412341231")
+	require.NoError(t, err)
+	c.Put(id, true)
+
+	now = now.Add(59 * time.Second)
+	v, ok := c.Get(id)
+	assert.True(t, v)
+	assert.True(t, ok)
+
+	now = now.Add(time.Second)
+	v, ok = c.Get(id)
+	assert.False(t, v)  // expired, returns zero-value
+	assert.False(t, ok) // expired, not OK
+}
+
+func TestSnapshotAndRestore(t *testing.T) {
+	c, err := NewLRUDecisionCacheWithTTL[bool](3, time.Minute)
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	c.(*lruDecisionCache[bool]).now = func() time.Time { return now }
+
+	id1, err := traceIDFromHex("12341234123412341234123412341231")
+	require.NoError(t, err)
+	id2, err := traceIDFromHex("12341234123412341234123412341232")
+	require.NoError(t, err)
+	id3, err := traceIDFromHex("12341234123412341234123412341233")
+	require.NoError(t, err)
+
+	c.Put(id1, true)
+	now = now.Add(30 * time.Second)
+	c.Put(id2, true)
+	c.Put(id3, false)
+
+	now = now.Add(40 * time.Second)
+	entries := c.Snapshot()
+	require.Len(t, entries, 2, "Must not include expired entries")
+	assert.Equal(t, time.Unix(1090, 0), entries[0].ExpiresAt)
+
+	restored, err := NewLRUDecisionCacheWithTTL[bool](3, time.Minute)
+	require.NoError(t, err)
+	restored.(*lruDecisionCache[bool]).now = func() time.Time { return now }
+	restored.Restore(entries)
+
+	_, ok := restored.Get(id1)
+	assert.False(t, ok)
+	v, ok := restored.Get(id2)
+	assert.True(t, v)
+	assert.True(t, ok)
+	v, ok = restored.Get(id3)
+	assert.False(t, v)
+	assert.True(t, ok)
+
+	now = now.Add(20 * time.Second)
+	_, ok = restored.Get(id2)
+	assert.False(t, ok, "Must keep the original expiration")
+}
@@ -22,3 +22,9 @@ func (n *nopDecisionCache[V]) Put(_ pcommon.TraceID, _ V) {
 }
 
 func (n *nopDecisionCache[V]) Delete(_ pcommon.TraceID) {}
+
+func (n *nopDecisionCache[V]) Snapshot() []Entry[V] {
+	return nil
+}
+
+func (n *nopDecisionCache[V]) Restore(_ []Entry[V]) {}
@@ -18,4 +18,5 @@ func TestNopCache(t *testing.T) {
 	v, ok := c.Get(id)
 	assert.False(t, v)
 	assert.False(t, ok)
+	assert.Empty(t, c.Snapshot())
 }
@@ -3,7 +3,11 @@
 
 package cache // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
 
-import "go.opentelemetry.io/collector/pdata/pcommon"
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
 
 // Cache is a cache using a pcommon.TraceID as the key and any generic type as the value.
 type Cache[V any] interface {
@@ -14,4 +18,21 @@ type Cache[V any] interface {
 	Put(id pcommon.TraceID, v V)
 	// Delete deletes the value for the given id
 	Delete(id pcommon.TraceID)
+	// Snapshot returns the entries currently held by the cache, from the least to the most
+	// recently used, excluding the ones that have expired.
+	Snapshot() []Entry[V]
+	// Restore adds the given entries to the cache, keeping their original expiration.
+	Restore(entries []Entry[V])
+}
+
+// Entry is a single cached value, used to persist the content of a cache.
+type Entry[V any] struct {
+	// ID is the key of the entry. Implementations may only use part of the
+	// trace ID as the key, in which case only that part is set.
+	ID pcommon.TraceID
+	// Value is the value for the ID.
+	Value V
+	// ExpiresAt is the time after which the entry is no longer returned,
+	// the zero value denotes an entry that never expires.
+	ExpiresAt time.Time
 }
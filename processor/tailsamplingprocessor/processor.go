@@ -5,6 +5,7 @@ package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"runtime"
@@ -14,6 +15,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
@@ -44,20 +46,27 @@ type policy struct {
 // policy to sample traces.
 type tailSamplingSpanProcessor struct {
 	ctx context.Context
+	id  component.ID
 
 	telemetry *metadata.TelemetryBuilder
 	logger    *zap.Logger
 
-	nextConsumer    consumer.Traces
-	maxNumTraces    uint64
-	policies        []*policy
-	idToTrace       sync.Map
-	policyTicker    timeutils.TTicker
-	tickerFrequency time.Duration
-	decisionBatcher idbatcher.Batcher
-	sampledIDCache  cache.Cache[bool]
-	deleteChan      chan pcommon.TraceID
-	numTracesOnMap  *atomic.Uint64
+	nextConsumer      consumer.Traces
+	maxNumTraces      uint64
+	policies          []*policy
+	idToTrace         sync.Map
+	policyTicker      timeutils.TTicker
+	tickerFrequency   time.Duration
+	decisionBatcher   idbatcher.Batcher
+	sampledIDCache    cache.Cache[bool]
+	nonSampledIDCache cache.Cache[bool]
+	deleteChan        chan pcommon.TraceID
+	numTracesOnMap    *atomic.Uint64
+	storageID         *component.ID
+	storageClient     storage.Client
+	persistInterval   time.Duration
+	persistDone       chan struct{}
+	persistWG         sync.WaitGroup
 }
 
 // spanAndScope a structure for holding information about span and its instrumentation scope.
@@ -91,21 +100,32 @@ func newTracesProcessor(ctx context.Context, set processor.Settings, nextConsume
 	}
 	sampledDecisions := cache.NewNopDecisionCache[bool]()
 	if cfg.DecisionCache.SampledCacheSize > 0 {
-		sampledDecisions, err = cache.NewLRUDecisionCache[bool](cfg.DecisionCache.SampledCacheSize)
+		sampledDecisions, err = cache.NewLRUDecisionCacheWithTTL[bool](cfg.DecisionCache.SampledCacheSize, cfg.DecisionCache.TTL)
+		if err != nil {
+			return nil, err
+		}
+	}
+	nonSampledDecisions := cache.NewNopDecisionCache[bool]()
+	if cfg.DecisionCache.NonSampledCacheSize > 0 {
+		nonSampledDecisions, err = cache.NewLRUDecisionCacheWithTTL[bool](cfg.DecisionCache.NonSampledCacheSize, cfg.DecisionCache.TTL)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	tsp := &tailSamplingSpanProcessor{
-		ctx:            ctx,
-		telemetry:      telemetry,
-		nextConsumer:   nextConsumer,
-		maxNumTraces:   cfg.NumTraces,
-		sampledIDCache: sampledDecisions,
-		logger:         telemetrySettings.Logger,
-		numTracesOnMap: &atomic.Uint64{},
-		deleteChan:     make(chan pcommon.TraceID, cfg.NumTraces),
+		ctx:               ctx,
+		id:                set.ID,
+		telemetry:         telemetry,
+		nextConsumer:      nextConsumer,
+		maxNumTraces:      cfg.NumTraces,
+		sampledIDCache:    sampledDecisions,
+		nonSampledIDCache: nonSampledDecisions,
+		logger:            telemetrySettings.Logger,
+		numTracesOnMap:    &atomic.Uint64{},
+		deleteChan:        make(chan pcommon.TraceID, cfg.NumTraces),
+		storageID:         cfg.StorageID,
+		persistInterval:   cfg.PersistInterval,
 	}
 	tsp.policyTicker = &timeutils.PolicyTicker{OnTickFunc: tsp.samplingPolicyOnTick}
 
@@ -188,6 +208,13 @@ func withSampledDecisionCache(c cache.Cache[bool]) Option {
 	}
 }
 
+// withNonSampledDecisionCache sets the cache which the processor uses to store recently non-sampled trace IDs.
+func withNonSampledDecisionCache(c cache.Cache[bool]) Option {
+	return func(tsp *tailSamplingSpanProcessor) {
+		tsp.nonSampledIDCache = c
+	}
+}
+
 func getPolicyEvaluator(settings component.TelemetrySettings, cfg *PolicyCfg) (sampling.PolicyEvaluator, error) {
 	switch cfg.Type {
 	case Composite:
@@ -277,6 +304,8 @@ func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
 
 		if decision == sampling.Sampled {
 			tsp.releaseSampledTrace(context.Background(), id, allSpans)
+		} else {
+			tsp.nonSampledIDCache.Put(id, true)
 		}
 	}
 
@@ -374,6 +403,10 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 			tsp.telemetry.ProcessorTailSamplingEarlyReleasesFromCacheDecision.Add(tsp.ctx, int64(len(spans)))
 			continue
 		}
+		// If the trace ID is in the non-sampled cache, drop the spans without buffering them again
+		if _, ok := tsp.nonSampledIDCache.Get(id); ok {
+			continue
+		}
 
 		lenSpans := int64(len(spans))
 		lenPolicies := len(tsp.policies)
@@ -445,16 +478,47 @@ func (tsp *tailSamplingSpanProcessor) Capabilities() consumer.Capabilities {
 }
 
 // Start is invoked during service startup.
-func (tsp *tailSamplingSpanProcessor) Start(context.Context, component.Host) error {
+func (tsp *tailSamplingSpanProcessor) Start(ctx context.Context, host component.Host) error {
+	if tsp.storageID != nil {
+		client, err := getStorageClient(ctx, host, *tsp.storageID, tsp.id)
+		if err != nil {
+			return err
+		}
+		tsp.storageClient = client
+		if err := tsp.restoreState(ctx); err != nil {
+			return fmt.Errorf("failed to restore the processor state: %w", err)
+		}
+		if tsp.persistInterval > 0 {
+			tsp.persistDone = make(chan struct{})
+			tsp.persistWG.Add(1)
+			go tsp.persistPeriodically()
+		}
+	}
+
 	tsp.policyTicker.Start(tsp.tickerFrequency)
 	return nil
 }
 
 // Shutdown is invoked during service shutdown.
-func (tsp *tailSamplingSpanProcessor) Shutdown(context.Context) error {
+func (tsp *tailSamplingSpanProcessor) Shutdown(ctx context.Context) error {
 	tsp.decisionBatcher.Stop()
 	tsp.policyTicker.Stop()
-	return nil
+
+	if tsp.storageClient == nil {
+		return nil
+	}
+	if tsp.persistDone != nil {
+		close(tsp.persistDone)
+		tsp.persistWG.Wait()
+	}
+	var errs []error
+	if err := tsp.persistState(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to persist the processor state: %w", err))
+	}
+	if err := tsp.storageClient.Close(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
 }
 
 func (tsp *tailSamplingSpanProcessor) dropTrace(traceID pcommon.TraceID, deletionTime time.Time) {
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+const (
+	sampledDecisionsKey    = "sampled_decisions"
+	nonSampledDecisionsKey = "non_sampled_decisions"
+	pendingTracesKey       = "pending_traces"
+
+	// each persisted decision holds the trace ID, the decision and its expiration
+	decisionEntrySize = 16 + 1 + 8
+)
+
+var errCorruptedDecisions = errors.New("corrupted decision cache in the storage")
+
+func getStorageClient(ctx context.Context, host component.Host, storageID component.ID, componentID component.ID) (storage.Client, error) {
+	ext, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExt.GetClient(ctx, component.KindProcessor, componentID, "")
+}
+
+// restoreState loads the decision caches and the pending traces that were last persisted,
+// either periodically or when the processor shut down. The state is kept in the storage,
+// so that it's restored again if the processor is stopped before persisting it anew.
+func (tsp *tailSamplingSpanProcessor) restoreState(ctx context.Context) error {
+	sampled := storage.GetOperation(sampledDecisionsKey)
+	nonSampled := storage.GetOperation(nonSampledDecisionsKey)
+	pending := storage.GetOperation(pendingTracesKey)
+	if err := tsp.storageClient.Batch(ctx, sampled, nonSampled, pending); err != nil {
+		return err
+	}
+
+	sampledEntries, err := decodeDecisions(sampled.Value)
+	if err != nil {
+		return err
+	}
+	tsp.sampledIDCache.Restore(sampledEntries)
+
+	nonSampledEntries, err := decodeDecisions(nonSampled.Value)
+	if err != nil {
+		return err
+	}
+	tsp.nonSampledIDCache.Restore(nonSampledEntries)
+
+	if pending.Value != nil {
+		unmarshaler := ptrace.ProtoUnmarshaler{}
+		td, err := unmarshaler.UnmarshalTraces(pending.Value)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal pending traces: %w", err)
+		}
+
+		// the pending traces are buffered again as if they were just received,
+		// which means that the decision wait starts over for them
+		for i := 0; i < td.ResourceSpans().Len(); i++ {
+			tsp.processTraces(td.ResourceSpans().At(i))
+		}
+		tsp.logger.Info("Restored the pending traces from the storage", zap.Int("spans", td.SpanCount()))
+	}
+	return nil
+}
+
+// persistPeriodically persists the processor state every persistInterval until the processor shuts down.
+func (tsp *tailSamplingSpanProcessor) persistPeriodically() {
+	defer tsp.persistWG.Done()
+
+	ticker := time.NewTicker(tsp.persistInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-tsp.persistDone:
+			return
+		case <-ticker.C:
+			if err := tsp.persistState(context.Background()); err != nil {
+				tsp.logger.Warn("Failed to persist the processor state", zap.Error(err))
+			}
+		}
+	}
+}
+
+// persistState stores the decision caches and the traces that are still waiting for a decision.
+func (tsp *tailSamplingSpanProcessor) persistState(ctx context.Context) error {
+	pending := ptrace.NewTraces()
+	tsp.idToTrace.Range(func(_, value any) bool {
+		trace := value.(*sampling.TraceData)
+		trace.Lock()
+		if trace.FinalDecision == sampling.Unspecified {
+			rss := trace.ReceivedBatches.ResourceSpans()
+			for i := 0; i < rss.Len(); i++ {
+				rss.At(i).CopyTo(pending.ResourceSpans().AppendEmpty())
+			}
+		}
+		trace.Unlock()
+		return true
+	})
+	marshaler := ptrace.ProtoMarshaler{}
+	content, err := marshaler.MarshalTraces(pending)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pending traces: %w", err)
+	}
+
+	return tsp.storageClient.Batch(ctx,
+		storage.SetOperation(sampledDecisionsKey, encodeDecisions(tsp.sampledIDCache.Snapshot())),
+		storage.SetOperation(nonSampledDecisionsKey, encodeDecisions(tsp.nonSampledIDCache.Snapshot())),
+		storage.SetOperation(pendingTracesKey, content),
+	)
+}
+
+func encodeDecisions(entries []cache.Entry[bool]) []byte {
+	buf := make([]byte, 0, len(entries)*decisionEntrySize)
+	for _, e := range entries {
+		buf = append(buf, e.ID[:]...)
+		if e.Value {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+		var expiresAt int64
+		if !e.ExpiresAt.IsZero() {
+			expiresAt = e.ExpiresAt.UnixNano()
+		}
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(expiresAt))
+	}
+	return buf
+}
+
+func decodeDecisions(buf []byte) ([]cache.Entry[bool], error) {
+	if len(buf)%decisionEntrySize != 0 {
+		return nil, fmt.Errorf("%w: unexpected size %d", errCorruptedDecisions, len(buf))
+	}
+
+	entries := make([]cache.Entry[bool], 0, len(buf)/decisionEntrySize)
+	for ; len(buf) > 0; buf = buf[decisionEntrySize:] {
+		e := cache.Entry[bool]{Value: buf[16] == 1}
+		copy(e.ID[:], buf[:16])
+		if expiresAt := int64(binary.LittleEndian.Uint64(buf[17:])); expiresAt != 0 {
+			e.ExpiresAt = time.Unix(0, expiresAt)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
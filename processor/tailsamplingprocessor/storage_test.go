@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+func TestStateIsRestoredAfterRestart(t *testing.T) {
+	storageID := storagetest.NewStorageID("tail_sampling")
+	host := storagetest.NewStorageHost().WithFileBackedStorageExtension("tail_sampling", t.TempDir())
+	cfg := Config{
+		DecisionWait: defaultTestDecisionWait,
+		NumTraces:    defaultNumTraces,
+		DecisionCache: DecisionCacheConfig{
+			SampledCacheSize:    100,
+			NonSampledCacheSize: 100,
+			TTL:                 time.Hour,
+		},
+		StorageID: &storageID,
+	}
+	tel := setupTestTelemetry()
+	set := tel.NewSettings()
+
+	sampledID := uInt64ToTraceID(1)
+	nonSampledID := uInt64ToTraceID(2)
+	pendingID := uInt64ToTraceID(3)
+
+	mpe := &mockPolicyEvaluator{}
+	policies := []*policy{
+		{name: "mock-policy-1", evaluator: mpe, attribute: metric.WithAttributes(attribute.String("policy", "mock-policy-1"))},
+	}
+
+	nextConsumer := new(consumertest.TracesSink)
+	p, err := newTracesProcessor(context.Background(), set, nextConsumer, cfg, withDecisionBatcher(newSyncIDBatcher()), withPolicies(policies))
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	tsp := p.(*tailSamplingSpanProcessor)
+
+	// Decide on the first two traces
+	mpe.NextDecision = sampling.Sampled
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTracesWithID(sampledID)))
+	tsp.policyTicker.OnTick()
+	tsp.policyTicker.OnTick()
+	mpe.NextDecision = sampling.NotSampled
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTracesWithID(nonSampledID)))
+	tsp.policyTicker.OnTick()
+	tsp.policyTicker.OnTick()
+	require.EqualValues(t, 2, mpe.EvaluationCount)
+	require.EqualValues(t, 1, nextConsumer.SpanCount())
+
+	// The third trace is still pending when the processor shuts down
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTracesWithID(pendingID)))
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	// Restart the processor
+	mpe.NextDecision = sampling.Sampled
+	nextConsumer = new(consumertest.TracesSink)
+	p, err = newTracesProcessor(context.Background(), set, nextConsumer, cfg, withDecisionBatcher(newSyncIDBatcher()), withPolicies(policies))
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+	tsp = p.(*tailSamplingSpanProcessor)
+
+	// Late spans must keep the decision made before the restart
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTracesWithID(sampledID)))
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTracesWithID(nonSampledID)))
+	assert.EqualValues(t, 1, nextConsumer.SpanCount())
+	assert.EqualValues(t, 2, mpe.EvaluationCount)
+
+	// The pending trace must be evaluated once its decision wait is over
+	_, ok := tsp.idToTrace.Load(pendingID)
+	require.True(t, ok, "Must restore the pending trace")
+	tsp.policyTicker.OnTick()
+	tsp.policyTicker.OnTick()
+	assert.EqualValues(t, 3, mpe.EvaluationCount)
+	assert.EqualValues(t, 2, nextConsumer.SpanCount())
+	require.NoError(t, tel.Shutdown(context.Background()))
+}
+
+func TestStateIsPersistedPeriodically(t *testing.T) {
+	storageID := storagetest.NewStorageID("tail_sampling")
+	host := storagetest.NewStorageHost().WithFileBackedStorageExtension("tail_sampling", t.TempDir())
+	cfg := Config{
+		DecisionWait: defaultTestDecisionWait,
+		NumTraces:    defaultNumTraces,
+		DecisionCache: DecisionCacheConfig{
+			SampledCacheSize: 100,
+		},
+		StorageID:       &storageID,
+		PersistInterval: 10 * time.Millisecond,
+	}
+	tel := setupTestTelemetry()
+
+	mpe := &mockPolicyEvaluator{NextDecision: sampling.Sampled}
+	policies := []*policy{
+		{name: "mock-policy-1", evaluator: mpe, attribute: metric.WithAttributes(attribute.String("policy", "mock-policy-1"))},
+	}
+
+	p, err := newTracesProcessor(context.Background(), tel.NewSettings(), consumertest.NewNop(), cfg, withDecisionBatcher(newSyncIDBatcher()), withPolicies(policies))
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+		require.NoError(t, tel.Shutdown(context.Background()))
+	}()
+	tsp := p.(*tailSamplingSpanProcessor)
+
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTracesWithID(uInt64ToTraceID(1))))
+	tsp.policyTicker.OnTick()
+	tsp.policyTicker.OnTick()
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTracesWithID(uInt64ToTraceID(2))))
+
+	// The state must be written without the processor shutting down
+	assert.Eventually(t, func() bool {
+		sampled, err := tsp.storageClient.Get(context.Background(), sampledDecisionsKey)
+		if err != nil {
+			return false
+		}
+		entries, err := decodeDecisions(sampled)
+		if err != nil || len(entries) != 1 {
+			return false
+		}
+		pending, err := tsp.storageClient.Get(context.Background(), pendingTracesKey)
+		return err == nil && len(pending) > 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestStartWithInvalidStorage(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		storageID component.ID
+		host      component.Host
+	}{
+		{
+			name:      "missing extension",
+			storageID: storagetest.NewStorageID("missing"),
+			host:      storagetest.NewStorageHost(),
+		},
+		{
+			name:      "non-storage extension",
+			storageID: storagetest.NewNonStorageID("non_storage"),
+			host:      storagetest.NewStorageHost().WithNonStorageExtension("non_storage"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{
+				DecisionWait: defaultTestDecisionWait,
+				NumTraces:    defaultNumTraces,
+				StorageID:    &tt.storageID,
+			}
+			tel := setupTestTelemetry()
+			p, err := newTracesProcessor(context.Background(), tel.NewSettings(), consumertest.NewNop(), cfg)
+			require.NoError(t, err)
+
+			assert.Error(t, p.Start(context.Background(), tt.host))
+			assert.NoError(t, p.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestEncodeDecisions(t *testing.T) {
+	entries := []cache.Entry[bool]{
+		{ID: uInt64ToTraceID(1), Value: true, ExpiresAt: time.Unix(1000, 5)},
+		{ID: uInt64ToTraceID(2), Value: false},
+	}
+
+	decoded, err := decodeDecisions(encodeDecisions(entries))
+	require.NoError(t, err)
+	assert.Equal(t, entries, decoded)
+
+	decoded, err = decodeDecisions(nil)
+	require.NoError(t, err)
+	assert.Empty(t, decoded)
+
+	_, err = decodeDecisions([]byte{1, 2, 3})
+	assert.ErrorIs(t, err, errCorruptedDecisions)
+}
@@ -462,6 +462,30 @@ func Test_ProcessLogs_MixContext(t *testing.T) {
 				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("test", "pass")
 			},
 		},
+		{
+			name: "variables are shared by the statements of a context",
+			contextStatments: []common.ContextStatements{
+				{
+					Context: "log",
+					Statements: []string{
+						`set(vars["flags"], Split(attributes["flags"], "|"))`,
+						`set(attributes["first_flag"], vars["flags"][0])`,
+						`set(attributes["last_flag"], vars["flags"][1]) where Len(vars["flags"]) == 2`,
+					},
+				},
+				{
+					Context: "log",
+					Statements: []string{
+						`set(attributes["test"], "fail") where vars["flags"] != nil`,
+					},
+				},
+			},
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("first_flag", "A")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("first_flag", "C")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("last_flag", "D")
+			},
+		},
 	}
 
 	for _, tt := range tests {
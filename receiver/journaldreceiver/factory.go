@@ -5,6 +5,8 @@ package journaldreceiver // import "github.com/open-telemetry/opentelemetry-coll
 
 import (
 	"go.opentelemetry.io/collector/receiver"
+
+	_ "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/transformer/transform" // register the transform operator
 )
 
 // NewFactory creates a factory for journald receiver
@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -19,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componentstatus"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap/zapcore"
@@ -41,6 +43,7 @@ type prometheusRemoteWriteReceiver struct {
 
 	config *Config
 	server *http.Server
+	wg     sync.WaitGroup
 }
 
 func (prw *prometheusRemoteWriteReceiver) Start(ctx context.Context, host component.Host) error {
@@ -57,7 +60,9 @@ func (prw *prometheusRemoteWriteReceiver) Start(ctx context.Context, host compon
 		return fmt.Errorf("failed to create prometheus remote-write listener: %w", err)
 	}
 
+	prw.wg.Add(1)
 	go func() {
+		defer prw.wg.Done()
 		if err := prw.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(fmt.Errorf("error starting prometheus remote-write receiver: %w", err)))
 		}
@@ -69,7 +74,13 @@ func (prw *prometheusRemoteWriteReceiver) Shutdown(ctx context.Context) error {
 	if prw.server == nil {
 		return nil
 	}
-	return prw.server.Shutdown(ctx)
+	err := prw.server.Shutdown(ctx)
+	if err == nil {
+		// Only wait for the server to finish if it was able to shutdown,
+		// otherwise the context has expired and waiting could block forever.
+		prw.wg.Wait()
+	}
+	return err
 }
 
 func (prw *prometheusRemoteWriteReceiver) handlePRW(w http.ResponseWriter, req *http.Request) {
@@ -109,7 +120,20 @@ func (prw *prometheusRemoteWriteReceiver) handlePRW(w http.ResponseWriter, req *
 		return
 	}
 
-	_, stats, err := prw.translateV2(req.Context(), &prw2Req)
+	m, stats, err := prw.translateV2(req.Context(), &prw2Req)
+	if m.DataPointCount() > 0 {
+		if consumeErr := prw.nextConsumer.ConsumeMetrics(req.Context(), m); consumeErr != nil {
+			prw.settings.Logger.Warn("Error consuming remote write request", zapcore.Field{Key: "error", Type: zapcore.ErrorType, Interface: consumeErr})
+			// Nothing was written, so the sender is told so and is able to retry the errors that aren't permanent.
+			promremote.WriteResponseStats{Confirmed: true}.SetHeaders(w)
+			if consumererror.IsPermanent(consumeErr) {
+				http.Error(w, consumeErr.Error(), http.StatusBadRequest)
+			} else {
+				http.Error(w, consumeErr.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+	}
 	stats.SetHeaders(w)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest) // Following instructions at https://prometheus.io/docs/specs/remote_write_spec_2_0/#invalid-samples
@@ -150,8 +174,10 @@ func (prw *prometheusRemoteWriteReceiver) parseProto(contentType string) (promco
 }
 
 // translateV2 translates a v2 remote-write request into OTLP metrics.
-// For now translateV2 is not implemented and returns an empty metrics.
-// nolint
-func (prw *prometheusRemoteWriteReceiver) translateV2(_ context.Context, _ *writev2.Request) (pmetric.Metrics, promremote.WriteResponseStats, error) {
-	return pmetric.NewMetrics(), promremote.WriteResponseStats{}, nil
+// Series are grouped into resources using the job and instance labels,
+// with `target_info` providing any additional resource attributes.
+// The returned stats only account for the samples, histograms and
+// exemplars that were translated, invalid series are reported by the error.
+func (prw *prometheusRemoteWriteReceiver) translateV2(_ context.Context, req *writev2.Request) (pmetric.Metrics, promremote.WriteResponseStats, error) {
+	return newV2Translator().translate(req)
 }
@@ -6,16 +6,21 @@ package prometheusremotewritereceiver // import "github.com/open-telemetry/opent
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
 	promconfig "github.com/prometheus/prometheus/config"
+	"github.com/prometheus/prometheus/model/labels"
 	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
@@ -87,7 +92,8 @@ func TestHandlePRWContentTypeNegotiation(t *testing.T) {
 			req.Header.Set("Content-Type", tc.contentType)
 			req.Header.Set("Content-Encoding", "snappy")
 			resp, err := http.DefaultClient.Do(req)
-			assert.NoError(t, err)
+			require.NoError(t, err)
+			defer resp.Body.Close()
 
 			assert.Equal(t, tc.extectedCode, resp.StatusCode)
 			if tc.extectedCode == http.StatusNoContent { // We went until the end
@@ -98,3 +104,42 @@ func TestHandlePRWContentTypeNegotiation(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlePRWConsumerErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		consumerErr  error
+		expectedCode int
+	}{
+		{
+			name:         "retryable error",
+			consumerErr:  errors.New("retryable"),
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:         "permanent error",
+			consumerErr:  consumererror.NewPermanent(errors.New("permanent")),
+			expectedCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			prw, err := newRemoteWriteReceiver(receivertest.NewNopSettings(), NewFactory().CreateDefaultConfig().(*Config), consumertest.NewErr(tc.consumerErr))
+			require.NoError(t, err)
+
+			b := newRequestBuilder()
+			b.add(labels.FromStrings("__name__", "test_metric"), writev2.Metadata_METRIC_TYPE_GAUGE, "", "", func(ts *writev2.TimeSeries) {
+				ts.Samples = []writev2.Sample{{Value: 1, Timestamp: 1}}
+			})
+			body, err := proto.Marshal(b.build())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/write", bytes.NewReader(body))
+			req.Header.Set("Content-Type", fmt.Sprintf("application/x-protobuf;proto=%s", promconfig.RemoteWriteProtoMsgV2))
+			w := httptest.NewRecorder()
+			prw.(*prometheusRemoteWriteReceiver).handlePRW(w, req)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			assert.Equal(t, "0", w.Header().Get("X-Prometheus-Remote-Write-Samples-Written"))
+		})
+	}
+}
@@ -0,0 +1,631 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewritereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusremotewritereceiver"
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
+	promremote "github.com/prometheus/prometheus/storage/remote"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.25.0"
+
+	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
+)
+
+const (
+	bucketSuffix = "_bucket"
+	sumSuffix    = "_sum"
+	countSuffix  = "_count"
+
+	// labelSeparator is used to build map keys out of label values,
+	// it is not a valid UTF-8 character so it can not appear in the values.
+	labelSeparator = "\xff"
+)
+
+var (
+	errInvalidSymbolsTable = errors.New("invalid symbols table")
+	errMissingMetricName   = errors.New("missing metric name")
+	errInvalidLabelsRefs   = errors.New("invalid labels references")
+	errUnsupportedSchema   = errors.New("unsupported native histogram schema")
+)
+
+// v2Translator holds the state required to translate a single
+// remote-write 2.0 request into OTLP metrics.
+type v2Translator struct {
+	symbols []string
+	builder labels.ScratchBuilder
+
+	metrics    pmetric.Metrics
+	resources  map[string]*resourceState
+	targetInfo map[string]labels.Labels
+
+	// classic holds the histograms and summaries that are built from
+	// multiple series, they are only added once all series were read.
+	classic []*classicPoint
+
+	stats promremote.WriteResponseStats
+}
+
+type resourceState struct {
+	rm     pmetric.ResourceMetrics
+	scopes map[string]*scopeState
+}
+
+type scopeState struct {
+	sm      pmetric.ScopeMetrics
+	metrics map[string]pmetric.Metric
+	classic map[string]*classicPoint
+}
+
+// classicPoint aggregates the `_bucket`, `_sum`, `_count` and quantile
+// series of a classic histogram or summary at a given timestamp.
+type classicPoint struct {
+	scope     *scopeState
+	name      string
+	typ       writev2.Metadata_MetricType
+	unit      string
+	help      string
+	attrs     labels.Labels
+	timestamp int64
+	created   int64
+
+	sum, count float64
+	hasCount   bool
+	buckets    map[float64]float64
+	quantiles  map[float64]float64
+	exemplars  pmetric.ExemplarSlice
+}
+
+func newV2Translator() *v2Translator {
+	return &v2Translator{
+		builder:    labels.NewScratchBuilder(0),
+		metrics:    pmetric.NewMetrics(),
+		resources:  make(map[string]*resourceState),
+		targetInfo: make(map[string]labels.Labels),
+		stats:      promremote.WriteResponseStats{Confirmed: true},
+	}
+}
+
+// translate converts the request into OTLP metrics.
+// Invalid series are skipped and reported within the returned error
+// so that the valid series can still be consumed.
+func (t *v2Translator) translate(req *writev2.Request) (pmetric.Metrics, promremote.WriteResponseStats, error) {
+	// As per spec, the first symbol must always be an empty string.
+	if (len(req.Symbols) > 0 && req.Symbols[0] != "") || (len(req.Timeseries) > 0 && len(req.Symbols) == 0) {
+		return pmetric.NewMetrics(), t.stats, errInvalidSymbolsTable
+	}
+	t.symbols = req.Symbols
+
+	var errs []error
+	series := make([]labels.Labels, len(req.Timeseries))
+	for i := range req.Timeseries {
+		ts := &req.Timeseries[i]
+		if err := t.validate(ts); err != nil {
+			errs = append(errs, fmt.Errorf("timeseries %d: %w", i, err))
+			continue
+		}
+		lbls := ts.ToLabels(&t.builder, t.symbols)
+		if lbls.Get(labels.MetricName) == "" {
+			errs = append(errs, fmt.Errorf("timeseries %d: %w", i, errMissingMetricName))
+			continue
+		}
+		series[i] = lbls
+		// target_info is collected first so that the resource attributes
+		// are known before any of the metrics are added to the resource.
+		if lbls.Get(labels.MetricName) == prometheustranslator.TargetInfoMetricName {
+			t.targetInfo[resourceKey(lbls)] = lbls
+			t.stats.Samples += len(ts.Samples)
+		}
+	}
+
+	for i := range req.Timeseries {
+		lbls := series[i]
+		if lbls.IsEmpty() || lbls.Get(labels.MetricName) == prometheustranslator.TargetInfoMetricName {
+			continue
+		}
+		if err := t.addTimeSeries(&req.Timeseries[i], lbls); err != nil {
+			errs = append(errs, fmt.Errorf("timeseries %d: %w", i, err))
+		}
+	}
+	t.flushClassic()
+
+	// Resources and scopes are created before the series is fully
+	// validated, so any left empty by rejected series are removed.
+	t.metrics.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+
+	return t.metrics, t.stats, errors.Join(errs...)
+}
+
+// validate ensures that all the symbol references of the series are
+// within the symbols table to avoid panics when resolving them.
+func (t *v2Translator) validate(ts *writev2.TimeSeries) error {
+	if err := t.validateRefs(ts.LabelsRefs); err != nil {
+		return err
+	}
+	if ts.Metadata.HelpRef >= uint32(len(t.symbols)) || ts.Metadata.UnitRef >= uint32(len(t.symbols)) {
+		return fmt.Errorf("metadata: %w", errInvalidLabelsRefs)
+	}
+	for _, ex := range ts.Exemplars {
+		if err := t.validateRefs(ex.LabelsRefs); err != nil {
+			return fmt.Errorf("exemplar: %w", err)
+		}
+	}
+	return nil
+}
+
+func (t *v2Translator) validateRefs(refs []uint32) error {
+	if len(refs)%2 != 0 {
+		return fmt.Errorf("odd number of references: %w", errInvalidLabelsRefs)
+	}
+	for _, ref := range refs {
+		if ref >= uint32(len(t.symbols)) {
+			return fmt.Errorf("reference %d out of range: %w", ref, errInvalidLabelsRefs)
+		}
+	}
+	return nil
+}
+
+func (t *v2Translator) addTimeSeries(ts *writev2.TimeSeries, lbls labels.Labels) error {
+	var (
+		name  = lbls.Get(labels.MetricName)
+		unit  = t.symbols[ts.Metadata.UnitRef]
+		help  = t.symbols[ts.Metadata.HelpRef]
+		scope = t.scopeFor(lbls)
+	)
+
+	if len(ts.Histograms) > 0 {
+		return t.addNativeHistograms(scope, ts, name, unit, help, lbls)
+	}
+
+	switch ts.Metadata.Type {
+	case writev2.Metadata_METRIC_TYPE_HISTOGRAM, writev2.Metadata_METRIC_TYPE_GAUGEHISTOGRAM, writev2.Metadata_METRIC_TYPE_SUMMARY:
+		return t.addClassicSeries(scope, ts, name, unit, help, lbls)
+	case writev2.Metadata_METRIC_TYPE_COUNTER:
+		metric := scope.metric(name, pmetric.MetricTypeSum, ts.Metadata.Type, unit, help)
+		dps := metric.Sum().DataPoints()
+		t.addNumberDataPoints(dps, ts, lbls)
+	default:
+		// Unknown, info and stateset metrics are represented as gauges.
+		metric := scope.metric(name, pmetric.MetricTypeGauge, ts.Metadata.Type, unit, help)
+		dps := metric.Gauge().DataPoints()
+		t.addNumberDataPoints(dps, ts, lbls)
+	}
+	return nil
+}
+
+func (t *v2Translator) addNumberDataPoints(dps pmetric.NumberDataPointSlice, ts *writev2.TimeSeries, lbls labels.Labels) {
+	var dp pmetric.NumberDataPoint
+	for _, sample := range ts.Samples {
+		dp = dps.AppendEmpty()
+		dp.SetTimestamp(toTimestamp(sample.Timestamp))
+		if ts.CreatedTimestamp != 0 {
+			dp.SetStartTimestamp(toTimestamp(ts.CreatedTimestamp))
+		}
+		dp.SetDoubleValue(sample.Value)
+		if value.IsStaleNaN(sample.Value) {
+			dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+		}
+		copyAttributes(dp.Attributes(), lbls)
+		t.stats.Samples++
+	}
+	if len(ts.Samples) > 0 {
+		t.addExemplars(dp.Exemplars(), ts.Exemplars)
+	}
+}
+
+func (t *v2Translator) addNativeHistograms(scope *scopeState, ts *writev2.TimeSeries, name, unit, help string, lbls labels.Labels) error {
+	// Native histograms using custom buckets are not supported
+	// by exponential histograms so the series is rejected.
+	for _, h := range ts.Histograms {
+		if h.Schema < -4 || h.Schema > 8 {
+			return fmt.Errorf("schema %d: %w", h.Schema, errUnsupportedSchema)
+		}
+	}
+
+	metric := scope.metric(name, pmetric.MetricTypeExponentialHistogram, ts.Metadata.Type, unit, help)
+	dps := metric.ExponentialHistogram().DataPoints()
+
+	var dp pmetric.ExponentialHistogramDataPoint
+	for _, h := range ts.Histograms {
+		dp = dps.AppendEmpty()
+		dp.SetTimestamp(toTimestamp(h.Timestamp))
+		if ts.CreatedTimestamp != 0 {
+			dp.SetStartTimestamp(toTimestamp(ts.CreatedTimestamp))
+		}
+		copyAttributes(dp.Attributes(), lbls)
+		convertNativeHistogram(dp, h)
+		t.stats.Histograms++
+	}
+	t.addExemplars(dp.Exemplars(), ts.Exemplars)
+	return nil
+}
+
+// convertNativeHistogram maps the sparse buckets of a Prometheus native histogram onto
+// the dense buckets of an exponential histogram. A Prometheus bucket with index i
+// covers (base^(i-1), base^i] while the OTLP bucket with index i covers (base^i, base^(i+1)],
+// hence the bucket offset is shifted by one.
+func convertNativeHistogram(dp pmetric.ExponentialHistogramDataPoint, h writev2.Histogram) {
+	fh := h.ToFloatHistogram()
+
+	dp.SetScale(fh.Schema)
+	dp.SetSum(fh.Sum)
+	dp.SetCount(toCount(fh.Count))
+	dp.SetZeroCount(toCount(fh.ZeroCount))
+	dp.SetZeroThreshold(fh.ZeroThreshold)
+	if value.IsStaleNaN(fh.Sum) {
+		dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+	}
+
+	convertBuckets(dp.Positive(), h.PositiveSpans, fh.PositiveBuckets)
+	convertBuckets(dp.Negative(), h.NegativeSpans, fh.NegativeBuckets)
+}
+
+func convertBuckets(dest pmetric.ExponentialHistogramDataPointBuckets, spans []writev2.BucketSpan, counts []float64) {
+	if len(spans) == 0 {
+		return
+	}
+	dest.SetOffset(spans[0].Offset - 1)
+
+	var (
+		buckets = dest.BucketCounts()
+		bucket  = 0
+	)
+	for i, span := range spans {
+		if i > 0 {
+			// Following spans use the offset to represent the gap from
+			// the previous span, which are empty buckets.
+			for j := int32(0); j < span.Offset; j++ {
+				buckets.Append(0)
+			}
+		}
+		for j := uint32(0); j < span.Length && bucket < len(counts); j++ {
+			buckets.Append(toCount(counts[bucket]))
+			bucket++
+		}
+	}
+}
+
+// addClassicSeries stores the series of a classic histogram or summary
+// so that they can be combined into a single data point per timestamp.
+func (t *v2Translator) addClassicSeries(scope *scopeState, ts *writev2.TimeSeries, name, unit, help string, lbls labels.Labels) error {
+	var (
+		base   = name
+		suffix string
+	)
+	for _, s := range []string{bucketSuffix, sumSuffix, countSuffix} {
+		if strings.HasSuffix(name, s) {
+			base, suffix = strings.TrimSuffix(name, s), s
+			break
+		}
+	}
+
+	var (
+		bound float64
+		err   error
+	)
+	builder := labels.NewBuilder(lbls)
+	switch {
+	case suffix == bucketSuffix && ts.Metadata.Type != writev2.Metadata_METRIC_TYPE_SUMMARY:
+		if bound, err = strconv.ParseFloat(lbls.Get(model.BucketLabel), 64); err != nil {
+			return fmt.Errorf("invalid %q label: %w", model.BucketLabel, err)
+		}
+		builder.Del(model.BucketLabel)
+	case suffix == "" && ts.Metadata.Type == writev2.Metadata_METRIC_TYPE_SUMMARY:
+		if bound, err = strconv.ParseFloat(lbls.Get(model.QuantileLabel), 64); err != nil {
+			return fmt.Errorf("invalid %q label: %w", model.QuantileLabel, err)
+		}
+		builder.Del(model.QuantileLabel)
+	case suffix == sumSuffix, suffix == countSuffix:
+	default:
+		return fmt.Errorf("unexpected series %q for metric type %s", name, ts.Metadata.Type)
+	}
+	builder.Del(labels.MetricName)
+	attrs := builder.Labels()
+
+	var point *classicPoint
+	for _, sample := range ts.Samples {
+		point = t.classicPointFor(scope, base, ts, unit, help, attrs, sample.Timestamp)
+		switch suffix {
+		case sumSuffix:
+			point.sum = sample.Value
+		case countSuffix:
+			point.count, point.hasCount = sample.Value, true
+		case bucketSuffix:
+			point.buckets[bound] = sample.Value
+		default:
+			point.quantiles[bound] = sample.Value
+		}
+		t.stats.Samples++
+	}
+	if point != nil {
+		t.addExemplars(point.exemplars, ts.Exemplars)
+	}
+	return nil
+}
+
+func (t *v2Translator) classicPointFor(scope *scopeState, name string, ts *writev2.TimeSeries, unit, help string, attrs labels.Labels, timestamp int64) *classicPoint {
+	typ := ts.Metadata.Type
+	if typ == writev2.Metadata_METRIC_TYPE_GAUGEHISTOGRAM {
+		typ = writev2.Metadata_METRIC_TYPE_HISTOGRAM
+	}
+	key := strings.Join([]string{
+		name, typ.String(), attrs.String(), strconv.FormatInt(timestamp, 10),
+	}, labelSeparator)
+	if point, ok := scope.classic[key]; ok {
+		return point
+	}
+	point := &classicPoint{
+		scope:     scope,
+		name:      name,
+		typ:       typ,
+		unit:      unit,
+		help:      help,
+		attrs:     attrs,
+		timestamp: timestamp,
+		created:   ts.CreatedTimestamp,
+		buckets:   make(map[float64]float64),
+		quantiles: make(map[float64]float64),
+		exemplars: pmetric.NewExemplarSlice(),
+	}
+	scope.classic[key] = point
+	t.classic = append(t.classic, point)
+	return point
+}
+
+// flushClassic converts all the collected classic histograms
+// and summaries into their OTLP representation.
+func (t *v2Translator) flushClassic() {
+	for _, point := range t.classic {
+		dataType := pmetric.MetricTypeHistogram
+		if point.typ == writev2.Metadata_METRIC_TYPE_SUMMARY {
+			dataType = pmetric.MetricTypeSummary
+		}
+		metric := point.scope.metric(point.name, dataType, point.typ, point.unit, point.help)
+		switch dataType {
+		case pmetric.MetricTypeSummary:
+			dp := metric.Summary().DataPoints().AppendEmpty()
+			dp.SetTimestamp(toTimestamp(point.timestamp))
+			if point.created != 0 {
+				dp.SetStartTimestamp(toTimestamp(point.created))
+			}
+			dp.SetSum(point.sum)
+			dp.SetCount(toCount(point.count))
+			copyAttributes(dp.Attributes(), point.attrs)
+			for _, q := range sortedKeys(point.quantiles) {
+				qv := dp.QuantileValues().AppendEmpty()
+				qv.SetQuantile(q)
+				qv.SetValue(point.quantiles[q])
+			}
+		default:
+			dp := metric.Histogram().DataPoints().AppendEmpty()
+			dp.SetTimestamp(toTimestamp(point.timestamp))
+			if point.created != 0 {
+				dp.SetStartTimestamp(toTimestamp(point.created))
+			}
+			dp.SetSum(point.sum)
+			copyAttributes(dp.Attributes(), point.attrs)
+
+			// Prometheus buckets are cumulative, while OTLP bucket counts
+			// only contain the observations within each bucket.
+			var previous float64
+			for _, bound := range sortedKeys(point.buckets) {
+				if !math.IsInf(bound, 1) {
+					dp.ExplicitBounds().Append(bound)
+				}
+				dp.BucketCounts().Append(toCount(point.buckets[bound] - previous))
+				previous = point.buckets[bound]
+			}
+			if _, ok := point.buckets[math.Inf(1)]; !ok && len(point.buckets) > 0 {
+				dp.BucketCounts().Append(toCount(point.count - previous))
+			}
+			if point.hasCount {
+				dp.SetCount(toCount(point.count))
+			} else {
+				dp.SetCount(toCount(previous))
+			}
+			point.exemplars.MoveAndAppendTo(dp.Exemplars())
+		}
+	}
+}
+
+// scopeFor returns the scope that the series belongs to,
+// creating the resource and scope if they have not been seen before.
+func (t *v2Translator) scopeFor(lbls labels.Labels) *scopeState {
+	key := resourceKey(lbls)
+	res, ok := t.resources[key]
+	if !ok {
+		res = &resourceState{
+			rm:     t.metrics.ResourceMetrics().AppendEmpty(),
+			scopes: make(map[string]*scopeState),
+		}
+		attrs := res.rm.Resource().Attributes()
+		parseJobAndInstance(attrs, lbls.Get(model.JobLabel), lbls.Get(model.InstanceLabel))
+		if info, ok := t.targetInfo[key]; ok {
+			info.Range(func(l labels.Label) {
+				switch l.Name {
+				case labels.MetricName, model.JobLabel, model.InstanceLabel:
+				default:
+					attrs.PutStr(l.Name, l.Value)
+				}
+			})
+		}
+		t.resources[key] = res
+	}
+
+	var (
+		name    = lbls.Get(prometheustranslator.ScopeNameLabelKey)
+		version = lbls.Get(prometheustranslator.ScopeVersionLabelKey)
+	)
+	scopeKey := name + labelSeparator + version
+	scope, ok := res.scopes[scopeKey]
+	if !ok {
+		scope = &scopeState{
+			sm:      res.rm.ScopeMetrics().AppendEmpty(),
+			metrics: make(map[string]pmetric.Metric),
+			classic: make(map[string]*classicPoint),
+		}
+		scope.sm.Scope().SetName(name)
+		scope.sm.Scope().SetVersion(version)
+		res.scopes[scopeKey] = scope
+	}
+	return scope
+}
+
+// metric returns the metric matching the name, data type and unit,
+// creating it if it does not already exist within the scope.
+func (s *scopeState) metric(name string, dataType pmetric.MetricType, typ writev2.Metadata_MetricType, unit, help string) pmetric.Metric {
+	key := name + labelSeparator + dataType.String() + labelSeparator + unit
+	if metric, ok := s.metrics[key]; ok {
+		return metric
+	}
+	metric := s.sm.Metrics().AppendEmpty()
+	metric.SetName(name)
+	metric.SetUnit(unit)
+	metric.SetDescription(help)
+	metric.Metadata().PutStr(prometheustranslator.MetricMetadataTypeKey, metadataType(typ))
+
+	switch dataType {
+	case pmetric.MetricTypeSum:
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(true)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	case pmetric.MetricTypeHistogram:
+		metric.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	case pmetric.MetricTypeExponentialHistogram:
+		metric.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	case pmetric.MetricTypeSummary:
+		metric.SetEmptySummary()
+	default:
+		metric.SetEmptyGauge()
+	}
+	s.metrics[key] = metric
+	return metric
+}
+
+// addExemplars converts the remote-write exemplars, the trace and span id
+// labels are set as the exemplar identifiers and any remaining labels
+// are set as filtered attributes.
+func (t *v2Translator) addExemplars(dest pmetric.ExemplarSlice, exemplars []writev2.Exemplar) {
+	for _, ex := range exemplars {
+		e := dest.AppendEmpty()
+		e.SetDoubleValue(ex.Value)
+		e.SetTimestamp(toTimestamp(ex.Timestamp))
+		ex.ToExemplar(&t.builder, t.symbols).Labels.Range(func(l labels.Label) {
+			switch l.Name {
+			case prometheustranslator.ExemplarTraceIDKey:
+				var id pcommon.TraceID
+				if b, err := hex.DecodeString(l.Value); err == nil && len(b) == len(id) {
+					copy(id[:], b)
+					e.SetTraceID(id)
+					return
+				}
+			case prometheustranslator.ExemplarSpanIDKey:
+				var id pcommon.SpanID
+				if b, err := hex.DecodeString(l.Value); err == nil && len(b) == len(id) {
+					copy(id[:], b)
+					e.SetSpanID(id)
+					return
+				}
+			}
+			e.FilteredAttributes().PutStr(l.Name, l.Value)
+		})
+		t.stats.Exemplars++
+	}
+}
+
+// resourceKey identifies the resource of the series,
+// which is based on the job and instance labels.
+func resourceKey(lbls labels.Labels) string {
+	return lbls.Get(model.JobLabel) + labelSeparator + lbls.Get(model.InstanceLabel)
+}
+
+// parseJobAndInstance sets the resource attributes derived from the job and instance labels
+// as defined by https://opentelemetry.io/docs/specs/otel/compatibility/prometheus_and_openmetrics/#resource-attributes-1
+func parseJobAndInstance(dest pcommon.Map, job, instance string) {
+	if instance != "" {
+		dest.PutStr(conventions.AttributeServiceInstanceID, instance)
+	}
+	if job == "" {
+		return
+	}
+	if namespace, name, ok := strings.Cut(job, "/"); ok {
+		dest.PutStr(conventions.AttributeServiceNamespace, namespace)
+		dest.PutStr(conventions.AttributeServiceName, name)
+		return
+	}
+	dest.PutStr(conventions.AttributeServiceName, job)
+}
+
+// copyAttributes sets all the labels of the series as attributes except
+// for the ones that are used to identify the metric, resource and scope.
+func copyAttributes(dest pcommon.Map, lbls labels.Labels) {
+	lbls.Range(func(l labels.Label) {
+		switch l.Name {
+		case labels.MetricName,
+			model.JobLabel,
+			model.InstanceLabel,
+			prometheustranslator.ScopeNameLabelKey,
+			prometheustranslator.ScopeVersionLabelKey:
+		default:
+			dest.PutStr(l.Name, l.Value)
+		}
+	})
+}
+
+func metadataType(typ writev2.Metadata_MetricType) string {
+	switch typ {
+	case writev2.Metadata_METRIC_TYPE_COUNTER:
+		return string(model.MetricTypeCounter)
+	case writev2.Metadata_METRIC_TYPE_GAUGE:
+		return string(model.MetricTypeGauge)
+	case writev2.Metadata_METRIC_TYPE_HISTOGRAM:
+		return string(model.MetricTypeHistogram)
+	case writev2.Metadata_METRIC_TYPE_GAUGEHISTOGRAM:
+		return string(model.MetricTypeGaugeHistogram)
+	case writev2.Metadata_METRIC_TYPE_SUMMARY:
+		return string(model.MetricTypeSummary)
+	case writev2.Metadata_METRIC_TYPE_INFO:
+		return string(model.MetricTypeInfo)
+	case writev2.Metadata_METRIC_TYPE_STATESET:
+		return string(model.MetricTypeStateset)
+	}
+	return string(model.MetricTypeUnknown)
+}
+
+func sortedKeys(m map[float64]float64) []float64 {
+	keys := make([]float64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Float64s(keys)
+	return keys
+}
+
+// toTimestamp converts the remote-write millisecond timestamps.
+func toTimestamp(ms int64) pcommon.Timestamp {
+	return pcommon.Timestamp(ms * int64(1e6))
+}
+
+func toCount(v float64) uint64 {
+	if v <= 0 || math.IsNaN(v) {
+		return 0
+	}
+	return uint64(math.Round(v))
+}
@@ -0,0 +1,362 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewritereceiver
+
+import (
+	"math"
+	"testing"
+
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/prometheus/prometheus/model/labels"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
+	promremote "github.com/prometheus/prometheus/storage/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// requestBuilder helps building remote-write 2.0 requests
+// by resolving the symbols table as the series are added.
+type requestBuilder struct {
+	symbols writev2.SymbolsTable
+	series  []writev2.TimeSeries
+}
+
+func newRequestBuilder() *requestBuilder {
+	return &requestBuilder{symbols: writev2.NewSymbolTable()}
+}
+
+func (b *requestBuilder) add(lbls labels.Labels, typ writev2.Metadata_MetricType, unit, help string, fn func(ts *writev2.TimeSeries)) *requestBuilder {
+	ts := writev2.TimeSeries{
+		LabelsRefs: b.symbols.SymbolizeLabels(lbls, nil),
+		Metadata: writev2.Metadata{
+			Type:    typ,
+			UnitRef: b.symbols.Symbolize(unit),
+			HelpRef: b.symbols.Symbolize(help),
+		},
+	}
+	if fn != nil {
+		fn(&ts)
+	}
+	b.series = append(b.series, ts)
+	return b
+}
+
+func (b *requestBuilder) exemplar(lbls labels.Labels, v float64, ts int64) writev2.Exemplar {
+	return writev2.Exemplar{
+		LabelsRefs: b.symbols.SymbolizeLabels(lbls, nil),
+		Value:      v,
+		Timestamp:  ts,
+	}
+}
+
+func (b *requestBuilder) build() *writev2.Request {
+	return &writev2.Request{
+		Symbols:    b.symbols.Symbols(),
+		Timeseries: b.series,
+	}
+}
+
+func TestTranslateV2Counters(t *testing.T) {
+	b := newRequestBuilder()
+	b.add(labels.FromStrings(
+		"__name__", "target_info",
+		"job", "production/service_a",
+		"instance", "host1:8080",
+		"k8s_pod_name", "pod-0",
+	), writev2.Metadata_METRIC_TYPE_GAUGE, "", "", func(ts *writev2.TimeSeries) {
+		ts.Samples = []writev2.Sample{{Value: 1, Timestamp: 1}}
+	})
+	b.add(labels.FromStrings(
+		"__name__", "http_requests_total",
+		"job", "production/service_a",
+		"instance", "host1:8080",
+		"otel_scope_name", "http",
+		"otel_scope_version", "1.0.0",
+		"code", "200",
+	), writev2.Metadata_METRIC_TYPE_COUNTER, "requests", "Total number of requests", func(ts *writev2.TimeSeries) {
+		ts.Samples = []writev2.Sample{{Value: 10, Timestamp: 1}, {Value: 12, Timestamp: 2}}
+		ts.CreatedTimestamp = 0
+		ts.Exemplars = []writev2.Exemplar{
+			b.exemplar(labels.FromStrings(
+				"trace_id", "0102030405060708090a0b0c0d0e0f10",
+				"span_id", "0102030405060708",
+				"user", "alice",
+			), 1, 2),
+		}
+	})
+	b.add(labels.FromStrings(
+		"__name__", "memory_usage_bytes",
+		"job", "service_b",
+	), writev2.Metadata_METRIC_TYPE_UNSPECIFIED, "bytes", "", func(ts *writev2.TimeSeries) {
+		ts.Samples = []writev2.Sample{{Value: 1024, Timestamp: 3}}
+	})
+
+	m, stats, err := newV2Translator().translate(b.build())
+	require.NoError(t, err)
+	assert.Equal(t, promremote.WriteResponseStats{Samples: 4, Exemplars: 1, Confirmed: true}, stats)
+
+	expected := pmetric.NewMetrics()
+	rm := expected.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.instance.id", "host1:8080")
+	rm.Resource().Attributes().PutStr("service.namespace", "production")
+	rm.Resource().Attributes().PutStr("service.name", "service_a")
+	rm.Resource().Attributes().PutStr("k8s_pod_name", "pod-0")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("http")
+	sm.Scope().SetVersion("1.0.0")
+	metric := sm.Metrics().AppendEmpty()
+	metric.SetName("http_requests_total")
+	metric.SetUnit("requests")
+	metric.SetDescription("Total number of requests")
+	metric.Metadata().PutStr("prometheus.type", "counter")
+	sum := metric.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	for _, v := range []struct {
+		value float64
+		ts    int64
+	}{{10, 1}, {12, 2}} {
+		dp := sum.DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.Timestamp(v.ts * 1e6))
+		dp.SetDoubleValue(v.value)
+		dp.Attributes().PutStr("code", "200")
+	}
+	ex := sum.DataPoints().At(1).Exemplars().AppendEmpty()
+	ex.SetDoubleValue(1)
+	ex.SetTimestamp(pcommon.Timestamp(2e6))
+	ex.SetTraceID(pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	ex.SetSpanID(pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8})
+	ex.FilteredAttributes().PutStr("user", "alice")
+
+	rm = expected.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "service_b")
+	metric = rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("memory_usage_bytes")
+	metric.SetUnit("bytes")
+	metric.Metadata().PutStr("prometheus.type", "unknown")
+	dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.Timestamp(3e6))
+	dp.SetDoubleValue(1024)
+
+	assert.Equal(t, expected, m)
+}
+
+func TestTranslateV2ClassicHistogramAndSummary(t *testing.T) {
+	b := newRequestBuilder()
+	for _, bucket := range []struct {
+		le    string
+		count float64
+	}{{"0.1", 2}, {"1", 5}, {"+Inf", 6}} {
+		b.add(labels.FromStrings(
+			"__name__", "request_duration_seconds_bucket",
+			"job", "service_a",
+			"le", bucket.le,
+			"method", "GET",
+		), writev2.Metadata_METRIC_TYPE_HISTOGRAM, "seconds", "Request duration", func(ts *writev2.TimeSeries) {
+			ts.Samples = []writev2.Sample{{Value: bucket.count, Timestamp: 10}}
+			ts.CreatedTimestamp = 5
+		})
+	}
+	b.add(labels.FromStrings(
+		"__name__", "request_duration_seconds_sum",
+		"job", "service_a",
+		"method", "GET",
+	), writev2.Metadata_METRIC_TYPE_HISTOGRAM, "seconds", "Request duration", func(ts *writev2.TimeSeries) {
+		ts.Samples = []writev2.Sample{{Value: 3.5, Timestamp: 10}}
+		ts.CreatedTimestamp = 5
+	})
+	b.add(labels.FromStrings(
+		"__name__", "request_duration_seconds_count",
+		"job", "service_a",
+		"method", "GET",
+	), writev2.Metadata_METRIC_TYPE_HISTOGRAM, "seconds", "Request duration", func(ts *writev2.TimeSeries) {
+		ts.Samples = []writev2.Sample{{Value: 6, Timestamp: 10}}
+		ts.CreatedTimestamp = 5
+	})
+	for _, quantile := range []struct {
+		q     string
+		value float64
+	}{{"0.99", 0.8}, {"0.5", 0.2}} {
+		b.add(labels.FromStrings(
+			"__name__", "rpc_latency_seconds",
+			"job", "service_a",
+			"quantile", quantile.q,
+		), writev2.Metadata_METRIC_TYPE_SUMMARY, "seconds", "", func(ts *writev2.TimeSeries) {
+			ts.Samples = []writev2.Sample{{Value: quantile.value, Timestamp: 10}}
+		})
+	}
+	b.add(labels.FromStrings(
+		"__name__", "rpc_latency_seconds_count",
+		"job", "service_a",
+	), writev2.Metadata_METRIC_TYPE_SUMMARY, "seconds", "", func(ts *writev2.TimeSeries) {
+		ts.Samples = []writev2.Sample{{Value: 20, Timestamp: 10}}
+	})
+
+	m, stats, err := newV2Translator().translate(b.build())
+	require.NoError(t, err)
+	assert.Equal(t, promremote.WriteResponseStats{Samples: 8, Confirmed: true}, stats)
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	hist := metrics.At(0)
+	assert.Equal(t, "request_duration_seconds", hist.Name())
+	assert.Equal(t, "seconds", hist.Unit())
+	assert.Equal(t, "Request duration", hist.Description())
+	require.Equal(t, pmetric.MetricTypeHistogram, hist.Type())
+	require.Equal(t, 1, hist.Histogram().DataPoints().Len())
+	hdp := hist.Histogram().DataPoints().At(0)
+	assert.Equal(t, pcommon.Timestamp(5e6), hdp.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(10e6), hdp.Timestamp())
+	assert.Equal(t, map[string]any{"method": "GET"}, hdp.Attributes().AsRaw())
+	assert.Equal(t, []float64{0.1, 1}, hdp.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{2, 3, 1}, hdp.BucketCounts().AsRaw())
+	assert.Equal(t, 3.5, hdp.Sum())
+	assert.Equal(t, uint64(6), hdp.Count())
+
+	summary := metrics.At(1)
+	assert.Equal(t, "rpc_latency_seconds", summary.Name())
+	require.Equal(t, pmetric.MetricTypeSummary, summary.Type())
+	require.Equal(t, 1, summary.Summary().DataPoints().Len())
+	sdp := summary.Summary().DataPoints().At(0)
+	assert.Equal(t, uint64(20), sdp.Count())
+	require.Equal(t, 2, sdp.QuantileValues().Len())
+	assert.Equal(t, 0.5, sdp.QuantileValues().At(0).Quantile())
+	assert.Equal(t, 0.2, sdp.QuantileValues().At(0).Value())
+	assert.Equal(t, 0.99, sdp.QuantileValues().At(1).Quantile())
+	assert.Equal(t, 0.8, sdp.QuantileValues().At(1).Value())
+}
+
+func TestTranslateV2NativeHistogram(t *testing.T) {
+	b := newRequestBuilder()
+	b.add(labels.FromStrings(
+		"__name__", "request_size_bytes",
+		"job", "service_a",
+	), writev2.Metadata_METRIC_TYPE_HISTOGRAM, "bytes", "", func(ts *writev2.TimeSeries) {
+		ts.Histograms = []writev2.Histogram{
+			writev2.FromIntHistogram(10, &histogram.Histogram{
+				Schema:        1,
+				Count:         12,
+				Sum:           100,
+				ZeroThreshold: 0.001,
+				ZeroCount:     2,
+				PositiveSpans: []histogram.Span{{Offset: 2, Length: 2}, {Offset: 1, Length: 1}},
+				// Deltas between buckets, the absolute counts are 4, 3 and 2.
+				PositiveBuckets: []int64{4, -1, -1},
+				NegativeSpans:   []histogram.Span{{Offset: 0, Length: 1}},
+				NegativeBuckets: []int64{1},
+			}),
+			writev2.FromFloatHistogram(20, &histogram.FloatHistogram{
+				Schema:          -1,
+				Count:           3,
+				Sum:             10,
+				PositiveSpans:   []histogram.Span{{Offset: -1, Length: 1}},
+				PositiveBuckets: []float64{3},
+			}),
+		}
+		ts.Exemplars = []writev2.Exemplar{b.exemplar(labels.EmptyLabels(), 5, 20)}
+	})
+	b.add(labels.FromStrings(
+		"__name__", "custom_buckets",
+		"job", "service_a",
+	), writev2.Metadata_METRIC_TYPE_HISTOGRAM, "", "", func(ts *writev2.TimeSeries) {
+		ts.Histograms = []writev2.Histogram{{Schema: -53, Timestamp: 10}}
+	})
+
+	m, stats, err := newV2Translator().translate(b.build())
+	assert.ErrorIs(t, err, errUnsupportedSchema, "Must reject custom bucket histograms")
+	assert.Equal(t, promremote.WriteResponseStats{Histograms: 2, Exemplars: 1, Confirmed: true}, stats)
+
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	metric := metrics.At(0)
+	require.Equal(t, pmetric.MetricTypeExponentialHistogram, metric.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, metric.ExponentialHistogram().AggregationTemporality())
+	require.Equal(t, 2, metric.ExponentialHistogram().DataPoints().Len())
+
+	dp := metric.ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, int32(1), dp.Scale())
+	assert.Equal(t, uint64(12), dp.Count())
+	assert.Equal(t, 100.0, dp.Sum())
+	assert.Equal(t, uint64(2), dp.ZeroCount())
+	assert.Equal(t, 0.001, dp.ZeroThreshold())
+	assert.Equal(t, int32(1), dp.Positive().Offset())
+	assert.Equal(t, []uint64{4, 3, 0, 2}, dp.Positive().BucketCounts().AsRaw())
+	assert.Equal(t, int32(-1), dp.Negative().Offset())
+	assert.Equal(t, []uint64{1}, dp.Negative().BucketCounts().AsRaw())
+
+	dp = metric.ExponentialHistogram().DataPoints().At(1)
+	assert.Equal(t, int32(-1), dp.Scale())
+	assert.Equal(t, uint64(3), dp.Count())
+	assert.Equal(t, int32(-2), dp.Positive().Offset())
+	assert.Equal(t, []uint64{3}, dp.Positive().BucketCounts().AsRaw())
+	assert.Equal(t, 1, dp.Exemplars().Len())
+}
+
+func TestTranslateV2InvalidRequests(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		req    *writev2.Request
+		expect error
+	}{
+		{
+			name:   "empty symbols table",
+			req:    &writev2.Request{Timeseries: []writev2.TimeSeries{{}}},
+			expect: errInvalidSymbolsTable,
+		},
+		{
+			name: "first symbol not empty",
+			req: &writev2.Request{
+				Symbols: []string{"__name__"},
+			},
+			expect: errInvalidSymbolsTable,
+		},
+		{
+			name: "reference out of range",
+			req: &writev2.Request{
+				Symbols:    []string{"", "__name__", "up"},
+				Timeseries: []writev2.TimeSeries{{LabelsRefs: []uint32{1, 3}}},
+			},
+			expect: errInvalidLabelsRefs,
+		},
+		{
+			name: "odd number of references",
+			req: &writev2.Request{
+				Symbols:    []string{"", "__name__", "up"},
+				Timeseries: []writev2.TimeSeries{{LabelsRefs: []uint32{1, 2, 1}}},
+			},
+			expect: errInvalidLabelsRefs,
+		},
+		{
+			name: "missing metric name",
+			req: &writev2.Request{
+				Symbols:    []string{"", "job", "service_a"},
+				Timeseries: []writev2.TimeSeries{{LabelsRefs: []uint32{1, 2}}},
+			},
+			expect: errMissingMetricName,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m, stats, err := newV2Translator().translate(tc.req)
+			assert.ErrorIs(t, err, tc.expect)
+			assert.True(t, stats.NoDataWritten())
+			assert.Equal(t, 0, m.ResourceMetrics().Len(), "Must not leave empty resources")
+		})
+	}
+}
+
+func TestTranslateV2StaleMarker(t *testing.T) {
+	b := newRequestBuilder()
+	b.add(labels.FromStrings("__name__", "up", "job", "service_a"), writev2.Metadata_METRIC_TYPE_GAUGE, "", "", func(ts *writev2.TimeSeries) {
+		ts.Samples = []writev2.Sample{{Value: math.Float64frombits(0x7ff0000000000002), Timestamp: 1}}
+	})
+
+	m, _, err := newV2Translator().translate(b.build())
+	require.NoError(t, err)
+	dp := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0)
+	assert.True(t, dp.Flags().NoRecordedValue(), "Must flag stale markers")
+}